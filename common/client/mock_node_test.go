@@ -7,6 +7,8 @@ import (
 
 	types "github.com/smartcontractkit/chainlink/v2/common/types"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // mockNode is an autogenerated mock type for the Node type
@@ -292,6 +294,131 @@ func (_c *mockNode_RPC_Call[CHAIN_ID, RPC]) RunAndReturn(run func() RPC) *mockNo
 	return _c
 }
 
+// RPCStats provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, RPC]) RPCStats() RPCStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RPCStats")
+	}
+
+	var r0 RPCStats
+	if rf, ok := ret.Get(0).(func() RPCStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(RPCStats)
+	}
+
+	return r0
+}
+
+// mockNode_RPCStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RPCStats'
+type mockNode_RPCStats_Call[CHAIN_ID types.ID, RPC any] struct {
+	*mock.Call
+}
+
+// RPCStats is a helper method to define mock.On call
+func (_e *mockNode_Expecter[CHAIN_ID, RPC]) RPCStats() *mockNode_RPCStats_Call[CHAIN_ID, RPC] {
+	return &mockNode_RPCStats_Call[CHAIN_ID, RPC]{Call: _e.mock.On("RPCStats")}
+}
+
+func (_c *mockNode_RPCStats_Call[CHAIN_ID, RPC]) Run(run func()) *mockNode_RPCStats_Call[CHAIN_ID, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockNode_RPCStats_Call[CHAIN_ID, RPC]) Return(_a0 RPCStats) *mockNode_RPCStats_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockNode_RPCStats_Call[CHAIN_ID, RPC]) RunAndReturn(run func() RPCStats) *mockNode_RPCStats_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportReadQuorumResult provides a mock function with given fields: agreed, demotionThreshold, demotionCooldown
+func (_m *mockNode[CHAIN_ID, RPC]) ReportReadQuorumResult(agreed bool, demotionThreshold uint32, demotionCooldown time.Duration) {
+	_m.Called(agreed, demotionThreshold, demotionCooldown)
+}
+
+// mockNode_ReportReadQuorumResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportReadQuorumResult'
+type mockNode_ReportReadQuorumResult_Call[CHAIN_ID types.ID, RPC any] struct {
+	*mock.Call
+}
+
+// ReportReadQuorumResult is a helper method to define mock.On call
+//   - agreed bool
+//   - demotionThreshold uint32
+//   - demotionCooldown time.Duration
+func (_e *mockNode_Expecter[CHAIN_ID, RPC]) ReportReadQuorumResult(agreed interface{}, demotionThreshold interface{}, demotionCooldown interface{}) *mockNode_ReportReadQuorumResult_Call[CHAIN_ID, RPC] {
+	return &mockNode_ReportReadQuorumResult_Call[CHAIN_ID, RPC]{Call: _e.mock.On("ReportReadQuorumResult", agreed, demotionThreshold, demotionCooldown)}
+}
+
+func (_c *mockNode_ReportReadQuorumResult_Call[CHAIN_ID, RPC]) Run(run func(agreed bool, demotionThreshold uint32, demotionCooldown time.Duration)) *mockNode_ReportReadQuorumResult_Call[CHAIN_ID, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool), args[1].(uint32), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *mockNode_ReportReadQuorumResult_Call[CHAIN_ID, RPC]) Return() *mockNode_ReportReadQuorumResult_Call[CHAIN_ID, RPC] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *mockNode_ReportReadQuorumResult_Call[CHAIN_ID, RPC]) RunAndReturn(run func(bool, uint32, time.Duration)) *mockNode_ReportReadQuorumResult_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestBudgetNearlyExhausted provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, RPC]) RequestBudgetNearlyExhausted() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestBudgetNearlyExhausted")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// mockNode_RequestBudgetNearlyExhausted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestBudgetNearlyExhausted'
+type mockNode_RequestBudgetNearlyExhausted_Call[CHAIN_ID types.ID, RPC any] struct {
+	*mock.Call
+}
+
+// RequestBudgetNearlyExhausted is a helper method to define mock.On call
+func (_e *mockNode_Expecter[CHAIN_ID, RPC]) RequestBudgetNearlyExhausted() *mockNode_RequestBudgetNearlyExhausted_Call[CHAIN_ID, RPC] {
+	return &mockNode_RequestBudgetNearlyExhausted_Call[CHAIN_ID, RPC]{Call: _e.mock.On("RequestBudgetNearlyExhausted")}
+}
+
+func (_c *mockNode_RequestBudgetNearlyExhausted_Call[CHAIN_ID, RPC]) Run(run func()) *mockNode_RequestBudgetNearlyExhausted_Call[CHAIN_ID, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockNode_RequestBudgetNearlyExhausted_Call[CHAIN_ID, RPC]) Return(_a0 bool) *mockNode_RequestBudgetNearlyExhausted_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockNode_RequestBudgetNearlyExhausted_Call[CHAIN_ID, RPC]) RunAndReturn(run func() bool) *mockNode_RequestBudgetNearlyExhausted_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPoolChainInfoProvider provides a mock function with given fields: _a0
 func (_m *mockNode[CHAIN_ID, RPC]) SetPoolChainInfoProvider(_a0 PoolChainInfoProvider) {
 	_m.Called(_a0)
@@ -88,6 +88,8 @@ const (
 	syncStatusNoNewHead
 	// syncStatusNoNewFinalizedHead - RPC failed to produce a new finalized head for too long
 	syncStatusNoNewFinalizedHead
+	// syncStatusDemoted - RPC repeatedly disagreed with the read quorum, and its demotion cooldown has not elapsed yet
+	syncStatusDemoted
 	syncStatusLen
 )
 
@@ -115,6 +117,8 @@ func (s syncStatus) string() string {
 		return "NoNewHead"
 	case syncStatusNoNewFinalizedHead:
 		return "NoNewFinalizedHead"
+	case syncStatusDemoted:
+		return "Demoted"
 	default:
 		return fmt.Sprintf("syncStatus(%d)", s)
 	}
@@ -64,8 +64,9 @@ func NewMultiNode[
 	chainID CHAIN_ID, // configured chain ID (used to verify that passed primaryNodes belong to the same chain)
 	chainFamily string, // name of the chain family - used in the metrics
 	deathDeclarationDelay time.Duration,
+	latencyWeightedCfg LatencyWeightedConfig, // scoring of the nodes, only used by the LatencyWeighted selector
 ) *MultiNode[CHAIN_ID, RPC] {
-	nodeSelector := newNodeSelector(selectionMode, primaryNodes)
+	nodeSelector := newNodeSelector(selectionMode, primaryNodes, latencyWeightedCfg)
 	// Prometheus' default interval is 15s, set this to under 7.5s to avoid
 	// aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
 	const reportInterval = 6500 * time.Millisecond
@@ -145,6 +146,15 @@ func (c *MultiNode[CHAIN_ID, RPC]) NodeStates() map[string]string {
 	return states
 }
 
+// NodeRPCStats returns the RPCStats of the primary nodes, by node name.
+func (c *MultiNode[CHAIN_ID, RPC]) NodeRPCStats() map[string]RPCStats {
+	stats := map[string]RPCStats{}
+	for _, n := range c.primaryNodes {
+		stats[n.Name()] = n.RPCStats()
+	}
+	return stats
+}
+
 // Start starts every node in the pool
 //
 // Nodes handle their own redialing and runloops, so this function does not
@@ -208,12 +218,13 @@ func (c *MultiNode[CHAIN_ID, RPC]) SelectRPC() (rpc RPC, err error) {
 	return n.RPC(), nil
 }
 
-// selectNode returns the active Node, if it is still nodeStateAlive, otherwise it selects a new one from the NodeSelector.
+// selectNode returns the active Node, if it is still nodeStateAlive and within its request budget, otherwise it selects
+// a new one from the NodeSelector.
 func (c *MultiNode[CHAIN_ID, RPC]) selectNode() (node Node[CHAIN_ID, RPC], err error) {
 	c.activeMu.RLock()
 	node = c.activeNode
 	c.activeMu.RUnlock()
-	if node != nil && node.State() == nodeStateAlive {
+	if node != nil && node.State() == nodeStateAlive && !node.RequestBudgetNearlyExhausted() {
 		return // still alive
 	}
 
@@ -221,27 +232,50 @@ func (c *MultiNode[CHAIN_ID, RPC]) selectNode() (node Node[CHAIN_ID, RPC], err e
 	c.activeMu.Lock()
 	defer c.activeMu.Unlock()
 	node = c.activeNode
-	if node != nil && node.State() == nodeStateAlive {
+	if node != nil && node.State() == nodeStateAlive && !node.RequestBudgetNearlyExhausted() {
 		return // another goroutine beat us here
 	}
 
-	var prevNodeName string
-	if c.activeNode != nil {
-		prevNodeName = c.activeNode.String()
-		c.activeNode.UnsubscribeAllExceptAliveLoop()
-	}
-	c.activeNode = c.nodeSelector.Select()
+	prevNode := c.activeNode
+	c.activeNode = c.selectNodeWithinBudget()
 	if c.activeNode == nil {
+		if prevNode != nil {
+			prevNode.UnsubscribeAllExceptAliveLoop()
+		}
 		c.lggr.Criticalw("No live RPC nodes available", "NodeSelectionMode", c.nodeSelector.Name())
 		errmsg := fmt.Errorf("no live nodes available for chain %s", c.chainID.String())
 		c.SvcErrBuffer.Append(errmsg)
 		return nil, ErroringNodeError
 	}
+	if c.activeNode == prevNode {
+		return c.activeNode, nil // all the alive nodes are nearly out of budget, keep using the active one
+	}
 
+	var prevNodeName string
+	if prevNode != nil {
+		prevNodeName = prevNode.String()
+		prevNode.UnsubscribeAllExceptAliveLoop()
+	}
 	c.lggr.Debugw("Switched to a new active node due to prev node heath issues", "prevNode", prevNodeName, "newNode", c.activeNode.String())
 	return c.activeNode, err
 }
 
+// selectNodeWithinBudget returns the node selected by the NodeSelector, unless its request budget is nearly exhausted
+// and another alive node is within its budget. Nodes which budget is nearly exhausted are only used as a last resort.
+func (c *MultiNode[CHAIN_ID, RPC]) selectNodeWithinBudget() Node[CHAIN_ID, RPC] {
+	selected := c.nodeSelector.Select()
+	if selected == nil || !selected.RequestBudgetNearlyExhausted() {
+		return selected
+	}
+	for _, n := range c.primaryNodes {
+		if n != selected && n.State() == nodeStateAlive && !n.RequestBudgetNearlyExhausted() {
+			c.lggr.Debugw("Request budget of the selected node is nearly exhausted, routing requests to another node", "selectedNode", selected.String(), "node", n.String())
+			return n
+		}
+	}
+	return selected
+}
+
 // LatestChainInfo - returns number of live nodes available in the pool, so we can prevent the last alive node in a pool from being marked as out-of-sync.
 // Return highest ChainInfo most recently received by the alive nodes.
 // E.g. If Node A's the most recent block is 10 and highest 15 and for Node B it's - 12 and 14. This method will return 12.
@@ -276,7 +310,7 @@ func (c *MultiNode[CHAIN_ID, RPC]) HighestUserObservations() ChainInfo {
 }
 
 func (c *MultiNode[CHAIN_ID, RPC]) checkLease() {
-	bestNode := c.nodeSelector.Select()
+	bestNode := c.selectNodeWithinBudget()
 	for _, n := range c.primaryNodes {
 		// Terminate client subscriptions. Services are responsible for reconnecting, which will be routed to the new
 		// best node. Only terminate connections with more than 1 subscription to account for the aliveLoop subscription
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+var (
+	promMultiNodeReadQuorumFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_read_quorum_failures",
+		Help: "The total number of read requests for which no result was returned by the required number of RPC nodes",
+	}, []string{"network", "chainId", "method"})
+
+	ErrReadQuorumNotReached = errors.New("read quorum not reached")
+)
+
+// ReadQuorumConfig configures the read methods which results must be confirmed by several RPC nodes.
+type ReadQuorumConfig interface {
+	// Enabled turns the read quorum on
+	Enabled() bool
+	// Methods are the names of the read methods requiring a quorum
+	Methods() []string
+	// Nodes is the number of alive nodes queried for each read
+	Nodes() uint32
+	// Agreement is the number of queried nodes which must return the same result
+	Agreement() uint32
+	// DemotionThreshold is the number of consecutive disagreements after which a node is declared out of sync, zero
+	// disables the demotion
+	DemotionThreshold() uint32
+	// DemotionCooldown is the minimum time a demoted node stays out of sync, even if its head is in sync with the pool
+	DemotionCooldown() time.Duration
+}
+
+// ReadQuorumEnabled returns true if the results of the given method must be confirmed by a quorum of nodes.
+func ReadQuorumEnabled(cfg ReadQuorumConfig, method string) bool {
+	return cfg != nil && cfg.Enabled() && slices.Contains(cfg.Methods(), method)
+}
+
+// QuorumRead performs the read on up to cfg.Nodes() alive nodes concurrently, starting with the active one, and returns
+// the result of the first cfg.Agreement() nodes returning results with the same key. Nodes returning a different result
+// than the quorum are reported as disagreeing, and get demoted for cfg.DemotionCooldown() once they disagreed
+// cfg.DemotionThreshold() times in a row.
+// The read is passed the lowest ChainInfo of the queried nodes, so that reads relative to the tip of the chain can be
+// pinned to a block all the nodes have already seen.
+func QuorumRead[
+	CHAIN_ID types.ID,
+	RPC any,
+	RESULT any,
+](
+	ctx context.Context,
+	c *MultiNode[CHAIN_ID, RPC],
+	cfg ReadQuorumConfig,
+	method string,
+	read func(ctx context.Context, rpc RPC, pinned ChainInfo) (RESULT, error),
+	key func(RESULT) (string, error),
+) (result RESULT, err error) {
+	nodes, pinned, err := c.readQuorumNodes(int(cfg.Nodes()))
+	if err != nil {
+		return result, err
+	}
+	agreement := int(cfg.Agreement())
+	if len(nodes) < agreement {
+		promMultiNodeReadQuorumFailures.WithLabelValues(c.chainFamily, c.chainID.String(), method).Inc()
+		return result, fmt.Errorf("%w: %d alive nodes, %d agreeing results required", ErrReadQuorumNotReached, len(nodes), agreement)
+	}
+
+	type response struct {
+		result RESULT
+		key    string
+		err    error
+	}
+	responses := make([]response, len(nodes))
+	var wg sync.WaitGroup
+	wg.Add(len(nodes))
+	for i, n := range nodes {
+		go func() {
+			defer wg.Done()
+			r := &responses[i]
+			r.result, r.err = read(ctx, n.RPC(), pinned)
+			if r.err == nil {
+				r.key, r.err = key(r.result)
+			}
+		}()
+	}
+	wg.Wait()
+
+	votes := map[string]int{}
+	var winner string
+	var errs []error
+	for _, r := range responses {
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		votes[r.key]++
+		if votes[r.key] > votes[winner] {
+			winner = r.key
+		}
+	}
+	if votes[winner] < agreement {
+		promMultiNodeReadQuorumFailures.WithLabelValues(c.chainFamily, c.chainID.String(), method).Inc()
+		c.lggr.Warnw("Read quorum not reached", "method", method, "nodes", len(nodes), "agreeing", votes[winner], "required", agreement, "errs", errs)
+		return result, fmt.Errorf("%w: %d of %d nodes agreed on the result of %s, %d required: %w", ErrReadQuorumNotReached, votes[winner], len(nodes), method, agreement, errors.Join(errs...))
+	}
+
+	for i, r := range responses {
+		if r.err != nil {
+			continue // a failing node says nothing about the data it serves
+		}
+		agreed := r.key == winner
+		if !agreed {
+			c.lggr.Warnw("RPC node disagreed with the read quorum", "method", method, "node", nodes[i].String())
+		}
+		nodes[i].ReportReadQuorumResult(agreed, cfg.DemotionThreshold(), cfg.DemotionCooldown())
+		if agreed {
+			result = r.result
+		}
+	}
+	return result, nil
+}
+
+// readQuorumNodes returns up to n alive primary nodes, starting with the active one, and their lowest ChainInfo.
+func (c *MultiNode[CHAIN_ID, RPC]) readQuorumNodes(n int) (nodes []Node[CHAIN_ID, RPC], pinned ChainInfo, err error) {
+	active, err := c.selectNode()
+	if err != nil {
+		return nil, pinned, err
+	}
+	pinned = ChainInfo{BlockNumber: math.MaxInt64, FinalizedBlockNumber: math.MaxInt64}
+	add := func(node Node[CHAIN_ID, RPC]) {
+		state, chainInfo := node.StateAndLatest()
+		if state != nodeStateAlive {
+			return
+		}
+		nodes = append(nodes, node)
+		pinned.BlockNumber = min(pinned.BlockNumber, chainInfo.BlockNumber)
+		pinned.FinalizedBlockNumber = min(pinned.FinalizedBlockNumber, chainInfo.FinalizedBlockNumber)
+	}
+	add(active)
+	for _, node := range c.primaryNodes {
+		if len(nodes) >= n {
+			break
+		}
+		if node != active {
+			add(node)
+		}
+	}
+	return nodes, pinned, nil
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type testReadQuorumConfig struct {
+	nodes, agreement, demotionThreshold uint32
+	demotionCooldown                    time.Duration
+}
+
+func (c testReadQuorumConfig) Enabled() bool                   { return true }
+func (c testReadQuorumConfig) Methods() []string               { return []string{"Read"} }
+func (c testReadQuorumConfig) Nodes() uint32                   { return c.nodes }
+func (c testReadQuorumConfig) Agreement() uint32               { return c.agreement }
+func (c testReadQuorumConfig) DemotionThreshold() uint32       { return c.demotionThreshold }
+func (c testReadQuorumConfig) DemotionCooldown() time.Duration { return c.demotionCooldown }
+
+func TestReadQuorumEnabled(t *testing.T) {
+	t.Parallel()
+	cfg := testReadQuorumConfig{}
+	assert.True(t, ReadQuorumEnabled(cfg, "Read"))
+	assert.False(t, ReadQuorumEnabled(cfg, "Write"))
+	assert.False(t, ReadQuorumEnabled(nil, "Read"))
+}
+
+func TestQuorumRead(t *testing.T) {
+	t.Parallel()
+
+	type rpcResult struct {
+		result string
+		err    error
+	}
+	// newQuorumNode returns an alive node, which RPC returns the given result
+	newQuorumNode := func(t *testing.T, name string, blockNumber int64, result rpcResult) (*mockNode[types.ID, multiNodeRPCClient], multiNodeRPCClient) {
+		rpc := newMockRPCClient[types.ID, types.Head[Hashable]](t)
+		node := newMockNode[types.ID, multiNodeRPCClient](t)
+		node.On("String").Return(name).Maybe()
+		node.On("State").Return(nodeStateAlive).Maybe()
+		node.On("RequestBudgetNearlyExhausted").Return(false).Maybe()
+		node.On("StateAndLatest").Return(nodeStateAlive, ChainInfo{BlockNumber: blockNumber, FinalizedBlockNumber: blockNumber - 10}).Maybe()
+		node.On("RPC").Return(rpc).Maybe()
+		return node, rpc
+	}
+	newQuorumMultiNode := func(t *testing.T, results []rpcResult) (testMultiNode, []*mockNode[types.ID, multiNodeRPCClient], func(context.Context, multiNodeRPCClient, ChainInfo) (string, error)) {
+		var nodes []Node[types.ID, multiNodeRPCClient]
+		var mockNodes []*mockNode[types.ID, multiNodeRPCClient]
+		rpcResults := map[multiNodeRPCClient]rpcResult{}
+		for i, r := range results {
+			node, rpc := newQuorumNode(t, string(rune('a'+i)), int64(100+i), r)
+			nodes = append(nodes, node)
+			mockNodes = append(mockNodes, node)
+			rpcResults[rpc] = r
+		}
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       types.RandomID(),
+			nodes:         nodes,
+		})
+		read := func(_ context.Context, rpc multiNodeRPCClient, pinned ChainInfo) (string, error) {
+			assert.Equal(t, ChainInfo{BlockNumber: 100, FinalizedBlockNumber: 90}, pinned)
+			r := rpcResults[rpc]
+			return r.result, r.err
+		}
+		return mn, mockNodes, read
+	}
+	key := func(s string) (string, error) { return s, nil }
+
+	t.Run("returns the result the quorum agreed on", func(t *testing.T) {
+		t.Parallel()
+		mn, nodes, read := newQuorumMultiNode(t, []rpcResult{{result: "a"}, {result: "b"}, {result: "a"}})
+		nodes[0].On("ReportReadQuorumResult", true, uint32(3), time.Minute).Once()
+		nodes[1].On("ReportReadQuorumResult", false, uint32(3), time.Minute).Once()
+		nodes[2].On("ReportReadQuorumResult", true, uint32(3), time.Minute).Once()
+		result, err := QuorumRead(tests.Context(t), mn.MultiNode, testReadQuorumConfig{nodes: 3, agreement: 2, demotionThreshold: 3, demotionCooldown: time.Minute}, "Read", read, key)
+		require.NoError(t, err)
+		assert.Equal(t, "a", result)
+	})
+	t.Run("ignores failing nodes", func(t *testing.T) {
+		t.Parallel()
+		mn, nodes, read := newQuorumMultiNode(t, []rpcResult{{result: "a"}, {err: errors.New("boom")}, {result: "a"}})
+		nodes[0].On("ReportReadQuorumResult", true, uint32(0), time.Duration(0)).Once()
+		nodes[2].On("ReportReadQuorumResult", true, uint32(0), time.Duration(0)).Once()
+		result, err := QuorumRead(tests.Context(t), mn.MultiNode, testReadQuorumConfig{nodes: 3, agreement: 2}, "Read", read, key)
+		require.NoError(t, err)
+		assert.Equal(t, "a", result)
+	})
+	t.Run("queries only the configured number of nodes", func(t *testing.T) {
+		t.Parallel()
+		mn, nodes, read := newQuorumMultiNode(t, []rpcResult{{result: "a"}, {result: "a"}, {result: "b"}})
+		nodes[0].On("ReportReadQuorumResult", true, uint32(0), time.Duration(0)).Once()
+		nodes[1].On("ReportReadQuorumResult", true, uint32(0), time.Duration(0)).Once()
+		result, err := QuorumRead(tests.Context(t), mn.MultiNode, testReadQuorumConfig{nodes: 2, agreement: 2}, "Read", read, key)
+		require.NoError(t, err)
+		assert.Equal(t, "a", result)
+	})
+	t.Run("fails without agreement", func(t *testing.T) {
+		t.Parallel()
+		mn, _, read := newQuorumMultiNode(t, []rpcResult{{result: "a"}, {result: "b"}, {err: errors.New("boom")}})
+		_, err := QuorumRead(tests.Context(t), mn.MultiNode, testReadQuorumConfig{nodes: 3, agreement: 2}, "Read", read, key)
+		require.ErrorIs(t, err, ErrReadQuorumNotReached)
+		assert.ErrorContains(t, err, "boom")
+	})
+	t.Run("fails with fewer alive nodes than the agreement", func(t *testing.T) {
+		t.Parallel()
+		mn, _, read := newQuorumMultiNode(t, []rpcResult{{result: "a"}})
+		_, err := QuorumRead(tests.Context(t), mn.MultiNode, testReadQuorumConfig{nodes: 3, agreement: 2}, "Read", read, key)
+		require.ErrorIs(t, err, ErrReadQuorumNotReached)
+	})
+}
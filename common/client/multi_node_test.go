@@ -42,7 +42,7 @@ func newTestMultiNode(t *testing.T, opts multiNodeOpts) testMultiNode {
 	}
 
 	result := NewMultiNode[types.ID, multiNodeRPCClient](
-		opts.logger, opts.selectionMode, opts.leaseDuration, opts.nodes, opts.sendonlys, opts.chainID, opts.chainFamily, opts.deathDeclarationDelay)
+		opts.logger, opts.selectionMode, opts.leaseDuration, opts.nodes, opts.sendonlys, opts.chainID, opts.chainFamily, opts.deathDeclarationDelay, nil)
 	return testMultiNode{
 		result,
 	}
@@ -60,6 +60,7 @@ func newNodeWithState(t *testing.T, chainID types.ID, state nodeState) *mockNode
 	node.On("String").Return(fmt.Sprintf("healthy_node_%d", rand.Int())).Maybe()
 	node.On("SetPoolChainInfoProvider", mock.Anything).Once()
 	node.On("State").Return(state).Maybe()
+	node.On("RequestBudgetNearlyExhausted").Return(false).Maybe()
 	return node
 }
 
@@ -346,6 +347,7 @@ func TestMultiNode_selectNode(t *testing.T) {
 		node1 := newMockNode[types.ID, multiNodeRPCClient](t)
 		node1.On("State").Return(nodeStateAlive).Once()
 		node1.On("String").Return("node1").Maybe()
+		node1.On("RequestBudgetNearlyExhausted").Return(false).Maybe()
 		node2 := newMockNode[types.ID, multiNodeRPCClient](t)
 		node2.On("String").Return("node2").Maybe()
 		mn := newTestMultiNode(t, multiNodeOpts{
@@ -368,9 +370,11 @@ func TestMultiNode_selectNode(t *testing.T) {
 		chainID := types.RandomID()
 		oldBest := newMockNode[types.ID, multiNodeRPCClient](t)
 		oldBest.On("String").Return("oldBest").Maybe()
+		oldBest.On("RequestBudgetNearlyExhausted").Return(false).Maybe()
 		oldBest.On("UnsubscribeAllExceptAliveLoop")
 		newBest := newMockNode[types.ID, multiNodeRPCClient](t)
 		newBest.On("String").Return("newBest").Maybe()
+		newBest.On("RequestBudgetNearlyExhausted").Return(false).Maybe()
 		mn := newTestMultiNode(t, multiNodeOpts{
 			selectionMode: NodeSelectionModeRoundRobin,
 			chainID:       chainID,
@@ -389,6 +393,61 @@ func TestMultiNode_selectNode(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, newBest.String(), newActiveNode.String())
 	})
+	t.Run("Routes requests to another node once the request budget of the active one is nearly exhausted", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.RandomID()
+		exhausted := newMockNode[types.ID, multiNodeRPCClient](t)
+		exhausted.On("String").Return("exhausted").Maybe()
+		exhausted.On("State").Return(nodeStateAlive)
+		exhausted.On("UnsubscribeAllExceptAliveLoop").Once()
+		withinBudget := newMockNode[types.ID, multiNodeRPCClient](t)
+		withinBudget.On("String").Return("withinBudget").Maybe()
+		withinBudget.On("State").Return(nodeStateAlive)
+		withinBudget.On("RequestBudgetNearlyExhausted").Return(false)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       chainID,
+			nodes:         []Node[types.ID, multiNodeRPCClient]{exhausted, withinBudget},
+		})
+		nodeSelector := newMockNodeSelector[types.ID, multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(exhausted).Once()
+		mn.nodeSelector = nodeSelector
+		exhausted.On("RequestBudgetNearlyExhausted").Return(false).Once()
+		activeNode, err := mn.selectNode()
+		require.NoError(t, err)
+		require.Equal(t, exhausted.String(), activeNode.String())
+		// budget of the active node is nearly exhausted, and the selector still prefers it
+		exhausted.On("RequestBudgetNearlyExhausted").Return(true)
+		nodeSelector.On("Select").Return(exhausted).Once()
+		newActiveNode, err := mn.selectNode()
+		require.NoError(t, err)
+		require.Equal(t, withinBudget.String(), newActiveNode.String())
+	})
+	t.Run("Keeps the active node if all alive nodes are nearly out of budget", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.RandomID()
+		node1 := newMockNode[types.ID, multiNodeRPCClient](t)
+		node1.On("String").Return("node1").Maybe()
+		node1.On("State").Return(nodeStateAlive)
+		node1.On("RequestBudgetNearlyExhausted").Return(true)
+		node2 := newMockNode[types.ID, multiNodeRPCClient](t)
+		node2.On("String").Return("node2").Maybe()
+		node2.On("State").Return(nodeStateAlive)
+		node2.On("RequestBudgetNearlyExhausted").Return(true)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeRoundRobin,
+			chainID:       chainID,
+			nodes:         []Node[types.ID, multiNodeRPCClient]{node1, node2},
+		})
+		nodeSelector := newMockNodeSelector[types.ID, multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(node1).Twice()
+		mn.nodeSelector = nodeSelector
+		for i := 0; i < 2; i++ {
+			activeNode, err := mn.selectNode()
+			require.NoError(t, err)
+			require.Equal(t, node1.String(), activeNode.String())
+		}
+	})
 	t.Run("No active nodes - reports critical error", func(t *testing.T) {
 		t.Parallel()
 		chainID := types.RandomID()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -34,6 +35,10 @@ var (
 		Name: "pool_rpc_node_verifies_success",
 		Help: "The total number of successful chain ID verifications for the given RPC node",
 	}, []string{"network", "chainID", "nodeName"})
+	promPoolRPCNodeReadQuorumDisagreements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pool_rpc_node_read_quorum_disagreements",
+		Help: "The total number of read requests for which the given RPC node returned a different result than the quorum",
+	}, []string{"network", "chainID", "nodeName"})
 )
 
 type NodeConfig interface {
@@ -79,6 +84,15 @@ type Node[
 	ConfiguredChainID() CHAIN_ID
 	// Order - returns priority order configured for the RPC
 	Order() int32
+	// RPCStats - returns the rolling latency and error statistics of the calls made to the RPC
+	RPCStats() RPCStats
+	// ReportReadQuorumResult - records whether the RPC agreed with the quorum on the result of a read request. The node
+	// is declared out of sync for at least demotionCooldown once it disagreed demotionThreshold consecutive times, zero
+	// disables the demotion.
+	ReportReadQuorumResult(agreed bool, demotionThreshold uint32, demotionCooldown time.Duration)
+	// RequestBudgetNearlyExhausted - returns true if the request budget of the RPC is nearly exhausted, so that requests
+	// should be routed to other nodes
+	RequestBudgetNearlyExhausted() bool
 	// Start - starts health checks
 	Start(context.Context) error
 	Close() error
@@ -114,6 +128,15 @@ type node[
 	wg sync.WaitGroup
 
 	healthCheckSubs []types.Subscription
+
+	stats *rpcStats
+
+	// readQuorumDisagreements counts the consecutive read quorum disagreements of the RPC
+	readQuorumDisagreements atomic.Uint32
+	// demoteCh signals aliveLoop that the RPC returned wrong data and must be declared out of sync for the cooldown
+	demoteCh chan time.Duration
+	// demotedUntil is the end of the cooldown of the last demotion, protected by stateMu
+	demotedUntil time.Time
 }
 
 func NewNode[
@@ -147,6 +170,7 @@ func NewNode[
 		n.http = httpuri
 	}
 	n.stopCh = make(services.StopChan)
+	n.demoteCh = make(chan time.Duration, 1)
 	lggr = logger.Named(lggr, "Node")
 	lggr = logger.With(lggr,
 		"nodeTier", Primary.String(),
@@ -158,6 +182,10 @@ func NewNode[
 	n.lfcLog = logger.Named(lggr, "Lifecycle")
 	n.rpc = rpc
 	n.chainFamily = chainFamily
+	n.stats = newRPCStats()
+	if observable, ok := any(rpc).(ObservableRPC); ok {
+		observable.SetCallObserver(n.stats.observe)
+	}
 	return n
 }
 
@@ -329,6 +357,33 @@ func (n *node[CHAIN_ID, HEAD, RPC]) Order() int32 {
 	return n.order
 }
 
+func (n *node[CHAIN_ID, HEAD, RPC]) RPCStats() RPCStats {
+	return n.stats.snapshot()
+}
+
+func (n *node[CHAIN_ID, HEAD, RPC]) RequestBudgetNearlyExhausted() bool {
+	if budgeted, ok := any(n.rpc).(BudgetedRPC); ok {
+		return budgeted.RequestBudgetNearlyExhausted()
+	}
+	return false
+}
+
+func (n *node[CHAIN_ID, HEAD, RPC]) ReportReadQuorumResult(agreed bool, demotionThreshold uint32, demotionCooldown time.Duration) {
+	if agreed {
+		n.readQuorumDisagreements.Store(0)
+		return
+	}
+	promPoolRPCNodeReadQuorumDisagreements.WithLabelValues(n.chainFamily, n.chainID.String(), n.name).Inc()
+	if demotionThreshold == 0 || n.readQuorumDisagreements.Add(1) < demotionThreshold {
+		return
+	}
+	n.readQuorumDisagreements.Store(0)
+	select {
+	case n.demoteCh <- demotionCooldown:
+	default: // demotion already pending
+	}
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) newCtx() (context.Context, context.CancelFunc) {
 	ctx, cancel := n.stopCh.NewCtx()
 	ctx = CtxAddHealthCheckFlag(ctx)
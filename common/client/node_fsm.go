@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -254,6 +255,18 @@ func (n *node[CHAIN_ID, HEAD, RPC]) declareOutOfSync(syncIssues syncStatus) {
 	})
 }
 
+// declareDemoted puts a node into OutOfSync state for at least the cooldown, after it repeatedly returned different
+// results than the read quorum. Until then it stays out of sync even if its head is in sync with the pool.
+func (n *node[CHAIN_ID, HEAD, RPC]) declareDemoted(cooldown time.Duration) {
+	n.transitionToOutOfSync(func() {
+		n.demotedUntil = time.Now().Add(cooldown)
+		syncIssues := syncStatusDemoted | syncStatusNotInSyncWithPool
+		n.lfcLog.Errorw("RPC Node is out of sync", "nodeState", n.state, "syncIssues", syncIssues, "demotionCooldown", cooldown)
+		n.wg.Add(1)
+		go n.outOfSyncLoop(syncIssues)
+	})
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) transitionToOutOfSync(fn func()) {
 	promPoolRPCNodeTransitionsToOutOfSync.WithLabelValues(n.chainID.String(), n.name).Inc()
 	n.stateMu.Lock()
@@ -179,6 +179,16 @@ func (n *node[CHAIN_ID, HEAD, RPC]) aliveLoop() {
 				n.declareOutOfSync(syncStatusNotInSyncWithPool)
 				return
 			}
+		case cooldown := <-n.demoteCh:
+			lggr.Errorw("RPC endpoint repeatedly disagreed with the read quorum of other RPC endpoints", "nodeState", n.getCachedState())
+			if n.poolInfoProvider != nil {
+				if l, _ := n.poolInfoProvider.LatestChainInfo(); l < 2 {
+					lggr.Criticalf("RPC endpoint disagreed with the read quorum; %s %s", msgCannotDisable, msgDegradedState)
+					continue
+				}
+			}
+			n.declareDemoted(cooldown)
+			return
 		case bh, open := <-headsSub.Heads:
 			if !open {
 				lggr.Errorw("Subscription channel unexpectedly closed", "nodeState", n.getCachedState())
@@ -371,7 +381,7 @@ func (n *node[CHAIN_ID, HEAD, RPC]) isOutOfSyncWithPool(localState ChainInfo) (o
 	ln, ci := n.poolInfoProvider.LatestChainInfo()
 	mode := n.nodePoolCfg.SelectionMode()
 	switch mode {
-	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel:
+	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeLatencyWeighted:
 		return localState.BlockNumber < ci.BlockNumber-int64(threshold), ln
 	case NodeSelectionModeTotalDifficulty:
 		bigThreshold := big.NewInt(int64(threshold))
@@ -439,6 +449,16 @@ func (n *node[CHAIN_ID, HEAD, RPC]) outOfSyncLoop(syncIssues syncStatus) {
 		lggr.Tracew("Successfully subscribed to finalized heads feed on out-of-sync RPC node")
 	}
 
+	var demotionElapsed <-chan time.Time
+	if syncIssues&syncStatusDemoted != 0 {
+		n.stateMu.RLock()
+		cooldown := time.Until(n.demotedUntil)
+		n.stateMu.RUnlock()
+		timer := time.NewTimer(cooldown)
+		defer timer.Stop()
+		demotionElapsed = timer.C
+	}
+
 	_, localHighestChainInfo := n.rpc.GetInterceptedChainInfo()
 	for {
 		if syncIssues == syncStatusSynced {
@@ -485,6 +505,9 @@ func (n *node[CHAIN_ID, HEAD, RPC]) outOfSyncLoop(syncIssues syncStatus) {
 					return
 				}
 			}
+		case <-demotionElapsed:
+			syncIssues &= ^syncStatusDemoted
+			lggr.Debugw("Demotion cooldown elapsed", "syncIssues", syncIssues)
 		case err := <-headsSub.Errors:
 			lggr.Errorw("Subscription was terminated", "err", err)
 			n.declareUnreachable()
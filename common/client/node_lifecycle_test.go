@@ -7,6 +7,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/rand"
 	prom "github.com/prometheus/client_model/go"
@@ -264,6 +265,54 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 		node.declareAlive()
 		tests.AssertLogEventually(t, observedLogs, fmt.Sprintf("RPC endpoint has fallen behind; %s %s", msgCannotDisable, msgDegradedState))
 	})
+	t.Run("when repeatedly disagreeing with the read quorum, transitions to out of sync", func(t *testing.T) {
+		t.Parallel()
+		rpc := newMockRPCClient[types.ID, Head](t)
+		lggr, observedLogs := logger.TestObserved(t, zap.DebugLevel)
+		node := newSubscribedNode(t, testNodeOpts{
+			config: testNodeConfig{},
+			rpc:    rpc,
+			lggr:   lggr,
+		})
+		defer func() { assert.NoError(t, node.close()) }()
+		poolInfo := newMockPoolChainInfoProvider(t)
+		poolInfo.On("LatestChainInfo").Return(3, ChainInfo{}).Once()
+		node.SetPoolChainInfoProvider(poolInfo)
+		rpc.On("GetInterceptedChainInfo").Return(ChainInfo{}, ChainInfo{}).Maybe()
+		// tries to redial in outOfSync
+		rpc.On("Dial", mock.Anything).Return(errors.New("failed to dial")).Run(func(_ mock.Arguments) {
+			assert.Equal(t, nodeStateOutOfSync, node.State())
+		}).Once()
+		rpc.On("Close").Maybe()
+		rpc.On("Dial", mock.Anything).Return(errors.New("failed to dial")).Maybe()
+		node.declareAlive()
+		node.ReportReadQuorumResult(false, 2, time.Minute)
+		node.ReportReadQuorumResult(true, 2, time.Minute)
+		node.ReportReadQuorumResult(false, 2, time.Minute)
+		assert.Equal(t, nodeStateAlive, node.State())
+		node.ReportReadQuorumResult(false, 2, time.Minute)
+		tests.AssertLogEventually(t, observedLogs, "RPC endpoint repeatedly disagreed with the read quorum of other RPC endpoints")
+		tests.AssertLogEventually(t, observedLogs, "Dial failed: Node is unreachable")
+	})
+	t.Run("when repeatedly disagreeing with the read quorum but we are the last live node, forcibly stays alive", func(t *testing.T) {
+		t.Parallel()
+		rpc := newMockRPCClient[types.ID, Head](t)
+		lggr, observedLogs := logger.TestObserved(t, zap.DebugLevel)
+		node := newSubscribedNode(t, testNodeOpts{
+			config: testNodeConfig{},
+			rpc:    rpc,
+			lggr:   lggr,
+		})
+		defer func() { assert.NoError(t, node.close()) }()
+		poolInfo := newMockPoolChainInfoProvider(t)
+		poolInfo.On("LatestChainInfo").Return(1, ChainInfo{}).Once()
+		node.SetPoolChainInfoProvider(poolInfo)
+		rpc.On("GetInterceptedChainInfo").Return(ChainInfo{}, ChainInfo{}).Maybe()
+		node.declareAlive()
+		node.ReportReadQuorumResult(false, 1, time.Minute)
+		tests.AssertLogEventually(t, observedLogs, fmt.Sprintf("RPC endpoint disagreed with the read quorum; %s %s", msgCannotDisable, msgDegradedState))
+		assert.Equal(t, nodeStateAlive, node.State())
+	})
 	t.Run("when behind but SyncThreshold=0, stay alive", func(t *testing.T) {
 		t.Parallel()
 		rpc := newMockRPCClient[types.ID, Head](t)
@@ -889,6 +938,43 @@ func TestUnit_NodeLifecycle_outOfSyncLoop(t *testing.T) {
 			return node.State() == nodeStateAlive
 		})
 	})
+	t.Run("when demoted, stays out-of-sync until the cooldown elapsed even if its head is in sync", func(t *testing.T) {
+		t.Parallel()
+		rpc := newMockRPCClient[types.ID, Head](t)
+		nodeChainID := types.RandomID()
+		lggr, observedLogs := logger.TestObserved(t, zap.DebugLevel)
+		node := newAliveNode(t, testNodeOpts{
+			rpc:     rpc,
+			chainID: nodeChainID,
+			lggr:    lggr,
+		})
+		defer func() { assert.NoError(t, node.close()) }()
+
+		rpc.On("Dial", mock.Anything).Return(nil).Once()
+		rpc.On("ChainID", mock.Anything).Return(nodeChainID, nil).Once()
+
+		outOfSyncSubscription := mocks.NewSubscription(t)
+		outOfSyncSubscription.On("Err").Return((<-chan error)(nil))
+		outOfSyncSubscription.On("Unsubscribe").Once()
+		const highestBlock = 1000
+		ch := make(chan Head)
+		rpc.On("SubscribeToHeads", mock.Anything).Run(func(args mock.Arguments) {
+			go writeHeads(t, ch, head{BlockNumber: highestBlock - 1}, head{BlockNumber: highestBlock}, head{BlockNumber: highestBlock + 1})
+		}).Return((<-chan Head)(ch), outOfSyncSubscription, nil).Once()
+		rpc.On("GetInterceptedChainInfo").Return(ChainInfo{BlockNumber: highestBlock}, ChainInfo{BlockNumber: highestBlock})
+		setupRPCForAliveLoop(t, rpc)
+
+		const cooldown = time.Second
+		demotedAt := time.Now()
+		node.declareDemoted(cooldown)
+		tests.AssertLogEventually(t, observedLogs, msgReceivedBlock)
+		assert.Equal(t, nodeStateOutOfSync, node.State())
+		tests.AssertLogEventually(t, observedLogs, "Demotion cooldown elapsed")
+		tests.AssertEventually(t, func() bool {
+			return node.State() == nodeStateAlive
+		})
+		assert.GreaterOrEqual(t, time.Since(demotedAt), cooldown)
+	})
 	t.Run("becomes alive if there is no other nodes", func(t *testing.T) {
 		t.Parallel()
 		rpc := newMockRPCClient[types.ID, Head](t)
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// rpcStatsLatencyWindow is the number of most recent calls used to compute the latency percentiles
+	rpcStatsLatencyWindow = 256
+	// rpcStatsErrorWindow is the number of most recent calls of each method used to compute its error ratio
+	rpcStatsErrorWindow = 64
+)
+
+// ObservableRPC is implemented by RPCs reporting the latency and result of their calls, so that Node can collect the
+// RPCStats used by NodeSelectionModeLatencyWeighted.
+type ObservableRPC interface {
+	// SetCallObserver sets the function to be called once each call to the RPC completes.
+	SetCallObserver(observer func(method string, latency time.Duration, err error))
+}
+
+// RPCStats are the rolling statistics of the calls made to the RPC of a Node.
+type RPCStats struct {
+	// Calls is the number of calls the latency percentiles are computed from
+	Calls      int
+	LatencyP50 time.Duration
+	LatencyP99 time.Duration
+	// ErrorRatio is the mean of the error ratios of the methods called, so that a method failing consistently is
+	// not hidden by the volume of other calls
+	ErrorRatio float64
+}
+
+// rpcStats collects the latency of the calls and the outcome of the calls of each method, over a rolling window of the
+// most recent calls. Failed calls count towards the latency too, a node timing out must not look fast.
+type rpcStats struct {
+	mu        sync.Mutex
+	latencies ring[time.Duration]
+	failures  map[string]*ring[bool]
+}
+
+func newRPCStats() *rpcStats {
+	return &rpcStats{
+		latencies: newRing[time.Duration](rpcStatsLatencyWindow),
+		failures:  map[string]*ring[bool]{},
+	}
+}
+
+func (s *rpcStats) observe(method string, latency time.Duration, err error) {
+	if errors.Is(err, context.Canceled) {
+		return // cancelled by the caller, it says nothing about the RPC
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latencies.add(latency)
+	failures, ok := s.failures[method]
+	if !ok {
+		r := newRing[bool](rpcStatsErrorWindow)
+		failures = &r
+		s.failures[method] = failures
+	}
+	failures.add(err != nil)
+}
+
+func (s *rpcStats) snapshot() (stats RPCStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	latencies := s.latencies.values()
+	stats.Calls = len(latencies)
+	if len(latencies) > 0 {
+		slices.Sort(latencies)
+		stats.LatencyP50 = percentile(latencies, 50)
+		stats.LatencyP99 = percentile(latencies, 99)
+	}
+	if len(s.failures) > 0 {
+		var sum float64
+		for _, failures := range s.failures {
+			var failed int
+			outcomes := failures.values()
+			for _, f := range outcomes {
+				if f {
+					failed++
+				}
+			}
+			sum += float64(failed) / float64(len(outcomes))
+		}
+		stats.ErrorRatio = sum / float64(len(s.failures))
+	}
+	return
+}
+
+// percentile returns the nearest-rank percentile p of the sorted values.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	idx := (len(sorted)*p+99)/100 - 1
+	return sorted[max(idx, 0)]
+}
+
+// ring is a fixed size buffer keeping the most recent values added to it.
+type ring[T any] struct {
+	buf  []T
+	next int
+	full bool
+}
+
+func newRing[T any](size int) ring[T] {
+	return ring[T]{buf: make([]T, size)}
+}
+
+func (r *ring[T]) add(v T) {
+	r.buf[r.next] = v
+	r.next = (r.next + 1) % len(r.buf)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+func (r *ring[T]) values() []T {
+	if r.full {
+		return slices.Clone(r.buf)
+	}
+	return slices.Clone(r.buf[:r.next])
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, RPCStats{}, newRPCStats().snapshot())
+	})
+
+	t.Run("computes the latency percentiles of the calls", func(t *testing.T) {
+		s := newRPCStats()
+		for i := 100; i >= 1; i-- {
+			s.observe("eth_call", time.Duration(i)*time.Millisecond, nil)
+		}
+
+		stats := s.snapshot()
+		assert.Equal(t, 100, stats.Calls)
+		assert.Equal(t, 50*time.Millisecond, stats.LatencyP50)
+		assert.Equal(t, 99*time.Millisecond, stats.LatencyP99)
+	})
+
+	t.Run("includes the latency of the failed calls", func(t *testing.T) {
+		s := newRPCStats()
+		for i := 0; i < 10; i++ {
+			s.observe("eth_call", time.Millisecond, nil)
+		}
+		for i := 0; i < 10; i++ {
+			s.observe("eth_call", 10*time.Second, errors.New("timeout"))
+		}
+
+		stats := s.snapshot()
+		assert.Equal(t, 20, stats.Calls)
+		assert.Equal(t, 10*time.Second, stats.LatencyP99)
+		assert.InDelta(t, 0.5, stats.ErrorRatio, 1e-9)
+	})
+
+	t.Run("keeps the most recent calls only", func(t *testing.T) {
+		s := newRPCStats()
+		for i := 0; i < rpcStatsLatencyWindow; i++ {
+			s.observe("eth_call", time.Hour, nil)
+		}
+		for i := 0; i < rpcStatsLatencyWindow; i++ {
+			s.observe("eth_call", time.Millisecond, nil)
+		}
+		stats := s.snapshot()
+		assert.Equal(t, rpcStatsLatencyWindow, stats.Calls)
+		assert.Equal(t, time.Millisecond, stats.LatencyP99)
+	})
+
+	t.Run("averages the error ratios of the methods", func(t *testing.T) {
+		s := newRPCStats()
+		for i := 0; i < 30; i++ {
+			s.observe("eth_call", time.Millisecond, nil)
+		}
+		// a rarely called method failing consistently
+		s.observe("eth_getLogs", time.Millisecond, errors.New("too many results"))
+		s.observe("eth_chainId", time.Millisecond, fmt.Errorf("dial: %w", errors.New("refused")))
+		s.observe("eth_chainId", time.Millisecond, nil)
+
+		assert.InDelta(t, (0+1+0.5)/3.0, s.snapshot().ErrorRatio, 1e-9)
+	})
+
+	t.Run("ignores the calls cancelled by the caller", func(t *testing.T) {
+		s := newRPCStats()
+		s.observe("eth_call", time.Millisecond, context.Canceled)
+		assert.Equal(t, RPCStats{}, s.snapshot())
+	})
+}
@@ -11,6 +11,7 @@ const (
 	NodeSelectionModeRoundRobin      = "RoundRobin"
 	NodeSelectionModeTotalDifficulty = "TotalDifficulty"
 	NodeSelectionModePriorityLevel   = "PriorityLevel"
+	NodeSelectionModeLatencyWeighted = "LatencyWeighted"
 )
 
 type NodeSelector[
@@ -27,7 +28,7 @@ type NodeSelector[
 func newNodeSelector[
 	CHAIN_ID types.ID,
 	RPC any,
-](selectionMode string, nodes []Node[CHAIN_ID, RPC]) NodeSelector[CHAIN_ID, RPC] {
+](selectionMode string, nodes []Node[CHAIN_ID, RPC], latencyWeightedCfg LatencyWeightedConfig) NodeSelector[CHAIN_ID, RPC] {
 	switch selectionMode {
 	case NodeSelectionModeHighestHead:
 		return NewHighestHeadNodeSelector[CHAIN_ID, RPC](nodes)
@@ -37,6 +38,8 @@ func newNodeSelector[
 		return NewTotalDifficultyNodeSelector[CHAIN_ID, RPC](nodes)
 	case NodeSelectionModePriorityLevel:
 		return NewPriorityLevelNodeSelector[CHAIN_ID, RPC](nodes)
+	case NodeSelectionModeLatencyWeighted:
+		return NewLatencyWeightedNodeSelector[CHAIN_ID, RPC](nodes, latencyWeightedCfg)
 	default:
 		panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", selectionMode))
 	}
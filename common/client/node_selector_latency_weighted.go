@@ -0,0 +1,93 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// LatencyWeightedConfig configures how NodeSelectionModeLatencyWeighted scores the nodes.
+type LatencyWeightedConfig interface {
+	// LatencyP50Weight is the weight of the median latency of a node in its score
+	LatencyP50Weight() float64
+	// LatencyP99Weight is the weight of the 99th percentile latency of a node in its score
+	LatencyP99Weight() float64
+	// ErrorRatePenalty is added to the score of a node failing all its calls, proportionally to its error ratio
+	ErrorRatePenalty() time.Duration
+	// Hysteresis is the fraction by which the score of another node must be lower than the score of the selected node
+	// to switch to it
+	Hysteresis() float64
+}
+
+// unmeasuredLatency is the latency assumed for a node which was not called yet. It is pessimistic, so that a node
+// is only preferred once the calls of its health checks have shown it to be faster.
+const unmeasuredLatency = time.Second
+
+// LatencyWeightedScore returns the score of a node with the given RPC statistics. Lower is better.
+func LatencyWeightedScore(stats RPCStats, cfg LatencyWeightedConfig) time.Duration {
+	if stats.Calls == 0 {
+		stats.LatencyP50, stats.LatencyP99 = unmeasuredLatency, unmeasuredLatency
+	}
+	return time.Duration(cfg.LatencyP50Weight()*float64(stats.LatencyP50) +
+		cfg.LatencyP99Weight()*float64(stats.LatencyP99) +
+		stats.ErrorRatio*float64(cfg.ErrorRatePenalty()))
+}
+
+type latencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+] struct {
+	nodes []Node[CHAIN_ID, RPC]
+	cfg   LatencyWeightedConfig
+
+	mu       sync.Mutex
+	selected Node[CHAIN_ID, RPC]
+}
+
+func NewLatencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+](nodes []Node[CHAIN_ID, RPC], cfg LatencyWeightedConfig) NodeSelector[CHAIN_ID, RPC] {
+	return &latencyWeightedNodeSelector[CHAIN_ID, RPC]{
+		nodes: nodes,
+		cfg:   cfg,
+	}
+}
+
+// Select returns the alive node with the lowest score, using the order of the nodes as a tie-breaker. The previously
+// selected node is kept unless another node scores lower by more than the configured hysteresis, to avoid flapping
+// between nodes performing alike.
+// Nodes which were not called yet score as if their latency was unmeasuredLatency.
+func (s *latencyWeightedNodeSelector[CHAIN_ID, RPC]) Select() Node[CHAIN_ID, RPC] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var best Node[CHAIN_ID, RPC]
+	var bestScore, selectedScore time.Duration
+	selectedAlive := false
+	for _, n := range s.nodes {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		score := LatencyWeightedScore(n.RPCStats(), s.cfg)
+		if n == s.selected {
+			selectedAlive = true
+			selectedScore = score
+		}
+		if best == nil || score < bestScore || (score == bestScore && n.Order() < best.Order()) {
+			best = n
+			bestScore = score
+		}
+	}
+
+	if selectedAlive && float64(bestScore) >= float64(selectedScore)*(1-s.cfg.Hysteresis()) {
+		return s.selected
+	}
+	s.selected = best
+	return best
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, RPC]) Name() string {
+	return NodeSelectionModeLatencyWeighted
+}
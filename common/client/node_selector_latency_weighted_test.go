@@ -0,0 +1,137 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type testLatencyWeightedConfig struct {
+	p50Weight        float64
+	p99Weight        float64
+	errorRatePenalty time.Duration
+	hysteresis       float64
+}
+
+func (c testLatencyWeightedConfig) LatencyP50Weight() float64       { return c.p50Weight }
+func (c testLatencyWeightedConfig) LatencyP99Weight() float64       { return c.p99Weight }
+func (c testLatencyWeightedConfig) ErrorRatePenalty() time.Duration { return c.errorRatePenalty }
+func (c testLatencyWeightedConfig) Hysteresis() float64             { return c.hysteresis }
+
+var defaultLatencyWeightedConfig = testLatencyWeightedConfig{
+	p50Weight:        1,
+	p99Weight:        0.5,
+	errorRatePenalty: 5 * time.Second,
+	hysteresis:       0.2,
+}
+
+func TestLatencyWeightedNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeLatencyWeighted, nil, defaultLatencyWeightedConfig)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLatencyWeighted)
+}
+
+func TestLatencyWeightedScore(t *testing.T) {
+	t.Parallel()
+
+	stats := RPCStats{Calls: 10, LatencyP50: 100 * time.Millisecond, LatencyP99: time.Second, ErrorRatio: 0.1}
+	// 100ms + 0.5 * 1s + 0.1 * 5s
+	assert.Equal(t, 1100*time.Millisecond, LatencyWeightedScore(stats, defaultLatencyWeightedConfig))
+	// unmeasured: 1s + 0.5 * 1s
+	assert.Equal(t, 1500*time.Millisecond, LatencyWeightedScore(RPCStats{}, defaultLatencyWeightedConfig))
+}
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient RPCClient[types.ID, Head]
+	type testNode struct {
+		state nodeState
+		order int32
+		stats RPCStats
+	}
+	newNodes := func(t *testing.T, specs ...*testNode) []Node[types.ID, nodeClient] {
+		var nodes []Node[types.ID, nodeClient]
+		for _, spec := range specs {
+			node := newMockNode[types.ID, nodeClient](t)
+			node.On("State").Return(func() nodeState { return spec.state }).Maybe()
+			node.On("Order").Return(spec.order).Maybe()
+			node.On("RPCStats").Return(func() RPCStats { return spec.stats }).Maybe()
+			nodes = append(nodes, node)
+		}
+		return nodes
+	}
+	latency := func(p50, p99 time.Duration, errorRatio float64) RPCStats {
+		return RPCStats{Calls: 100, LatencyP50: p50, LatencyP99: p99, ErrorRatio: errorRatio}
+	}
+
+	t.Run("selects the alive node with the lowest score", func(t *testing.T) {
+		slowInSync := &testNode{state: nodeStateAlive, order: 1, stats: latency(500*time.Millisecond, 2*time.Second, 0)}
+		fast := &testNode{state: nodeStateAlive, order: 2, stats: latency(50*time.Millisecond, 200*time.Millisecond, 0)}
+		fastOutOfSync := &testNode{state: nodeStateOutOfSync, order: 3, stats: latency(10*time.Millisecond, 20*time.Millisecond, 0)}
+		nodes := newNodes(t, slowInSync, fast, fastOutOfSync)
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes, defaultLatencyWeightedConfig)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("penalizes nodes failing calls", func(t *testing.T) {
+		fastFailing := &testNode{state: nodeStateAlive, order: 1, stats: latency(50*time.Millisecond, 200*time.Millisecond, 0.5)}
+		slower := &testNode{state: nodeStateAlive, order: 2, stats: latency(300*time.Millisecond, time.Second, 0)}
+		nodes := newNodes(t, fastFailing, slower)
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes, defaultLatencyWeightedConfig)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("uses the order as a tie-breaker", func(t *testing.T) {
+		a := &testNode{state: nodeStateAlive, order: 2, stats: latency(time.Second, time.Second, 0)}
+		b := &testNode{state: nodeStateAlive, order: 1, stats: latency(time.Second, time.Second, 0)}
+		nodes := newNodes(t, a, b)
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes, defaultLatencyWeightedConfig)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("keeps the selected node unless another one is better by more than the hysteresis", func(t *testing.T) {
+		a := &testNode{state: nodeStateAlive, order: 1, stats: latency(100*time.Millisecond, 0, 0)}
+		b := &testNode{state: nodeStateAlive, order: 2, stats: latency(200*time.Millisecond, 0, 0)}
+		nodes := newNodes(t, a, b)
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes, defaultLatencyWeightedConfig)
+		assert.Same(t, nodes[0], selector.Select())
+
+		// b is better, but by less than 20%
+		b.stats = latency(85*time.Millisecond, 0, 0)
+		assert.Same(t, nodes[0], selector.Select())
+
+		// b is better by more than 20%
+		b.stats = latency(75*time.Millisecond, 0, 0)
+		assert.Same(t, nodes[1], selector.Select())
+
+		// the selected node is not alive anymore
+		b.state = nodeStateUnreachable
+		assert.Same(t, nodes[0], selector.Select())
+	})
+
+	t.Run("does not prefer nodes which were not called yet over fast nodes", func(t *testing.T) {
+		unmeasured := &testNode{state: nodeStateAlive, order: 1}
+		fast := &testNode{state: nodeStateAlive, order: 2, stats: latency(50*time.Millisecond, 200*time.Millisecond, 0)}
+		nodes := newNodes(t, unmeasured, fast)
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes, defaultLatencyWeightedConfig)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("returns nil if no node is alive", func(t *testing.T) {
+		nodes := newNodes(t,
+			&testNode{state: nodeStateOutOfSync, stats: latency(time.Millisecond, time.Millisecond, 0)},
+			&testNode{state: nodeStateUnreachable},
+		)
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes, defaultLatencyWeightedConfig)
+		assert.Nil(t, selector.Select())
+	})
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func TestPriorityLevelNodeSelectorName(t *testing.T) {
-	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModePriorityLevel, nil)
+	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModePriorityLevel, nil, nil)
 	assert.Equal(t, selector.Name(), NodeSelectionModePriorityLevel)
 }
 
@@ -74,7 +74,7 @@ func TestPriorityLevelNodeSelector(t *testing.T) {
 				nodes = append(nodes, node)
 			}
 
-			selector := newNodeSelector(NodeSelectionModePriorityLevel, nodes)
+			selector := newNodeSelector(NodeSelectionModePriorityLevel, nodes, nil)
 			for _, idx := range tc.expect {
 				if idx >= len(nodes) {
 					t.Fatalf("Invalid node index %d in test case '%s'", idx, tc.name)
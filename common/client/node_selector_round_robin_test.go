@@ -9,7 +9,7 @@ import (
 )
 
 func TestRoundRobinNodeSelectorName(t *testing.T) {
-	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeRoundRobin, nil)
+	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeRoundRobin, nil, nil)
 	assert.Equal(t, selector.Name(), NodeSelectionModeRoundRobin)
 }
 
@@ -31,7 +31,7 @@ func TestRoundRobinNodeSelector(t *testing.T) {
 		nodes = append(nodes, node)
 	}
 
-	selector := newNodeSelector(NodeSelectionModeRoundRobin, nodes)
+	selector := newNodeSelector(NodeSelectionModeRoundRobin, nodes, nil)
 	assert.Same(t, nodes[1], selector.Select())
 	assert.Same(t, nodes[2], selector.Select())
 	assert.Same(t, nodes[1], selector.Select())
@@ -56,6 +56,6 @@ func TestRoundRobinNodeSelector_None(t *testing.T) {
 		nodes = append(nodes, node)
 	}
 
-	selector := newNodeSelector(NodeSelectionModeRoundRobin, nodes)
+	selector := newNodeSelector(NodeSelectionModeRoundRobin, nodes, nil)
 	assert.Nil(t, selector.Select())
 }
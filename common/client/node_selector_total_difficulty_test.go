@@ -10,7 +10,7 @@ import (
 )
 
 func TestTotalDifficultyNodeSelectorName(t *testing.T) {
-	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeTotalDifficulty, nil)
+	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeTotalDifficulty, nil, nil)
 	assert.Equal(t, selector.Name(), NodeSelectionModeTotalDifficulty)
 }
 
@@ -36,7 +36,7 @@ func TestTotalDifficultyNodeSelector(t *testing.T) {
 		nodes = append(nodes, node)
 	}
 
-	selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes)
+	selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes, nil)
 	assert.Same(t, nodes[2], selector.Select())
 
 	t.Run("stick to the same node", func(t *testing.T) {
@@ -46,7 +46,7 @@ func TestTotalDifficultyNodeSelector(t *testing.T) {
 		node.On("Order").Maybe().Return(int32(1))
 		nodes = append(nodes, node)
 
-		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes)
+		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes, nil)
 		assert.Same(t, nodes[2], selector.Select())
 	})
 
@@ -57,7 +57,7 @@ func TestTotalDifficultyNodeSelector(t *testing.T) {
 		node.On("Order").Maybe().Return(int32(1))
 		nodes = append(nodes, node)
 
-		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes)
+		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes, nil)
 		assert.Same(t, nodes[4], selector.Select())
 	})
 
@@ -70,7 +70,7 @@ func TestTotalDifficultyNodeSelector(t *testing.T) {
 		node2.On("Order").Maybe().Return(int32(1))
 		nodes := []Node[types.ID, nodeClient]{node1, node2}
 
-		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes)
+		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes, nil)
 		assert.Same(t, node1, selector.Select())
 	})
 }
@@ -93,7 +93,7 @@ func TestTotalDifficultyNodeSelector_None(t *testing.T) {
 		nodes = append(nodes, node)
 	}
 
-	selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes)
+	selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes, nil)
 	assert.Nil(t, selector.Select())
 }
 
@@ -110,7 +110,7 @@ func TestTotalDifficultyNodeSelectorWithOrder(t *testing.T) {
 			node.On("Order").Return(int32(2))
 			nodes = append(nodes, node)
 		}
-		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes)
+		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes, nil)
 		// Should select the first node because all things are equal
 		assert.Same(t, nodes[0], selector.Select())
 	})
@@ -129,7 +129,7 @@ func TestTotalDifficultyNodeSelectorWithOrder(t *testing.T) {
 		node3.On("Order").Return(int32(2))
 
 		nodes := []Node[types.ID, nodeClient]{node1, node2, node3}
-		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes)
+		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes, nil)
 		// Should select the second node as it has the highest priority
 		assert.Same(t, nodes[1], selector.Select())
 	})
@@ -148,7 +148,7 @@ func TestTotalDifficultyNodeSelectorWithOrder(t *testing.T) {
 		node3.On("Order").Return(int32(3))
 
 		nodes := []Node[types.ID, nodeClient]{node1, node2, node3}
-		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes)
+		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes, nil)
 		// Should select the third node as it has the highest td
 		assert.Same(t, nodes[2], selector.Select())
 	})
@@ -171,7 +171,7 @@ func TestTotalDifficultyNodeSelectorWithOrder(t *testing.T) {
 		node4.On("Order").Maybe().Return(int32(2))
 
 		nodes := []Node[types.ID, nodeClient]{node1, node2, node3, node4}
-		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes)
+		selector := newNodeSelector(NodeSelectionModeTotalDifficulty, nodes, nil)
 		// Should select the third node as it has the highest td and will win the priority tie-breaker
 		assert.Same(t, nodes[2], selector.Select())
 	})
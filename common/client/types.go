@@ -59,6 +59,13 @@ type PoolChainInfoProvider interface {
 	HighestUserObservations() ChainInfo
 }
 
+// BudgetedRPC is implemented by RPCs with a limited budget of requests, so that MultiNode can route the requests to other
+// RPCs when the budget is nearly exhausted.
+type BudgetedRPC interface {
+	// RequestBudgetNearlyExhausted - returns true if the RPC should only be used when no other RPC is available
+	RequestBudgetNearlyExhausted() bool
+}
+
 // ChainInfo - defines RPC's or MultiNode's view on the chain
 type ChainInfo struct {
 	BlockNumber          int64
@@ -15,6 +15,7 @@ var (
 	ErrBump                = errors.New("fee bump failed")
 	ErrConnectivity        = errors.New("transaction propagation issue: transactions are not being mined")
 	ErrFeeLimitTooLow      = errors.New("provided fee limit too low")
+	ErrFeeBelowBump        = errors.New("fee is below the minimum fee bump")
 )
 
 func IsBumpErr(err error) bool {
@@ -10,6 +10,8 @@ type Opt int
 const (
 	// OptForceRefetch forces the estimator to bust a cache if necessary
 	OptForceRefetch Opt = iota
+	// OptBlobTx makes the estimator also price the blob gas of a blob transaction (EIP-4844)
+	OptBlobTx
 )
 
 type Fee fmt.Stringer
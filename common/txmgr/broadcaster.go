@@ -121,6 +121,7 @@ type Broadcaster[
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	sequenceTracker txmgrtypes.SequenceTracker[ADDR, SEQ]
 	resumeCallback  ResumeCallback
+	events          *TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	chainID         CHAIN_ID
 	chainType       string
 	config          txmgrtypes.BroadcasterChainConfig
@@ -478,6 +479,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	} else if err != nil {
 		return fmt.Errorf("processUnstartedTxs failed on UpdateTxUnstartedToInProgress: %w", err), true
 	}
+	eb.events.StateChanged(*etx, &attempt)
 
 	return eb.handleInProgressTx(ctx, *etx, attempt, time.Now(), 0)
 }
@@ -554,6 +556,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 		if err != nil {
 			return err, true
 		}
+		eb.events.StateChanged(etx, &attempt)
 		// Increment sequence if successfully broadcasted
 		eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
 		return err, true
@@ -618,6 +621,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 			if err != nil {
 				return err, true
 			}
+			eb.events.StateChanged(etx, &attempt)
 			// Increment sequence if successfully broadcasted
 			eb.sequenceTracker.GenerateNextSequence(etx.FromAddress, *etx.Sequence)
 			return err, true
@@ -717,6 +721,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) repl
 	}
 
 	lgr.Debugw("Bumped fee on initial send", "oldFee", attempt.TxFee.String(), "newFee", bumpedFee.String(), "newFeeLimit", bumpedFeeLimit)
+	eb.events.FeeBumped(etx, bumpedAttempt)
 	return bumpedAttempt, true, err
 }
 
@@ -769,7 +774,11 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) save
 			}
 		}
 	}
-	return eb.txStore.UpdateTxFatalError(ctx, etx)
+	if err := eb.txStore.UpdateTxFatalError(ctx, etx); err != nil {
+		return err
+	}
+	eb.events.StateChanged(*etx, nil)
+	return nil
 }
 
 func observeTimeUntilBroadcast[CHAIN_ID types.ID](chainID CHAIN_ID, createdAt, broadcastAt time.Time) {
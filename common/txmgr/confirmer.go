@@ -14,6 +14,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	commonhex "github.com/smartcontractkit/chainlink-common/pkg/utils/hex"
 
@@ -103,6 +104,13 @@ var (
 	}, []string{"chainID"})
 )
 
+var (
+	// ErrTxNotFound is returned when replacing a transaction which does not exist.
+	ErrTxNotFound = errors.New("transaction not found")
+	// ErrTxNotReplaceable is returned when replacing a transaction which is not waiting to be included on chain.
+	ErrTxNotReplaceable = errors.New("transaction cannot be replaced")
+)
+
 type confirmerHeadTracker[HEAD types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface {
 	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized HEAD, err error)
 }
@@ -129,6 +137,7 @@ type Confirmer[
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	resumeCallback  ResumeCallback
+	events          *TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	chainConfig     txmgrtypes.ConfirmerChainConfig
 	feeConfig       txmgrtypes.ConfirmerFeeConfig
 	txConfig        txmgrtypes.ConfirmerTransactionsConfig
@@ -138,11 +147,13 @@ type Confirmer[
 	ks               txmgrtypes.KeyStore[ADDR, CHAIN_ID, SEQ]
 	enabledAddresses []ADDR
 
-	mb                              *mailbox.Mailbox[HEAD]
-	stopCh                          services.StopChan
-	wg                              sync.WaitGroup
-	initSync                        sync.Mutex
-	isStarted                       bool
+	mb        *mailbox.Mailbox[HEAD]
+	stopCh    services.StopChan
+	wg        sync.WaitGroup
+	initSync  sync.Mutex
+	isStarted bool
+	// processMu serializes the processing of heads with the manual replacements of transactions
+	processMu                       sync.Mutex
 	nConsecutiveBlocksChainTooShort int
 	isReceiptNil                    func(R) bool
 
@@ -289,6 +300,8 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) run
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ProcessHead(ctx context.Context, head types.Head[BLOCK_HASH]) error {
 	ctx, cancel := context.WithTimeout(ctx, processHeadTimeout)
 	defer cancel()
+	ec.processMu.Lock()
+	defer ec.processMu.Unlock()
 	return ec.processHead(ctx, head)
 }
 
@@ -398,6 +411,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Che
 		ec.lggr.Debugw("Batch sending transactions failed", "err", err)
 	}
 	var txIDsToUnconfirm []int64
+	var unconfirmed []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	for idx, txErr := range txErrs {
 		// Add to Unconfirm array, all tx where error wasn't TransactionAlreadyKnown.
 		if txErr != nil {
@@ -407,12 +421,18 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Che
 		}
 
 		txIDsToUnconfirm = append(txIDsToUnconfirm, attempts[idx].TxID)
+		unconfirmed = append(unconfirmed, attempts[idx])
 	}
 	err = ec.txStore.UpdateTxsUnconfirmed(ctx, txIDsToUnconfirm)
 
 	if err != nil {
 		return err
 	}
+	for _, attempt := range unconfirmed {
+		etx := attempt.Tx
+		etx.State = TxUnconfirmed
+		ec.events.StateChanged(etx, &attempt)
+	}
 	return
 }
 
@@ -460,13 +480,21 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Che
 		}
 	}
 
-	if err := ec.txStore.MarkAllConfirmedMissingReceipt(ctx, ec.chainID); err != nil {
+	missingReceiptTxs, err := ec.txStore.MarkAllConfirmedMissingReceipt(ctx, ec.chainID)
+	if err != nil {
 		return fmt.Errorf("unable to mark txes as 'confirmed_missing_receipt': %w", err)
 	}
+	for _, etx := range missingReceiptTxs {
+		ec.events.StateChanged(etx, nil)
+	}
 
-	if err := ec.txStore.MarkOldTxesMissingReceiptAsErrored(ctx, blockNum, latestFinalizedBlockNum, ec.chainID); err != nil {
+	erroredTxs, err := ec.txStore.MarkOldTxesMissingReceiptAsErrored(ctx, blockNum, latestFinalizedBlockNum, ec.chainID)
+	if err != nil {
 		return fmt.Errorf("unable to confirm buried unconfirmed txes': %w", err)
 	}
+	for _, etx := range erroredTxs {
+		ec.events.StateChanged(etx, nil)
+	}
 	return nil
 }
 
@@ -591,11 +619,13 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) fet
 		if err := ec.txStore.SaveFetchedReceipts(ctx, validReceipts, TxConfirmed, nil, ec.chainID); err != nil {
 			return fmt.Errorf("saveFetchedReceipts failed: %w", err)
 		}
+		ec.publishReceiptsSaved(batch, validReceipts, TxConfirmed, nil)
 		// Save the receipts but mark the associated transactions as Fatal Error since the original transaction was purged
 		stuckTxFatalErrMsg := ec.stuckTxDetector.StuckTxFatalError()
 		if err := ec.txStore.SaveFetchedReceipts(ctx, purgeReceipts, TxFatalError, &stuckTxFatalErrMsg, ec.chainID); err != nil {
 			return fmt.Errorf("saveFetchedReceipts failed: %w", err)
 		}
+		ec.publishReceiptsSaved(batch, purgeReceipts, TxFatalError, &stuckTxFatalErrMsg)
 		promNumConfirmedTxs.WithLabelValues(ec.chainID.String()).Add(float64(len(receipts)))
 
 		allReceipts = append(allReceipts, receipts...)
@@ -606,6 +636,28 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) fet
 	return nil
 }
 
+// publishReceiptsSaved publishes the new state of the transactions of the attempts which receipts were saved.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) publishReceiptsSaved(attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], receipts []R, state txmgrtypes.TxState, errorMsg *string) {
+	if ec.events == nil || len(receipts) == 0 {
+		return
+	}
+	saved := make(map[TX_HASH]struct{}, len(receipts))
+	for _, receipt := range receipts {
+		saved[receipt.GetTxHash()] = struct{}{}
+	}
+	for _, attempt := range attempts {
+		if _, ok := saved[attempt.Hash]; !ok {
+			continue
+		}
+		etx := attempt.Tx
+		etx.State = state
+		if errorMsg != nil {
+			etx.Error = null.StringFrom(*errorMsg)
+		}
+		ec.events.StateChanged(etx, &attempt)
+	}
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) separateValidAndPurgeAttemptReceipts(receipts []R, attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (valid []R, purge []R) {
 	receiptMap := make(map[TX_HASH]R)
 	for _, receipt := range receipts {
@@ -942,6 +994,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bum
 	if err == nil {
 		promNumGasBumps.WithLabelValues(ec.chainID.String()).Inc()
 		ec.lggr.Debugw("Rebroadcast bumping fee for tx", append(logFields, "bumpedFee", bumpedFee.String(), "bumpedFeeLimit", bumpedFeeLimit)...)
+		ec.events.FeeBumped(etx, bumpedAttempt)
 		return bumpedAttempt, err
 	}
 
@@ -1046,7 +1099,12 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) han
 		// Mark confirmed_missing_receipt and wait for the next cycle to try to get a receipt
 		lggr.Debugw("Sequence already used", "txAttemptID", attempt.ID, "txHash", attempt.Hash.String())
 		timeout := ec.dbConfig.DefaultQueryTimeout()
-		return ec.txStore.SaveConfirmedMissingReceiptAttempt(ctx, timeout, &attempt, now)
+		if err := ec.txStore.SaveConfirmedMissingReceiptAttempt(ctx, timeout, &attempt, now); err != nil {
+			return err
+		}
+		etx.State = TxConfirmedMissingReceipt
+		ec.events.StateChanged(etx, &attempt)
+		return nil
 	case client.InsufficientFunds:
 		timeout := ec.dbConfig.DefaultQueryTimeout()
 		return ec.txStore.SaveInsufficientFundsAttempt(ctx, timeout, &attempt, now)
@@ -1195,6 +1253,8 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) mar
 	if err := ec.txStore.UpdateTxForRebroadcast(ctx, etx, attempt); err != nil {
 		return fmt.Errorf("markForRebroadcast failed: %w", err)
 	}
+	etx.State = TxUnconfirmed
+	ec.events.StateChanged(etx, &attempt)
 
 	return nil
 }
@@ -1259,6 +1319,64 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sen
 	return txhash, nil
 }
 
+// ReplaceUnconfirmedTx sends a new attempt, created by newAttempt, for the unconfirmed transaction of the given sequence.
+// Unlike ForceRebroadcast, the attempt is saved and sent the same way as the gas bumps, so that its receipt is tracked
+// by the Confirmer like any other attempt. It is used by the node operators to cancel, speed up or replace stuck
+// transactions.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ReplaceUnconfirmedTx(ctx context.Context, fromAddress ADDR, seq SEQ, newAttempt func(ctx context.Context, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	ec.processMu.Lock()
+	defer ec.processMu.Unlock()
+
+	etx, err := ec.txStore.FindTxWithSequence(ctx, fromAddress, seq)
+	if err != nil {
+		return attempt, fmt.Errorf("FindTxWithSequence failed: %w", err)
+	}
+	if etx == nil {
+		return attempt, fmt.Errorf("%w: no transaction from %s with sequence %s", ErrTxNotFound, fromAddress, seq)
+	}
+	if etx.State != TxUnconfirmed {
+		return attempt, fmt.Errorf("%w: transaction %d is %s", ErrTxNotReplaceable, etx.ID, etx.State)
+	}
+	if len(etx.TxAttempts) == 0 {
+		return attempt, fmt.Errorf("expected unconfirmed transaction %d to have at least one attempt", etx.ID)
+	}
+	if etx.TxAttempts[0].IsPurgeAttempt {
+		return attempt, fmt.Errorf("%w: transaction %d is being purged", ErrTxNotReplaceable, etx.ID)
+	}
+	for _, a := range etx.TxAttempts {
+		if a.State == txmgrtypes.TxAttemptInProgress {
+			return attempt, fmt.Errorf("%w: transaction %d has an attempt in progress", ErrTxNotReplaceable, etx.ID)
+		}
+	}
+
+	latest, _, err := ec.headTracker.LatestAndFinalizedBlock(ctx)
+	if err != nil {
+		return attempt, fmt.Errorf("failed to retrieve latest head: %w", err)
+	}
+	if !latest.IsValid() {
+		return attempt, errors.New("latest head is not valid")
+	}
+
+	lggr := etx.GetLogger(ec.lggr)
+	attempt, err = newAttempt(ctx, etx)
+	if err != nil {
+		return attempt, fmt.Errorf("failed to create replacement attempt: %w", err)
+	}
+
+	lggr.Infow("Replacing transaction", "nPreviousAttempts", len(etx.TxAttempts), "fee", attempt.TxFee, "isPurgeAttempt", attempt.IsPurgeAttempt)
+
+	// newAttempt may have changed the payload of the transaction, which must only be saved along with the attempt built for it
+	if err = ec.txStore.SaveInProgressAttemptWithPayload(ctx, etx, &attempt); err != nil {
+		return attempt, fmt.Errorf("saveInProgressAttemptWithPayload failed: %w", err)
+	}
+	ec.events.FeeBumped(*etx, attempt)
+
+	if err = ec.handleInProgressAttempt(ctx, lggr, *etx, attempt, latest.BlockNumber()); err != nil {
+		return attempt, fmt.Errorf("handleInProgressAttempt failed: %w", err)
+	}
+	return attempt, nil
+}
+
 // ResumePendingTaskRuns issues callbacks to task runs that are pending waiting for receipts
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ResumePendingTaskRuns(ctx context.Context, latest, finalized int64) error {
 	receiptsPlus, err := ec.txStore.FindTxesPendingCallback(ctx, latest, finalized, ec.chainID)
@@ -33,6 +33,64 @@ func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) EXPECT
 	return &TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{mock: &_m.Mock}
 }
 
+// CancelTransaction provides a mock function with given fields: ctx, fromAddress, seq
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CancelTransaction(ctx context.Context, fromAddress ADDR, seq SEQ) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	ret := _m.Called(ctx, fromAddress, seq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelTransaction")
+	}
+
+	var r0 txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, SEQ) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)); ok {
+		return rf(ctx, fromAddress, seq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, SEQ) txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
+		r0 = rf(ctx, fromAddress, seq)
+	} else {
+		r0 = ret.Get(0).(txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, SEQ) error); ok {
+		r1 = rf(ctx, fromAddress, seq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxManager_CancelTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelTransaction'
+type TxManager_CancelTransaction_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// CancelTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress ADDR
+//   - seq SEQ
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CancelTransaction(ctx interface{}, fromAddress interface{}, seq interface{}) *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("CancelTransaction", ctx, fromAddress, seq)}
+}
+
+func (_c *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, seq SEQ)) *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(SEQ))
+	})
+	return _c
+}
+
+func (_c *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(attempt, err)
+	return _c
+}
+
+func (_c *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, SEQ) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) *TxManager_CancelTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Close() error {
 	ret := _m.Called()
@@ -925,6 +983,67 @@ func (_c *TxManager_RegisterResumeCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, B
 	return _c
 }
 
+// ReplaceTransaction provides a mock function with given fields: ctx, fromAddress, seq, encodedPayload, fee, feeLimit
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplaceTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, encodedPayload []byte, fee FEE, feeLimit uint64) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	ret := _m.Called(ctx, fromAddress, seq, encodedPayload, fee, feeLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceTransaction")
+	}
+
+	var r0 txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, SEQ, []byte, FEE, uint64) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)); ok {
+		return rf(ctx, fromAddress, seq, encodedPayload, fee, feeLimit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, SEQ, []byte, FEE, uint64) txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
+		r0 = rf(ctx, fromAddress, seq, encodedPayload, fee, feeLimit)
+	} else {
+		r0 = ret.Get(0).(txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, SEQ, []byte, FEE, uint64) error); ok {
+		r1 = rf(ctx, fromAddress, seq, encodedPayload, fee, feeLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxManager_ReplaceTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceTransaction'
+type TxManager_ReplaceTransaction_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// ReplaceTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress ADDR
+//   - seq SEQ
+//   - encodedPayload []byte
+//   - fee FEE
+//   - feeLimit uint64
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplaceTransaction(ctx interface{}, fromAddress interface{}, seq interface{}, encodedPayload interface{}, fee interface{}, feeLimit interface{}) *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("ReplaceTransaction", ctx, fromAddress, seq, encodedPayload, fee, feeLimit)}
+}
+
+func (_c *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, seq SEQ, encodedPayload []byte, fee FEE, feeLimit uint64)) *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(SEQ), args[3].([]byte), args[4].(FEE), args[5].(uint64))
+	})
+	return _c
+}
+
+func (_c *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(attempt, err)
+	return _c
+}
+
+func (_c *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, SEQ, []byte, FEE, uint64) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) *TxManager_ReplaceTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Reset provides a mock function with given fields: addr, abandon
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Reset(addr ADDR, abandon bool) error {
 	ret := _m.Called(addr, abandon)
@@ -1033,6 +1152,65 @@ func (_c *TxManager_SendNativeToken_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HA
 	return _c
 }
 
+// SpeedUpTransaction provides a mock function with given fields: ctx, fromAddress, seq, fee
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SpeedUpTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, fee FEE) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	ret := _m.Called(ctx, fromAddress, seq, fee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpeedUpTransaction")
+	}
+
+	var r0 txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, SEQ, FEE) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)); ok {
+		return rf(ctx, fromAddress, seq, fee)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, SEQ, FEE) txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]); ok {
+		r0 = rf(ctx, fromAddress, seq, fee)
+	} else {
+		r0 = ret.Get(0).(txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, SEQ, FEE) error); ok {
+		r1 = rf(ctx, fromAddress, seq, fee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxManager_SpeedUpTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpeedUpTransaction'
+type TxManager_SpeedUpTransaction_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// SpeedUpTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress ADDR
+//   - seq SEQ
+//   - fee FEE
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SpeedUpTransaction(ctx interface{}, fromAddress interface{}, seq interface{}, fee interface{}) *TxManager_SpeedUpTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_SpeedUpTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("SpeedUpTransaction", ctx, fromAddress, seq, fee)}
+}
+
+func (_c *TxManager_SpeedUpTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, fromAddress ADDR, seq SEQ, fee FEE)) *TxManager_SpeedUpTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(SEQ), args[3].(FEE))
+	})
+	return _c
+}
+
+func (_c *TxManager_SpeedUpTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) *TxManager_SpeedUpTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(attempt, err)
+	return _c
+}
+
+func (_c *TxManager_SpeedUpTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, SEQ, FEE) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) *TxManager_SpeedUpTransaction_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -1079,6 +1257,65 @@ func (_c *TxManager_Start_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, F
 	return _c
 }
 
+// SubscribeToTxEvents provides a mock function with given fields:
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SubscribeToTxEvents() (<-chan txmgr.TxEvent[CHAIN_ID, ADDR, TX_HASH], func()) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeToTxEvents")
+	}
+
+	var r0 <-chan txmgr.TxEvent[CHAIN_ID, ADDR, TX_HASH]
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan txmgr.TxEvent[CHAIN_ID, ADDR, TX_HASH], func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan txmgr.TxEvent[CHAIN_ID, ADDR, TX_HASH]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan txmgr.TxEvent[CHAIN_ID, ADDR, TX_HASH])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// TxManager_SubscribeToTxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeToTxEvents'
+type TxManager_SubscribeToTxEvents_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// SubscribeToTxEvents is a helper method to define mock.On call
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SubscribeToTxEvents() *TxManager_SubscribeToTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_SubscribeToTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("SubscribeToTxEvents")}
+}
+
+func (_c *TxManager_SubscribeToTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func()) *TxManager_SubscribeToTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TxManager_SubscribeToTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(_a0 <-chan txmgr.TxEvent[CHAIN_ID, ADDR, TX_HASH], _a1 func()) *TxManager_SubscribeToTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxManager_SubscribeToTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func() (<-chan txmgr.TxEvent[CHAIN_ID, ADDR, TX_HASH], func())) *TxManager_SubscribeToTxEvents_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Trigger provides a mock function with given fields: addr
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Trigger(addr ADDR) {
 	_m.Called(addr)
@@ -0,0 +1,149 @@
+package txmgr
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// TxEventsBufferSize is the number of events buffered for each subscriber. Events published while the buffer of a
+// subscriber is full are dropped for that subscriber.
+const TxEventsBufferSize = 256
+
+var promTxEventsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_manager_tx_events_dropped",
+	Help: "Number of transaction events dropped because a subscriber was not consuming them fast enough",
+}, []string{"chainID"})
+
+type TxEventType string
+
+const (
+	// TxEventStateChanged is published when a transaction is created or moves to another state, including when the
+	// Confirmer marks it confirmed_missing_receipt, moves it back to unconfirmed or fatally errors it after its
+	// receipt could not be found, and when it is abandoned by a reset of its key.
+	TxEventStateChanged TxEventType = "state_changed"
+	// TxEventFeeBumped is published when a new attempt with a bumped fee is created for a transaction.
+	TxEventFeeBumped TxEventType = "fee_bumped"
+)
+
+// TxEvent describes a state transition or a fee bump of a transaction.
+type TxEvent[CHAIN_ID types.ID, ADDR types.Hashable, TX_HASH types.Hashable] struct {
+	Type           TxEventType
+	ChainID        CHAIN_ID
+	TxID           int64
+	IdempotencyKey *string
+	FromAddress    ADDR
+	ToAddress      ADDR
+	// State is the state of the transaction once the event happened
+	State txmgrtypes.TxState
+	// TxHash is the hash of the attempt which was sent, bumped or included on chain, if any
+	TxHash *TX_HASH
+	// Fee is the fee of the attempt which was sent or bumped, if any
+	Fee string
+	// Error is the reason of a fatal error
+	Error     string
+	Timestamp time.Time
+}
+
+// TxEvents fans out the events of the transactions of a chain to its subscribers.
+// Publishing never blocks the Txm: events are dropped for the subscribers which do not consume them fast enough.
+type TxEvents[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH types.Hashable,
+	BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+] struct {
+	lggr    logger.Logger
+	chainID CHAIN_ID
+
+	mu   sync.RWMutex
+	subs map[chan TxEvent[CHAIN_ID, ADDR, TX_HASH]]struct{}
+}
+
+func NewTxEvents[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH types.Hashable,
+	BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+](lggr logger.Logger, chainID CHAIN_ID) *TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{
+		lggr:    logger.Named(lggr, "TxEvents"),
+		chainID: chainID,
+		subs:    make(map[chan TxEvent[CHAIN_ID, ADDR, TX_HASH]]struct{}),
+	}
+}
+
+// Subscribe returns a channel receiving the events published from now on, and a function to unsubscribe which closes
+// the channel.
+func (e *TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Subscribe() (<-chan TxEvent[CHAIN_ID, ADDR, TX_HASH], func()) {
+	ch := make(chan TxEvent[CHAIN_ID, ADDR, TX_HASH], TxEventsBufferSize)
+	e.mu.Lock()
+	e.subs[ch] = struct{}{}
+	e.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			e.mu.Lock()
+			delete(e.subs, ch)
+			close(ch)
+			e.mu.Unlock()
+		})
+	}
+}
+
+// StateChanged publishes the state of the transaction, along with the hash and the fee of the attempt which caused the
+// transition, if any.
+func (e *TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) StateChanged(tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt *txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
+	e.publish(TxEventStateChanged, tx, attempt)
+}
+
+// FeeBumped publishes the new attempt created with a bumped fee for the transaction.
+func (e *TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) FeeBumped(tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
+	e.publish(TxEventFeeBumped, tx, &attempt)
+}
+
+func (e *TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) publish(typ TxEventType, tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt *txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) {
+	if e == nil {
+		return
+	}
+	event := TxEvent[CHAIN_ID, ADDR, TX_HASH]{
+		Type:           typ,
+		ChainID:        e.chainID,
+		TxID:           tx.ID,
+		IdempotencyKey: tx.IdempotencyKey,
+		FromAddress:    tx.FromAddress,
+		ToAddress:      tx.ToAddress,
+		State:          tx.State,
+		Error:          tx.Error.String,
+		Timestamp:      time.Now(),
+	}
+	if attempt != nil {
+		hash := attempt.Hash
+		event.TxHash = &hash
+		event.Fee = attempt.TxFee.String()
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	for ch := range e.subs {
+		select {
+		case ch <- event:
+		default:
+			promTxEventsDropped.WithLabelValues(e.chainID.String()).Inc()
+			e.lggr.Debugw("Dropped transaction event, subscriber is too slow", "txID", event.TxID, "type", event.Type, "state", event.State)
+		}
+	}
+}
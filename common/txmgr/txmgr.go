@@ -49,8 +49,17 @@ type TxManager[
 	GetForwarderForEOA(ctx context.Context, eoa ADDR) (forwarder ADDR, err error)
 	GetForwarderForEOAOCR2Feeds(ctx context.Context, eoa, ocr2AggregatorID ADDR) (forwarder ADDR, err error)
 	RegisterResumeCallback(fn ResumeCallback)
+	// SubscribeToTxEvents returns a channel receiving the state transitions and fee bumps of the transactions from now
+	// on, and a function to unsubscribe. Events are dropped if the channel is not consumed fast enough.
+	SubscribeToTxEvents() (<-chan TxEvent[CHAIN_ID, ADDR, TX_HASH], func())
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
+	// CancelTransaction replaces the unconfirmed transaction of the given sequence with a self-send carrying no payload
+	CancelTransaction(ctx context.Context, fromAddress ADDR, seq SEQ) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// SpeedUpTransaction sends a new attempt at the given fee for the unconfirmed transaction of the given sequence
+	SpeedUpTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, fee FEE) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// ReplaceTransaction replaces the payload of the unconfirmed transaction of the given sequence and sends it at the given fee
+	ReplaceTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, encodedPayload []byte, fee FEE, feeLimit uint64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions by a field in the TxMeta blob and transaction states
 	FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions with a non-null TxMeta field that was provided by transaction states
@@ -98,6 +107,7 @@ type Txm[
 	trigger        chan ADDR
 	reset          chan reset
 	resumeCallback ResumeCallback
+	events         *TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 
 	chStop   services.StopChan
 	chSubbed chan struct{}
@@ -120,6 +130,10 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterRe
 	b.confirmer.SetResumeCallback(fn)
 }
 
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SubscribeToTxEvents() (<-chan TxEvent[CHAIN_ID, ADDR, TX_HASH], func()) {
+	return b.events.Subscribe()
+}
+
 // NewTxm creates a new Txm with the given configuration.
 func NewTxm[
 	CHAIN_ID types.ID,
@@ -145,6 +159,7 @@ func NewTxm[
 	resender *Resender[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
 	tracker *Tracker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
 	finalizer txmgrtypes.Finalizer[BLOCK_HASH, HEAD],
+	events *TxEvents[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 	newErrorClassifierFunc NewErrorClassifier,
 ) *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	b := Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{
@@ -168,6 +183,13 @@ func NewTxm[
 		tracker:            tracker,
 		newErrorClassifier: newErrorClassifierFunc,
 		finalizer:          finalizer,
+		events:             events,
+	}
+	if broadcaster != nil {
+		broadcaster.events = b.events
+	}
+	if confirmer != nil {
+		confirmer.events = b.events
 	}
 
 	if txCfg.ResendAfterThreshold() <= 0 {
@@ -248,15 +270,64 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Reset(addr
 	return err
 }
 
+// CancelTransaction replaces the unconfirmed transaction of the given sequence with a purge attempt: a self-send carrying
+// no payload, priced above the latest attempt. The transaction is marked fatally errored once the purge attempt is included.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CancelTransaction(ctx context.Context, fromAddress ADDR, seq SEQ) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return b.replaceTransaction(ctx, fromAddress, seq, func(ctx context.Context, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+		return b.txAttemptBuilder.NewPurgeTxAttempt(ctx, *etx, b.logger)
+	})
+}
+
+// SpeedUpTransaction sends a new attempt at the given fee for the unconfirmed transaction of the given sequence.
+// The fee must be at least the fee of the latest attempt bumped by the configured bump rules. The Confirmer keeps
+// bumping the fee from there if the attempt is not included in time.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SpeedUpTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, fee FEE) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return b.replaceTransaction(ctx, fromAddress, seq, func(ctx context.Context, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+		return b.txAttemptBuilder.NewReplacementTxAttempt(ctx, *etx, fee, etx.FeeLimit, b.logger)
+	})
+}
+
+// ReplaceTransaction replaces the payload and the fee limit of the unconfirmed transaction of the given sequence, and
+// sends it at the given fee. The fee limit of the transaction is kept if feeLimit is 0. The fee must be at least the fee
+// of the latest attempt bumped by the configured bump rules, and the new payload is only saved along with its attempt.
+// The previous attempts remain valid, so the previous payload may still be included if one of them is mined first.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ReplaceTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, encodedPayload []byte, fee FEE, feeLimit uint64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return b.replaceTransaction(ctx, fromAddress, seq, func(ctx context.Context, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+		if feeLimit == 0 {
+			feeLimit = etx.FeeLimit
+		}
+		etx.EncodedPayload = encodedPayload
+		etx.FeeLimit = feeLimit
+		return b.txAttemptBuilder.NewReplacementTxAttempt(ctx, *etx, fee, feeLimit, b.logger)
+	})
+}
+
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) replaceTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, newAttempt func(ctx context.Context, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	if err = b.checkEnabled(ctx, fromAddress); err != nil {
+		return attempt, err
+	}
+	ok := b.IfStarted(func() {
+		attempt, err = b.confirmer.ReplaceUnconfirmedTx(ctx, fromAddress, seq, newAttempt)
+	})
+	if !ok {
+		return attempt, errors.New("not started")
+	}
+	return attempt, err
+}
+
 // abandon, scoped to the key of this txm:
 // - marks all pending and inflight transactions fatally errored (note: at this point all transactions are either confirmed or fatally errored)
 // this must not be run while Broadcaster or Confirmer are running
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) abandon(addr ADDR) (err error) {
 	ctx, cancel := b.chStop.NewCtx()
 	defer cancel()
-	if err = b.txStore.Abandon(ctx, b.chainID, addr); err != nil {
+	abandoned, err := b.txStore.Abandon(ctx, b.chainID, addr)
+	if err != nil {
 		return fmt.Errorf("abandon failed to update txes for key %s: %w", addr.String(), err)
 	}
+	for _, etx := range abandoned {
+		b.events.StateChanged(etx, nil)
+	}
 	return nil
 }
 
@@ -528,6 +599,12 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		return tx, err
 	}
 
+	if txRequest.BlobSidecar != nil {
+		if err = txRequest.BlobSidecar.Validate(); err != nil {
+			return tx, fmt.Errorf("Txm#CreateTransaction: invalid blob sidecar: %w", err)
+		}
+	}
+
 	if b.txConfig.ForwardersEnabled() && (!utils.IsZero(txRequest.ForwarderAddress)) {
 		fwdPayload, fwdErr := b.fwdMgr.ConvertPayload(txRequest.ToAddress, txRequest.EncodedPayload)
 		if fwdErr == nil {
@@ -555,6 +632,7 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 	if err != nil {
 		return tx, err
 	}
+	b.events.StateChanged(tx, nil)
 
 	// Trigger the Broadcaster to check for new transaction
 	b.broadcaster.Trigger(txRequest.FromAddress)
@@ -717,6 +795,21 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Res
 	return nil
 }
 
+// CancelTransaction does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CancelTransaction(ctx context.Context, fromAddress ADDR, seq SEQ) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return attempt, errors.New(n.ErrMsg)
+}
+
+// SpeedUpTransaction does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SpeedUpTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, fee FEE) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return attempt, errors.New(n.ErrMsg)
+}
+
+// ReplaceTransaction does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) ReplaceTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, encodedPayload []byte, fee FEE, feeLimit uint64) (attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return attempt, errors.New(n.ErrMsg)
+}
+
 // SendNativeToken does nothing, null functionality
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return etx, errors.New(n.ErrMsg)
@@ -733,6 +826,12 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Hea
 }
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RegisterResumeCallback(fn ResumeCallback) {
 }
+
+// SubscribeToTxEvents returns a channel which never receives any event for NullTxManager.
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SubscribeToTxEvents() (<-chan TxEvent[CHAIN_ID, ADDR, TX_HASH], func()) {
+	ch := make(chan TxEvent[CHAIN_ID, ADDR, TX_HASH])
+	return ch, func() {}
+}
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return txes, errors.New(n.ErrMsg)
 }
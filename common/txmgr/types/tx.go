@@ -93,6 +93,27 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// BlobSidecar holds the blobs to be carried by the transaction, if it is a blob transaction (EIP-4844).
+	BlobSidecar *BlobSidecar
+}
+
+// BlobSidecar holds the blobs carried by a blob transaction (EIP-4844), along with their KZG commitments and proofs.
+type BlobSidecar struct {
+	Blobs       [][]byte `json:"blobs"`
+	Commitments [][]byte `json:"commitments"`
+	Proofs      [][]byte `json:"proofs"`
+}
+
+// Validate checks that the sidecar has a commitment and a proof for each of its blobs.
+func (s *BlobSidecar) Validate() error {
+	if len(s.Blobs) == 0 {
+		return errors.New("blob sidecar has no blobs")
+	}
+	if len(s.Commitments) != len(s.Blobs) || len(s.Proofs) != len(s.Blobs) {
+		return fmt.Errorf("blob sidecar has %d blobs but %d commitments and %d proofs", len(s.Blobs), len(s.Commitments), len(s.Proofs))
+	}
+	return nil
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -236,6 +257,9 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	// Marshalled BlobSidecar, set for blob transactions (EIP-4844).
+	BlobSidecar *sqlutil.JSON
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -342,6 +366,19 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetChecker() (Transm
 	return t, nil
 }
 
+// GetBlobSidecar returns a Tx's blob sidecar in struct form, unmarshalling it from JSON first. It returns nil if the Tx
+// does not carry blobs.
+func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetBlobSidecar() (*BlobSidecar, error) {
+	if e.BlobSidecar == nil {
+		return nil, nil
+	}
+	var s BlobSidecar
+	if err := json.Unmarshal(*e.BlobSidecar, &s); err != nil {
+		return nil, fmt.Errorf("unmarshalling blob sidecar: %w", err)
+	}
+	return &s, nil
+}
+
 // Provides error classification to external components in a chain agnostic way
 // Only exposes the error types that could be set in the transaction error field
 type ErrorClassifier interface {
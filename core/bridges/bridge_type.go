@@ -21,6 +21,19 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	ResiliencePolicy
+}
+
+// ResiliencePolicy guards the requests made to a bridge by pipeline tasks, see HealthTracker.
+type ResiliencePolicy struct {
+	// CircuitFailureThreshold is the number of consecutive failed requests opening the circuit, zero disables the circuit breaker.
+	CircuitFailureThreshold uint32 `json:"circuitFailureThreshold"`
+	// CircuitOpenDuration is how long the circuit stays open before letting a trial request through.
+	CircuitOpenDuration models.Interval `json:"circuitOpenDuration"`
+	// HedgeAfterP95 sends a second request when the first one is still pending after the p95 latency of the bridge.
+	HedgeAfterP95 bool `json:"hedgeAfterP95"`
+	// FallbackBridgeName is the bridge requested when the circuit is open or the request fails, and the target of hedged requests.
+	FallbackBridgeName *BridgeName `json:"fallbackBridgeName"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -62,6 +75,7 @@ type BridgeType struct {
 	MinimumContractPayment *assets.Link
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
+	ResiliencePolicy
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -92,6 +106,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			ResiliencePolicy:       btr.ResiliencePolicy,
 		}, nil
 }
 
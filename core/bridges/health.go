@@ -0,0 +1,168 @@
+package bridges
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// latencySamples is the number of latencies kept per bridge to estimate the p95 latency.
+	latencySamples = 100
+	// minLatencySamples is the number of latencies needed before hedging requests.
+	minLatencySamples = 20
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of the circuit breaker of a bridge.
+type CircuitState string
+
+const (
+	// CircuitDisabled means the bridge has no circuit breaker, see ResiliencePolicy.CircuitFailureThreshold.
+	CircuitDisabled CircuitState = "disabled"
+	// CircuitClosed lets every request through.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects every request until ResiliencePolicy.CircuitOpenDuration elapsed.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a single trial request through, which closes the circuit if successful or opens it again.
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// Health is a snapshot of the state of a bridge.
+type Health struct {
+	Circuit             CircuitState
+	ConsecutiveFailures uint32
+	// OpenedAt is zero unless the circuit is open or half-open.
+	OpenedAt time.Time
+	// LatencyP95 is zero until enough successful requests were made.
+	LatencyP95 time.Duration
+}
+
+type bridgeHealth struct {
+	consecutiveFailures uint32
+	openedAt            time.Time
+	trialStartedAt      time.Time
+	latencies           []time.Duration
+	next                int
+}
+
+func (b *bridgeHealth) circuit(policy ResiliencePolicy, now time.Time) CircuitState {
+	switch {
+	case policy.CircuitFailureThreshold == 0:
+		return CircuitDisabled
+	case b.openedAt.IsZero():
+		return CircuitClosed
+	case now.Before(b.openedAt.Add(policy.CircuitOpenDuration.Duration())):
+		return CircuitOpen
+	default:
+		return CircuitHalfOpen
+	}
+}
+
+func (b *bridgeHealth) latencyP95() time.Duration {
+	if len(b.latencies) < minLatencySamples {
+		return 0
+	}
+	sorted := slices.Clone(b.latencies)
+	slices.Sort(sorted)
+	return sorted[(len(sorted)*95-1)/100]
+}
+
+// HealthTracker keeps the circuit breaker state and the latency of every bridge, shared by all the jobs of the node.
+// A nil HealthTracker enforces no policy.
+type HealthTracker struct {
+	mu      sync.Mutex
+	bridges map[BridgeName]*bridgeHealth
+}
+
+func NewHealthTracker() *HealthTracker {
+	return &HealthTracker{bridges: make(map[BridgeName]*bridgeHealth)}
+}
+
+func (h *HealthTracker) get(name BridgeName) *bridgeHealth {
+	b, ok := h.bridges[name]
+	if !ok {
+		b = &bridgeHealth{}
+		h.bridges[name] = b
+	}
+	return b
+}
+
+// Allow returns ErrCircuitOpen if the circuit breaker of the bridge rejects the request.
+// Once the circuit is half-open, a single trial request is allowed per CircuitOpenDuration.
+func (h *HealthTracker) Allow(name BridgeName, policy ResiliencePolicy) error {
+	if h == nil {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	b := h.get(name)
+	now := time.Now()
+	switch b.circuit(policy, now) {
+	case CircuitOpen:
+		return ErrCircuitOpen
+	case CircuitHalfOpen:
+		// a trial which never reported back, e.g. because it was cancelled, is given up on after CircuitOpenDuration
+		if !b.trialStartedAt.IsZero() && now.Before(b.trialStartedAt.Add(policy.CircuitOpenDuration.Duration())) {
+			return ErrCircuitOpen
+		}
+		b.trialStartedAt = now
+	default:
+	}
+	return nil
+}
+
+// Record reports the outcome of a request to the bridge.
+func (h *HealthTracker) Record(name BridgeName, policy ResiliencePolicy, latency time.Duration, failed bool) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	b := h.get(name)
+	b.trialStartedAt = time.Time{}
+	if !failed {
+		b.consecutiveFailures = 0
+		b.openedAt = time.Time{}
+		if len(b.latencies) < latencySamples {
+			b.latencies = append(b.latencies, latency)
+		} else {
+			b.latencies[b.next] = latency
+		}
+		b.next = (b.next + 1) % latencySamples
+		return
+	}
+	b.consecutiveFailures++
+	if policy.CircuitFailureThreshold > 0 && b.consecutiveFailures >= policy.CircuitFailureThreshold {
+		b.openedAt = time.Now()
+	}
+}
+
+// HedgeAfter returns how long to wait for a response before hedging the request, if the policy of the bridge asks for it.
+func (h *HealthTracker) HedgeAfter(name BridgeName, policy ResiliencePolicy) (time.Duration, bool) {
+	if h == nil || !policy.HedgeAfterP95 {
+		return 0, false
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	p95 := h.get(name).latencyP95()
+	return p95, p95 > 0
+}
+
+// Health returns the current state of the bridge.
+func (h *HealthTracker) Health(name BridgeName, policy ResiliencePolicy) Health {
+	if h == nil {
+		return Health{Circuit: CircuitDisabled}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	b := h.get(name)
+	return Health{
+		Circuit:             b.circuit(policy, time.Now()),
+		ConsecutiveFailures: b.consecutiveFailures,
+		OpenedAt:            b.openedAt,
+		LatencyP95:          b.latencyP95(),
+	}
+}
@@ -0,0 +1,94 @@
+package bridges_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestHealthTracker_Circuit(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("adapter")
+	policy := bridges.ResiliencePolicy{
+		CircuitFailureThreshold: 2,
+		CircuitOpenDuration:     models.Interval(100 * time.Millisecond),
+	}
+	h := bridges.NewHealthTracker()
+
+	assert.Equal(t, bridges.CircuitClosed, h.Health(name, policy).Circuit)
+	require.NoError(t, h.Allow(name, policy))
+	h.Record(name, policy, time.Millisecond, true)
+	assert.Equal(t, bridges.CircuitClosed, h.Health(name, policy).Circuit)
+	h.Record(name, policy, time.Millisecond, true)
+
+	health := h.Health(name, policy)
+	assert.Equal(t, bridges.CircuitOpen, health.Circuit)
+	assert.Equal(t, uint32(2), health.ConsecutiveFailures)
+	assert.False(t, health.OpenedAt.IsZero())
+	require.ErrorIs(t, h.Allow(name, policy), bridges.ErrCircuitOpen)
+
+	require.Eventually(t, func() bool {
+		return h.Health(name, policy).Circuit == bridges.CircuitHalfOpen
+	}, time.Second, 10*time.Millisecond)
+	// a single trial request is let through
+	require.NoError(t, h.Allow(name, policy))
+	require.ErrorIs(t, h.Allow(name, policy), bridges.ErrCircuitOpen)
+
+	h.Record(name, policy, time.Millisecond, false)
+	health = h.Health(name, policy)
+	assert.Equal(t, bridges.CircuitClosed, health.Circuit)
+	assert.Zero(t, health.ConsecutiveFailures)
+	assert.True(t, health.OpenedAt.IsZero())
+
+	t.Run("disabled without threshold", func(t *testing.T) {
+		h := bridges.NewHealthTracker()
+		for i := 0; i < 10; i++ {
+			h.Record(name, bridges.ResiliencePolicy{}, time.Millisecond, true)
+		}
+		assert.Equal(t, bridges.CircuitDisabled, h.Health(name, bridges.ResiliencePolicy{}).Circuit)
+		assert.NoError(t, h.Allow(name, bridges.ResiliencePolicy{}))
+	})
+
+	t.Run("nil tracker enforces nothing", func(t *testing.T) {
+		var h *bridges.HealthTracker
+		h.Record(name, policy, time.Millisecond, true)
+		assert.NoError(t, h.Allow(name, policy))
+		assert.Equal(t, bridges.CircuitDisabled, h.Health(name, policy).Circuit)
+	})
+}
+
+func TestHealthTracker_HedgeAfter(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("adapter")
+	policy := bridges.ResiliencePolicy{HedgeAfterP95: true}
+	h := bridges.NewHealthTracker()
+
+	for i := 1; i <= 19; i++ {
+		h.Record(name, policy, time.Duration(i)*time.Millisecond, false)
+	}
+	_, ok := h.HedgeAfter(name, policy)
+	assert.False(t, ok, "not enough samples")
+
+	for i := 20; i <= 100; i++ {
+		h.Record(name, policy, time.Duration(i)*time.Millisecond, false)
+	}
+	after, ok := h.HedgeAfter(name, policy)
+	require.True(t, ok)
+	assert.Equal(t, 95*time.Millisecond, after)
+	assert.Equal(t, 95*time.Millisecond, h.Health(name, policy).LatencyP95)
+
+	// failed requests are not latency samples
+	h.Record(name, policy, time.Hour, true)
+	after, _ = h.HedgeAfter(name, policy)
+	assert.Equal(t, 95*time.Millisecond, after)
+
+	_, ok = h.HedgeAfter(name, bridges.ResiliencePolicy{})
+	assert.False(t, ok, "hedging disabled")
+}
@@ -579,7 +579,7 @@ func (e *TestEvmConfig) BlockEmissionIdleWarningThreshold() time.Duration {
 }
 
 func (e *TestEvmConfig) Transactions() evmconfig.Transactions {
-	return &transactionsConfig{e: e, autoPurge: &autoPurgeConfig{}}
+	return &transactionsConfig{e: e, autoPurge: &autoPurgeConfig{}, accessList: &accessListConfig{}, privateRelay: &privateRelayConfig{}}
 }
 
 func (e *TestEvmConfig) NonceAutoSync() bool { return true }
@@ -677,17 +677,23 @@ type TestFeeHistoryConfig struct {
 
 type transactionsConfig struct {
 	evmconfig.Transactions
-	e         *TestEvmConfig
-	autoPurge evmconfig.AutoPurgeConfig
+	e            *TestEvmConfig
+	autoPurge    evmconfig.AutoPurgeConfig
+	accessList   evmconfig.AccessListConfig
+	privateRelay evmconfig.PrivateRelayConfig
 }
 
-func (*transactionsConfig) ForwardersEnabled() bool                { return false }
-func (t *transactionsConfig) MaxInFlight() uint32                  { return t.e.MaxInFlight }
-func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.MaxQueued }
-func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
-func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
-func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
-func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (*transactionsConfig) ForwardersEnabled() bool                  { return false }
+func (t *transactionsConfig) MaxInFlight() uint32                    { return t.e.MaxInFlight }
+func (t *transactionsConfig) MaxQueued() uint64                      { return t.e.MaxQueued }
+func (t *transactionsConfig) ReaperInterval() time.Duration          { return t.e.ReaperInterval }
+func (t *transactionsConfig) ReaperThreshold() time.Duration         { return t.e.ReaperThreshold }
+func (t *transactionsConfig) ResendAfterThreshold() time.Duration    { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig   { return t.autoPurge }
+func (t *transactionsConfig) AccessList() evmconfig.AccessListConfig { return t.accessList }
+func (t *transactionsConfig) PrivateRelay() evmconfig.PrivateRelayConfig {
+	return t.privateRelay
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -695,6 +701,18 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type accessListConfig struct {
+	evmconfig.AccessListConfig
+}
+
+func (a *accessListConfig) Enabled() bool { return false }
+
+type privateRelayConfig struct {
+	evmconfig.PrivateRelayConfig
+}
+
+func (p *privateRelayConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
 	RpcDefaultBatchSize uint32
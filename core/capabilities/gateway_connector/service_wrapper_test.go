@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -19,10 +20,11 @@ import (
 )
 
 func generateWrapper(t *testing.T, privateKey *ecdsa.PrivateKey, keystoreKey *ecdsa.PrivateKey) (*gatewayconnector.ServiceWrapper, error) {
+	return generateWrapperWithKey(t, ethkey.FromPrivateKey(privateKey).Address, ethkey.FromPrivateKey(keystoreKey))
+}
+
+func generateWrapperWithKey(t *testing.T, addr common.Address, keystoreKeyV2 ethkey.KeyV2) (*gatewayconnector.ServiceWrapper, error) {
 	logger := logger.TestLogger(t)
-	privateKeyV2 := ethkey.FromPrivateKey(privateKey)
-	addr := privateKeyV2.Address
-	keystoreKeyV2 := ethkey.FromPrivateKey(keystoreKey)
 
 	config, err := chainlink.GeneralConfigOpts{
 		Config: chainlink.Config{
@@ -78,4 +80,16 @@ func TestGatewayConnectorServiceWrapper_NonexistentKey(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestGatewayConnectorServiceWrapper_RemoteKey(t *testing.T) {
+	t.Parallel()
+
+	_, addr := testutils.NewPrivateKeyAndAddress(t)
+	wrapper, err := generateWrapperWithKey(t, addr, ethkey.FromAddress(addr))
+	require.NoError(t, err)
+
+	ctx := testutils.Context(t)
+	err = wrapper.Start(ctx)
+	require.ErrorContains(t, err, "remote signer")
+}
+
 func ptr[T any](t T) *T { return &t }
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+type accessListClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+type accessListResult struct {
+	AccessList *types.AccessList `json:"accessList"`
+	Error      string            `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64    `json:"gasUsed"`
+}
+
+// CreateAccessList calls eth_createAccessList to create the EIP-2930 access list of msg against the pending state.
+// It returns the access list along with the gas used by the call once the access list is attached to it.
+func CreateAccessList(ctx context.Context, client accessListClient, msg ethereum.CallMsg) (types.AccessList, uint64, error) {
+	var result accessListResult
+	if err := client.CallContext(ctx, &result, "eth_createAccessList", toCallArg(msg), "pending"); err != nil {
+		return nil, 0, err
+	}
+	if result.Error != "" {
+		return nil, 0, fmt.Errorf("call would fail: %s", result.Error)
+	}
+	if result.AccessList == nil {
+		return types.AccessList{}, uint64(result.GasUsed), nil
+	}
+	return *result.AccessList, uint64(result.GasUsed), nil
+}
+
+// methodNotFoundCode is the JSON-RPC error code returned for unknown or disabled methods.
+const methodNotFoundCode = -32601
+
+// IsMethodNotFound returns true if err reports that the RPC does not support the method called.
+func IsMethodNotFound(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFoundCode {
+		return true
+	}
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "method not found") ||
+		strings.Contains(msg, "does not exist/is not available") ||
+		strings.Contains(msg, "method not supported") ||
+		strings.Contains(msg, "unsupported method")
+}
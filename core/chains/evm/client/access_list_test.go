@@ -0,0 +1,93 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+)
+
+func TestCreateAccessList(t *testing.T) {
+	t.Parallel()
+
+	fromAddress := testutils.NewAddress()
+	toAddress := testutils.NewAddress()
+	ctx := tests.Context(t)
+	msg := ethereum.CallMsg{
+		From: fromAddress,
+		To:   &toAddress,
+		Data: []byte{0xde, 0xad, 0xbe, 0xef},
+	}
+
+	newClient := func(t *testing.T, createAccessList func(resp *testutils.JSONRPCResponse)) client.Client {
+		wsURL := testutils.NewWSServer(t, testutils.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+			switch method {
+			case "eth_subscribe":
+				resp.Result = `"0x00"`
+				resp.Notify = headResult
+				return
+			case "eth_unsubscribe":
+				resp.Result = "true"
+				return
+			case "eth_createAccessList":
+				assert.Equal(t, "pending", params.Array()[1].String())
+				createAccessList(&resp)
+			}
+			return
+		}).WSURL().String()
+
+		ethClient := mustNewChainClient(t, wsURL)
+		require.NoError(t, ethClient.Dial(ctx))
+		return ethClient
+	}
+
+	t.Run("returns the access list and gas used", func(t *testing.T) {
+		ethClient := newClient(t, func(resp *testutils.JSONRPCResponse) {
+			resp.Result = `{"accessList":[{"address":"0x0000000000000000000000000000000000000001","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000002"]}],"gasUsed":"0x6000"}`
+		})
+
+		accessList, gasUsed, err := client.CreateAccessList(ctx, ethClient, msg)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0x6000), gasUsed)
+		require.Len(t, accessList, 1)
+		assert.Len(t, accessList[0].StorageKeys, 1)
+	})
+
+	t.Run("returns an error if the call would fail", func(t *testing.T) {
+		ethClient := newClient(t, func(resp *testutils.JSONRPCResponse) {
+			resp.Result = `{"accessList":[],"gasUsed":"0x6000","error":"execution reverted"}`
+		})
+
+		_, _, err := client.CreateAccessList(ctx, ethClient, msg)
+		require.ErrorContains(t, err, "execution reverted")
+		assert.False(t, client.IsMethodNotFound(err))
+	})
+
+	t.Run("reports unsupported RPCs", func(t *testing.T) {
+		ethClient := newClient(t, func(resp *testutils.JSONRPCResponse) {
+			resp.Error.Code = -32601
+			resp.Error.Message = "the method eth_createAccessList does not exist/is not available"
+		})
+
+		_, _, err := client.CreateAccessList(ctx, ethClient, msg)
+		require.Error(t, err)
+		assert.True(t, client.IsMethodNotFound(err))
+	})
+}
+
+func TestIsMethodNotFound(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, client.IsMethodNotFound(nil))
+	assert.False(t, client.IsMethodNotFound(errors.New("execution reverted")))
+	assert.True(t, client.IsMethodNotFound(errors.New("Method not found")))
+	assert.True(t, client.IsMethodNotFound(errors.New("unsupported method: eth_createAccessList")))
+}
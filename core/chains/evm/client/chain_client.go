@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
@@ -33,6 +34,13 @@ type Client interface {
 	// NodeStates returns a map of node Name->node state
 	// It might be nil or empty, e.g. for mock clients etc
 	NodeStates() map[string]string
+	// NodeRPCStats returns a map of node Name->rolling latency and error statistics of its RPC
+	// It might be nil or empty, e.g. for mock clients etc
+	NodeRPCStats() map[string]commonclient.RPCStats
+	// NodeThrottling returns a map of node Name->error describing why the requests to its RPC are throttled, nil if
+	// they are not. Only nodes with a rate limit or a request budget are included.
+	// It might be nil or empty, e.g. for mock clients etc
+	NodeThrottling() map[string]error
 
 	TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -104,6 +112,8 @@ type chainClient struct {
 	logger       logger.SugaredLogger
 	chainType    chaintype.ChainType
 	clientErrors evmconfig.ClientErrors
+	readQuorum   commonclient.ReadQuorumConfig
+	rpcs         []*RPCClient
 }
 
 func NewChainClient(
@@ -115,6 +125,8 @@ func NewChainClient(
 	chainID *big.Int,
 	clientErrors evmconfig.ClientErrors,
 	deathDeclarationDelay time.Duration,
+	latencyWeightedCfg commonclient.LatencyWeightedConfig,
+	readQuorumCfg commonclient.ReadQuorumConfig,
 	chainType chaintype.ChainType,
 ) Client {
 	chainFamily := "EVM"
@@ -127,12 +139,21 @@ func NewChainClient(
 		chainID,
 		chainFamily,
 		deathDeclarationDelay,
+		latencyWeightedCfg,
 	)
 
 	classifySendError := func(tx *types.Transaction, err error) commonclient.SendTxReturnCode {
 		return ClassifySendError(err, clientErrors, logger.Sugared(logger.Nop()), tx, common.Address{}, chainType.IsL2())
 	}
 
+	var rpcs []*RPCClient
+	for _, n := range nodes {
+		rpcs = append(rpcs, n.RPC())
+	}
+	for _, n := range sendonlys {
+		rpcs = append(rpcs, n.RPC())
+	}
+
 	txSender := commonclient.NewTransactionSender[*types.Transaction, *big.Int, *RPCClient](
 		lggr,
 		chainID,
@@ -148,6 +169,8 @@ func NewChainClient(
 		logger:       logger.Sugared(lggr),
 		chainType:    chainType,
 		clientErrors: clientErrors,
+		readQuorum:   readQuorumCfg,
+		rpcs:         rpcs,
 	}
 }
 
@@ -246,6 +269,15 @@ func (c *chainClient) CallContext(ctx context.Context, result interface{}, metho
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if commonclient.ReadQuorumEnabled(c.readQuorum, "CallContract") && canPinBlockNumber(blockNumber) {
+		return commonclient.QuorumRead(ctx, c.multiNode, c.readQuorum, "CallContract",
+			func(ctx context.Context, r *RPCClient, pinned commonclient.ChainInfo) ([]byte, error) {
+				return r.CallContract(ctx, msg, pinBlockNumber(blockNumber, pinned))
+			},
+			func(result []byte) (string, error) {
+				return hexutil.Encode(result), nil
+			})
+	}
 	r, err := c.multiNode.SelectRPC()
 	if err != nil {
 		return nil, err
@@ -294,6 +326,18 @@ func (c *chainClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (u
 	return r.EstimateGas(ctx, call)
 }
 func (c *chainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if commonclient.ReadQuorumEnabled(c.readQuorum, "FilterLogs") && (q.BlockHash != nil || canPinBlockNumber(q.FromBlock) && canPinBlockNumber(q.ToBlock)) {
+		return commonclient.QuorumRead(ctx, c.multiNode, c.readQuorum, "FilterLogs",
+			func(ctx context.Context, r *RPCClient, pinned commonclient.ChainInfo) ([]types.Log, error) {
+				pinnedQ := q
+				if q.BlockHash == nil {
+					pinnedQ.FromBlock = pinBlockNumber(q.FromBlock, pinned)
+					pinnedQ.ToBlock = pinBlockNumber(q.ToBlock, pinned)
+				}
+				return r.FilterEvents(ctx, pinnedQ)
+			},
+			logsKey)
+	}
 	r, err := c.multiNode.SelectRPC()
 	if err != nil {
 		return nil, err
@@ -310,6 +354,18 @@ func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *ty
 }
 
 func (c *chainClient) HeaderByNumber(ctx context.Context, n *big.Int) (head *types.Header, err error) {
+	if commonclient.ReadQuorumEnabled(c.readQuorum, "HeaderByNumber") && canPinBlockNumber(n) {
+		return commonclient.QuorumRead(ctx, c.multiNode, c.readQuorum, "HeaderByNumber",
+			func(ctx context.Context, r *RPCClient, pinned commonclient.ChainInfo) (*types.Header, error) {
+				return r.HeaderByNumber(ctx, pinBlockNumber(n, pinned))
+			},
+			func(head *types.Header) (string, error) {
+				if head == nil {
+					return "", nil
+				}
+				return head.Hash().Hex(), nil
+			})
+	}
 	r, err := c.multiNode.SelectRPC()
 	if err != nil {
 		return head, err
@@ -357,6 +413,21 @@ func (c *chainClient) NodeStates() map[string]string {
 	return c.multiNode.NodeStates()
 }
 
+func (c *chainClient) NodeRPCStats() map[string]commonclient.RPCStats {
+	return c.multiNode.NodeRPCStats()
+}
+
+func (c *chainClient) NodeThrottling() map[string]error {
+	throttling := map[string]error{}
+	for _, r := range c.rpcs {
+		if r.budget.limits.isZero() {
+			continue
+		}
+		throttling[r.Name()] = r.budget.health()
+	}
+	return throttling
+}
+
 func (c *chainClient) PendingCodeAt(ctx context.Context, account common.Address) (b []byte, err error) {
 	r, err := c.multiNode.SelectRPC()
 	if err != nil {
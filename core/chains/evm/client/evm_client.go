@@ -13,7 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
-func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, clientErrors evmconfig.ClientErrors, lggr logger.Logger, chainID *big.Int, nodes []*toml.Node, chainType chaintype.ChainType) (Client, error) {
+func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, clientErrors evmconfig.ClientErrors, lggr logger.Logger, chainID *big.Int, nodes []*toml.Node, chainType chaintype.ChainType, usage RequestUsageORM) (Client, error) {
 	var primaries []commonclient.Node[*big.Int, *RPCClient]
 	var sendonlys []commonclient.SendOnlyNode[*big.Int, *RPCClient]
 	largePayloadRPCTimeout, defaultRPCTimeout := getRPCTimeouts(chainType)
@@ -21,13 +21,13 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, cli
 	for i, node := range nodes {
 		if node.SendOnly != nil && *node.SendOnly {
 			rpc := NewRPCClient(cfg, lggr, nil, node.HTTPURL.URL(), *node.Name, i, chainID,
-				commonclient.Secondary, largePayloadRPCTimeout, defaultRPCTimeout, chainType)
+				commonclient.Secondary, largePayloadRPCTimeout, defaultRPCTimeout, requestLimits(node), usage, chainType)
 			sendonly := commonclient.NewSendOnlyNode(lggr, (url.URL)(*node.HTTPURL),
 				*node.Name, chainID, rpc)
 			sendonlys = append(sendonlys, sendonly)
 		} else {
 			rpc := NewRPCClient(cfg, lggr, node.WSURL.URL(), node.HTTPURL.URL(), *node.Name, i,
-				chainID, commonclient.Primary, largePayloadRPCTimeout, defaultRPCTimeout, chainType)
+				chainID, commonclient.Primary, largePayloadRPCTimeout, defaultRPCTimeout, requestLimits(node), usage, chainType)
 			primaryNode := commonclient.NewNode(cfg, chainCfg,
 				lggr, node.WSURL.URL(), node.HTTPURL.URL(), *node.Name, i, chainID, *node.Order,
 				rpc, "EVM")
@@ -36,7 +36,20 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, cli
 	}
 
 	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(),
-		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), chainType), nil
+		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), cfg.LatencyWeighted(), cfg.ReadQuorum(), chainType), nil
+}
+
+func requestLimits(node *toml.Node) (limits RequestLimits) {
+	if node.RateLimit != nil {
+		limits.RateLimit = *node.RateLimit
+	}
+	if node.DailyBudget != nil {
+		limits.DailyBudget = *node.DailyBudget
+	}
+	if node.MonthlyBudget != nil {
+		limits.MonthlyBudget = *node.MonthlyBudget
+	}
+	return
 }
 
 func getRPCTimeouts(chainType chaintype.ChainType) (largePayload, defaultTimeout time.Duration) {
@@ -1300,6 +1300,53 @@ func (_c *Client_LatestFinalizedBlock_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// NodeRPCStats provides a mock function with given fields:
+func (_m *Client) NodeRPCStats() map[string]commonclient.RPCStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeRPCStats")
+	}
+
+	var r0 map[string]commonclient.RPCStats
+	if rf, ok := ret.Get(0).(func() map[string]commonclient.RPCStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]commonclient.RPCStats)
+		}
+	}
+
+	return r0
+}
+
+// Client_NodeRPCStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeRPCStats'
+type Client_NodeRPCStats_Call struct {
+	*mock.Call
+}
+
+// NodeRPCStats is a helper method to define mock.On call
+func (_e *Client_Expecter) NodeRPCStats() *Client_NodeRPCStats_Call {
+	return &Client_NodeRPCStats_Call{Call: _e.mock.On("NodeRPCStats")}
+}
+
+func (_c *Client_NodeRPCStats_Call) Run(run func()) *Client_NodeRPCStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_NodeRPCStats_Call) Return(_a0 map[string]commonclient.RPCStats) *Client_NodeRPCStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_NodeRPCStats_Call) RunAndReturn(run func() map[string]commonclient.RPCStats) *Client_NodeRPCStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NodeStates provides a mock function with given fields:
 func (_m *Client) NodeStates() map[string]string {
 	ret := _m.Called()
@@ -1347,6 +1394,53 @@ func (_c *Client_NodeStates_Call) RunAndReturn(run func() map[string]string) *Cl
 	return _c
 }
 
+// NodeThrottling provides a mock function with given fields:
+func (_m *Client) NodeThrottling() map[string]error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeThrottling")
+	}
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// Client_NodeThrottling_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeThrottling'
+type Client_NodeThrottling_Call struct {
+	*mock.Call
+}
+
+// NodeThrottling is a helper method to define mock.On call
+func (_e *Client_Expecter) NodeThrottling() *Client_NodeThrottling_Call {
+	return &Client_NodeThrottling_Call{Call: _e.mock.On("NodeThrottling")}
+}
+
+func (_c *Client_NodeThrottling_Call) Run(run func()) *Client_NodeThrottling_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_NodeThrottling_Call) Return(_a0 map[string]error) *Client_NodeThrottling_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_NodeThrottling_Call) RunAndReturn(run func() map[string]error) *Client_NodeThrottling_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NonceAt provides a mock function with given fields: ctx, account, blockNumber
 func (_m *Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
 	ret := _m.Called(ctx, account, blockNumber)
@@ -223,6 +223,12 @@ func (nc *NullClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, er
 // NodeStates implements evmclient.Client
 func (nc *NullClient) NodeStates() map[string]string { return nil }
 
+// NodeRPCStats implements evmclient.Client
+func (nc *NullClient) NodeRPCStats() map[string]commonclient.RPCStats { return nil }
+
+// NodeThrottling implements evmclient.Client
+func (nc *NullClient) NodeThrottling() map[string]error { return nil }
+
 func (nc *NullClient) IsL2() bool {
 	nc.lggr.Debug("IsL2")
 	return false
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
+
+// canPinBlockNumber returns true if reads at the given block number can be confirmed by a read quorum. Reads of the
+// pending and safe blocks are served by a single node, as the nodes do not report them.
+func canPinBlockNumber(n *big.Int) bool {
+	if n == nil || !n.IsInt64() {
+		return true
+	}
+	switch rpc.BlockNumber(n.Int64()) {
+	case rpc.PendingBlockNumber, rpc.SafeBlockNumber:
+		return false
+	default:
+		return true
+	}
+}
+
+// pinBlockNumber replaces the latest and finalized block tags by the corresponding block number seen by all the nodes
+// queried by a read quorum, so that they do not disagree only because some of them have seen a more recent block.
+func pinBlockNumber(n *big.Int, pinned commonclient.ChainInfo) *big.Int {
+	pin := func(blockNumber int64) *big.Int {
+		if blockNumber <= 0 {
+			return n // unknown, let the nodes resolve the tag
+		}
+		return big.NewInt(blockNumber)
+	}
+	if n == nil {
+		return pin(pinned.BlockNumber)
+	}
+	if !n.IsInt64() {
+		return n
+	}
+	switch rpc.BlockNumber(n.Int64()) {
+	case rpc.LatestBlockNumber:
+		return pin(pinned.BlockNumber)
+	case rpc.FinalizedBlockNumber:
+		return pin(pinned.FinalizedBlockNumber)
+	default:
+		return n
+	}
+}
+
+// logsKey hashes the logs by the block and transaction they were emitted in and their content, so that the results
+// of FilterLogs can be compared.
+func logsKey(logs []types.Log) (string, error) {
+	h := crypto.NewKeccakState()
+	for _, l := range logs {
+		h.Write(l.BlockHash[:])
+		h.Write(l.TxHash[:])
+		h.Write(binary.BigEndian.AppendUint64(nil, uint64(l.Index)))
+		h.Write(l.Address[:])
+		h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(l.Topics))))
+		for _, topic := range l.Topics {
+			h.Write(topic[:])
+		}
+		h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(l.Data))))
+		h.Write(l.Data)
+	}
+	return hexutil.Encode(h.Sum(nil)), nil
+}
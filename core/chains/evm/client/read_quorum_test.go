@@ -0,0 +1,67 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
+
+func TestReadQuorum_pinBlockNumber(t *testing.T) {
+	t.Parallel()
+
+	pinned := commonclient.ChainInfo{BlockNumber: 100, FinalizedBlockNumber: 90}
+	tag := func(n rpc.BlockNumber) *big.Int { return big.NewInt(n.Int64()) }
+
+	assert.True(t, canPinBlockNumber(nil))
+	assert.True(t, canPinBlockNumber(big.NewInt(42)))
+	assert.True(t, canPinBlockNumber(tag(rpc.LatestBlockNumber)))
+	assert.True(t, canPinBlockNumber(tag(rpc.FinalizedBlockNumber)))
+	assert.False(t, canPinBlockNumber(tag(rpc.PendingBlockNumber)))
+	assert.False(t, canPinBlockNumber(tag(rpc.SafeBlockNumber)))
+
+	assert.Equal(t, big.NewInt(100), pinBlockNumber(nil, pinned))
+	assert.Equal(t, big.NewInt(100), pinBlockNumber(tag(rpc.LatestBlockNumber), pinned))
+	assert.Equal(t, big.NewInt(90), pinBlockNumber(tag(rpc.FinalizedBlockNumber), pinned))
+	assert.Equal(t, big.NewInt(42), pinBlockNumber(big.NewInt(42), pinned))
+	assert.Equal(t, tag(rpc.EarliestBlockNumber), pinBlockNumber(tag(rpc.EarliestBlockNumber), pinned))
+	// unknown chain info leaves the tag to the nodes
+	assert.Nil(t, pinBlockNumber(nil, commonclient.ChainInfo{}))
+}
+
+func TestReadQuorum_logsKey(t *testing.T) {
+	t.Parallel()
+
+	logs := []types.Log{{
+		Address:   common.HexToAddress("0x1"),
+		Topics:    []common.Hash{common.HexToHash("0x2")},
+		Data:      []byte{3},
+		TxHash:    common.HexToHash("0x4"),
+		BlockHash: common.HexToHash("0x5"),
+		Index:     6,
+	}}
+	key, err := logsKey(logs)
+	require.NoError(t, err)
+
+	same := []types.Log{logs[0]}
+	same[0].Removed = true // not part of the data returned by the chain
+	sameKey, err := logsKey(same)
+	require.NoError(t, err)
+	assert.Equal(t, key, sameKey)
+
+	reorged := []types.Log{logs[0]}
+	reorged[0].BlockHash = common.HexToHash("0x7")
+	reorgedKey, err := logsKey(reorged)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, reorgedKey)
+
+	emptyKey, err := logsKey(nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, emptyKey)
+}
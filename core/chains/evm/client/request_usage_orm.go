@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+const (
+	periodDaily   = "daily"
+	periodMonthly = "monthly"
+)
+
+// RequestUsageORM persists the compute units charged to the request budgets of the RPCs, so that the budgets are not
+// reset when the node restarts.
+type RequestUsageORM interface {
+	// RequestUsage returns the compute units charged to the RPC during the daily or monthly window starting at start.
+	RequestUsage(ctx context.Context, nodeName string, period string, start time.Time) (uint64, error)
+	// AddRequestUsage adds units to the usage of the RPC during the daily or monthly window starting at start.
+	AddRequestUsage(ctx context.Context, nodeName string, period string, start time.Time, units uint64) error
+	// DeleteRequestUsageBefore deletes the usage of the windows starting before the given time.
+	DeleteRequestUsageBefore(ctx context.Context, before time.Time) error
+}
+
+type requestUsageORM struct {
+	chainID ubig.Big
+	ds      sqlutil.DataSource
+}
+
+var _ RequestUsageORM = (*requestUsageORM)(nil)
+
+// NewRequestUsageORM creates a RequestUsageORM scoped to chainID.
+func NewRequestUsageORM(chainID *big.Int, ds sqlutil.DataSource) RequestUsageORM {
+	return &requestUsageORM{chainID: *ubig.New(chainID), ds: ds}
+}
+
+func (o *requestUsageORM) RequestUsage(ctx context.Context, nodeName string, period string, start time.Time) (units uint64, err error) {
+	stmt := `SELECT units FROM evm.rpc_request_usage WHERE evm_chain_id = $1 AND node_name = $2 AND period = $3 AND period_start = $4`
+	err = o.ds.GetContext(ctx, &units, stmt, o.chainID, nodeName, period, start)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return units, err
+}
+
+func (o *requestUsageORM) AddRequestUsage(ctx context.Context, nodeName string, period string, start time.Time, units uint64) error {
+	stmt := `
+INSERT INTO evm.rpc_request_usage (evm_chain_id, node_name, period, period_start, units)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (evm_chain_id, node_name, period, period_start) DO UPDATE
+SET units = evm.rpc_request_usage.units + EXCLUDED.units`
+	_, err := o.ds.ExecContext(ctx, stmt, o.chainID, nodeName, period, start, units)
+	return err
+}
+
+func (o *requestUsageORM) DeleteRequestUsageBefore(ctx context.Context, before time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM evm.rpc_request_usage WHERE evm_chain_id = $1 AND period_start < $2`, o.chainID, before)
+	return err
+}
@@ -0,0 +1,58 @@
+package client_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestRequestUsageORM(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := client.NewRequestUsageORM(testutils.FixtureChainID, db)
+	otherChain := client.NewRequestUsageORM(big.NewInt(1337), db)
+	day := time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)
+	month := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	units, err := orm.RequestUsage(ctx, "rpc", "daily", day)
+	require.NoError(t, err)
+	assert.Zero(t, units)
+
+	require.NoError(t, orm.AddRequestUsage(ctx, "rpc", "daily", day, 10))
+	require.NoError(t, orm.AddRequestUsage(ctx, "rpc", "daily", day, 5))
+	require.NoError(t, orm.AddRequestUsage(ctx, "rpc", "monthly", month, 7))
+	require.NoError(t, orm.AddRequestUsage(ctx, "rpc", "daily", day.AddDate(0, 0, -7), 3))
+	require.NoError(t, otherChain.AddRequestUsage(ctx, "rpc", "daily", day, 100))
+
+	units, err = orm.RequestUsage(ctx, "rpc", "daily", day)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), units)
+	units, err = orm.RequestUsage(ctx, "rpc", "monthly", month)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), units)
+	units, err = orm.RequestUsage(ctx, "other", "daily", day)
+	require.NoError(t, err)
+	assert.Zero(t, units)
+
+	require.NoError(t, orm.DeleteRequestUsageBefore(ctx, month))
+	units, err = orm.RequestUsage(ctx, "rpc", "daily", day.AddDate(0, 0, -7))
+	require.NoError(t, err)
+	assert.Zero(t, units)
+	units, err = orm.RequestUsage(ctx, "rpc", "daily", day)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), units)
+	units, err = otherChain.RequestUsage(ctx, "rpc", "daily", day)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), units)
+}
@@ -119,10 +119,18 @@ type RPCClient struct {
 	highestUserObservations commonclient.ChainInfo
 	// most recent chain info observed during current lifecycle (reseted on DisconnectAll)
 	latestChainInfo commonclient.ChainInfo
+
+	// callObserver is notified of the outcome of every call, it is set before the RPCClient is used
+	callObserver func(method string, latency time.Duration, err error)
+
+	// budget enforces the rate limit and budgets of the requests made to the RPC
+	budget *requestBudget
 }
 
 var _ commonclient.RPCClient[*big.Int, *evmtypes.Head] = (*RPCClient)(nil)
 var _ commonclient.SendTxRPCClient[*types.Transaction] = (*RPCClient)(nil)
+var _ commonclient.ObservableRPC = (*RPCClient)(nil)
+var _ commonclient.BudgetedRPC = (*RPCClient)(nil)
 
 func NewRPCClient(
 	cfg config.NodePool,
@@ -135,6 +143,8 @@ func NewRPCClient(
 	tier commonclient.NodeTier,
 	largePayloadRPCTimeout time.Duration,
 	rpcTimeout time.Duration,
+	limits RequestLimits,
+	usage RequestUsageORM,
 	chainType chaintype.ChainType,
 ) *RPCClient {
 	r := &RPCClient{
@@ -165,6 +175,7 @@ func NewRPCClient(
 	)
 	r.rpcLog = logger.Sugared(lggr).Named("RPC")
 	r.subs = map[ethereum.Subscription]struct{}{}
+	r.budget = newRequestBudget(cfg.RequestBudget(), limits, usage, chainID, name, lggr)
 
 	return r
 }
@@ -261,6 +272,7 @@ func (r *RPCClient) Close() {
 	}()
 	r.cancelInflightRequests()
 	r.UnsubscribeAllExcept()
+	r.budget.close()
 	r.chainInfoLock.Lock()
 	r.latestChainInfo = commonclient.ChainInfo{}
 	r.chainInfoLock.Unlock()
@@ -294,6 +306,9 @@ func (r *RPCClient) logResult(
 	results ...interface{},
 ) {
 	lggr = logger.With(lggr, "duration", callDuration, "rpcDomain", rpcDomain, "callName", callName)
+	if r.callObserver != nil {
+		r.callObserver(callName, callDuration, err)
+	}
 	promEVMPoolRPCNodeCalls.WithLabelValues(r.chainID.String(), r.name).Inc()
 	if err == nil {
 		promEVMPoolRPCNodeCallsSuccess.WithLabelValues(r.chainID.String(), r.name).Inc()
@@ -317,6 +332,33 @@ func (r *RPCClient) logResult(
 		Observe(float64(callDuration))
 }
 
+// SetCallObserver implements commonclient.ObservableRPC
+func (r *RPCClient) SetCallObserver(observer func(method string, latency time.Duration, err error)) {
+	r.callObserver = observer
+}
+
+// RequestBudgetNearlyExhausted implements commonclient.BudgetedRPC
+func (r *RPCClient) RequestBudgetNearlyExhausted() bool {
+	return r.budget.nearlyExhausted()
+}
+
+// acquireBudget waits until the rate limit of the RPC allows a request calling the given methods, and charges it to
+// the budgets of the RPC.
+func (r *RPCClient) acquireBudget(ctx context.Context, methods ...string) error {
+	if err := r.budget.acquire(ctx, methods...); err != nil {
+		return r.wrapRPCClientError(err)
+	}
+	return nil
+}
+
+func batchMethods(b []rpc.BatchElem) []string {
+	methods := make([]string, len(b))
+	for i, el := range b {
+		methods[i] = el.Method
+	}
+	return methods
+}
+
 func (r *RPCClient) getRPCDomain() string {
 	if r.http != nil {
 		return r.http.uri.Host
@@ -347,6 +389,9 @@ func (r *RPCClient) registerSub(sub ethereum.Subscription, stopInFLightCh chan s
 func (r *RPCClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
+	if err := r.acquireBudget(ctx, method); err != nil {
+		return err
+	}
 	lggr := r.newRqLggr().With(
 		"method", method,
 		"args", args,
@@ -390,6 +435,9 @@ func (r *RPCClient) BatchCallContext(rootCtx context.Context, b []rpc.BatchElem)
 
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(rootCtx, r.largePayloadRPCTimeout)
 	defer cancel()
+	if err := r.acquireBudget(ctx, batchMethods(b)...); err != nil {
+		return err
+	}
 	lggr := r.newRqLggr().With("nBatchElems", len(b), "batchElems", b)
 
 	lggr.Trace("RPC call: evmclient.Client#BatchCallContext")
@@ -479,6 +527,9 @@ func (r *RPCClient) SubscribeToHeads(ctx context.Context) (ch <-chan *evmtypes.H
 	if ws == nil {
 		return nil, nil, errors.New("SubscribeNewHead is not allowed without ws url")
 	}
+	if err = r.acquireBudget(ctx, "eth_subscribe"); err != nil {
+		return nil, nil, err
+	}
 
 	lggr.Debug("RPC call: evmclient.Client#EthSubscribe")
 	defer func() {
@@ -552,6 +603,9 @@ func (r *RPCClient) TransactionReceipt(ctx context.Context, txHash common.Hash)
 func (r *RPCClient) TransactionReceiptGeth(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getTransactionReceipt"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("txHash", txHash)
 
 	lggr.Debug("RPC call: evmclient.Client#TransactionReceipt")
@@ -575,6 +629,9 @@ func (r *RPCClient) TransactionReceiptGeth(ctx context.Context, txHash common.Ha
 func (r *RPCClient) TransactionByHash(ctx context.Context, txHash common.Hash) (tx *types.Transaction, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getTransactionByHash"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("txHash", txHash)
 
 	lggr.Debug("RPC call: evmclient.Client#TransactionByHash")
@@ -599,6 +656,9 @@ func (r *RPCClient) TransactionByHash(ctx context.Context, txHash common.Hash) (
 func (r *RPCClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getBlockByNumber"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("number", number)
 
 	lggr.Debug("RPC call: evmclient.Client#HeaderByNumber")
@@ -620,6 +680,9 @@ func (r *RPCClient) HeaderByNumber(ctx context.Context, number *big.Int) (header
 func (r *RPCClient) HeaderByHash(ctx context.Context, hash common.Hash) (header *types.Header, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getBlockByHash"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("hash", hash)
 
 	lggr.Debug("RPC call: evmclient.Client#HeaderByHash")
@@ -723,6 +786,9 @@ func (r *RPCClient) BlockByNumber(ctx context.Context, number *big.Int) (head *e
 func (r *RPCClient) ethGetBlockByNumber(ctx context.Context, number string, result interface{}) (err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getBlockByNumber"); err != nil {
+		return
+	}
 	const method = "eth_getBlockByNumber"
 	args := []interface{}{number, false}
 	lggr := r.newRqLggr().With(
@@ -759,6 +825,9 @@ func (r *RPCClient) BlockByHash(ctx context.Context, hash common.Hash) (head *ev
 func (r *RPCClient) BlockByHashGeth(ctx context.Context, hash common.Hash) (block *types.Block, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getBlockByHash"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("hash", hash)
 
 	lggr.Debug("RPC call: evmclient.Client#BlockByHash")
@@ -782,6 +851,9 @@ func (r *RPCClient) BlockByHashGeth(ctx context.Context, hash common.Hash) (bloc
 func (r *RPCClient) BlockByNumberGeth(ctx context.Context, number *big.Int) (block *types.Block, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getBlockByNumber"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("number", number)
 
 	lggr.Debug("RPC call: evmclient.Client#BlockByNumber")
@@ -805,6 +877,9 @@ func (r *RPCClient) BlockByNumberGeth(ctx context.Context, number *big.Int) (blo
 func (r *RPCClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
+	if err := r.acquireBudget(ctx, "eth_sendRawTransaction"); err != nil {
+		return err
+	}
 	lggr := r.newRqLggr().With("tx", tx)
 
 	lggr.Debug("RPC call: evmclient.Client#SendTransaction")
@@ -843,6 +918,9 @@ func (r *RPCClient) SendEmptyTransaction(
 func (r *RPCClient) PendingSequenceAt(ctx context.Context, account common.Address) (nonce evmtypes.Nonce, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getTransactionCount"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("account", account)
 
 	lggr.Debug("RPC call: evmclient.Client#PendingNonceAt")
@@ -872,6 +950,9 @@ func (r *RPCClient) PendingSequenceAt(ctx context.Context, account common.Addres
 func (r *RPCClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getTransactionCount"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("account", account, "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#NonceAt")
@@ -895,6 +976,9 @@ func (r *RPCClient) NonceAt(ctx context.Context, account common.Address, blockNu
 func (r *RPCClient) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getCode"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("account", account)
 
 	lggr.Debug("RPC call: evmclient.Client#PendingCodeAt")
@@ -918,6 +1002,9 @@ func (r *RPCClient) PendingCodeAt(ctx context.Context, account common.Address) (
 func (r *RPCClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) (code []byte, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getCode"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("account", account, "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#CodeAt")
@@ -941,6 +1028,9 @@ func (r *RPCClient) CodeAt(ctx context.Context, account common.Address, blockNum
 func (r *RPCClient) EstimateGas(ctx context.Context, c interface{}) (gas uint64, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_estimateGas"); err != nil {
+		return
+	}
 	call := c.(ethereum.CallMsg)
 	lggr := r.newRqLggr().With("call", call)
 
@@ -965,6 +1055,9 @@ func (r *RPCClient) EstimateGas(ctx context.Context, c interface{}) (gas uint64,
 func (r *RPCClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_gasPrice"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr()
 
 	lggr.Debug("RPC call: evmclient.Client#SuggestGasPrice")
@@ -988,6 +1081,9 @@ func (r *RPCClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err er
 func (r *RPCClient) CallContract(ctx context.Context, msg interface{}, blockNumber *big.Int) (val []byte, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_call"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("callMsg", msg, "blockNumber", blockNumber)
 	message := msg.(ethereum.CallMsg)
 
@@ -1016,6 +1112,9 @@ func (r *RPCClient) CallContract(ctx context.Context, msg interface{}, blockNumb
 func (r *RPCClient) PendingCallContract(ctx context.Context, msg interface{}) (val []byte, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_call"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("callMsg", msg)
 	message := msg.(ethereum.CallMsg)
 
@@ -1050,6 +1149,9 @@ func (r *RPCClient) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
 func (r *RPCClient) BlockNumber(ctx context.Context) (height uint64, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_blockNumber"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr()
 
 	lggr.Debug("RPC call: evmclient.Client#BlockNumber")
@@ -1073,6 +1175,9 @@ func (r *RPCClient) BlockNumber(ctx context.Context) (height uint64, err error)
 func (r *RPCClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getBalance"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("account", account.Hex(), "blockNumber", blockNumber)
 
 	lggr.Debug("RPC call: evmclient.Client#BalanceAt")
@@ -1096,6 +1201,9 @@ func (r *RPCClient) BalanceAt(ctx context.Context, account common.Address, block
 func (r *RPCClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_feeHistory"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("blockCount", blockCount, "rewardPercentiles", rewardPercentiles)
 
 	lggr.Debug("RPC call: evmclient.Client#FeeHistory")
@@ -1161,6 +1269,9 @@ func (r *RPCClient) FilterEvents(ctx context.Context, q ethereum.FilterQuery) ([
 func (r *RPCClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types.Log, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_getLogs"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr().With("q", q)
 
 	lggr.Debug("RPC call: evmclient.Client#FilterLogs")
@@ -1192,6 +1303,9 @@ func (r *RPCClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQu
 	if ws == nil {
 		return nil, errors.New("SubscribeFilterLogs is not allowed without ws url")
 	}
+	if err = r.acquireBudget(ctx, "eth_subscribe"); err != nil {
+		return nil, err
+	}
 	lggr := r.newRqLggr().With("q", q)
 
 	lggr.Debug("RPC call: evmclient.Client#SubscribeFilterLogs")
@@ -1218,6 +1332,9 @@ func (r *RPCClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQu
 func (r *RPCClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_maxPriorityFeePerGas"); err != nil {
+		return
+	}
 	lggr := r.newRqLggr()
 
 	lggr.Debug("RPC call: evmclient.Client#SuggestGasTipCap")
@@ -1244,6 +1361,9 @@ func (r *RPCClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 
 	defer cancel()
+	if err = r.acquireBudget(ctx, "eth_chainId"); err != nil {
+		return
+	}
 
 	if http != nil {
 		chainID, err = http.geth.ChainID(ctx)
@@ -1342,6 +1462,9 @@ func (r *RPCClient) makeQueryCtx(ctx context.Context, timeout time.Duration) (co
 func (r *RPCClient) IsSyncing(ctx context.Context) (bool, error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
+	if err := r.acquireBudget(ctx, "eth_syncing"); err != nil {
+		return false, err
+	}
 	lggr := r.newRqLggr()
 
 	lggr.Debug("RPC call: evmclient.Client#SyncProgress")
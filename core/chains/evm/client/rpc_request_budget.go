@@ -0,0 +1,381 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+var (
+	promEVMPoolRPCNodeRequestUnits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_request_units_total",
+		Help: "The total number of compute units, weighted by method, charged to the given RPC node",
+	}, []string{"evmChainID", "nodeName"})
+	promEVMPoolRPCNodeRequestsThrottled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_requests_throttled",
+		Help: "The total number of requests to the given RPC node which were delayed by its rate limit or rejected because its budget was exhausted",
+	}, []string{"evmChainID", "nodeName", "reason"})
+	promEVMPoolRPCNodeRequestBudgetUsed = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_request_budget_used",
+		Help: "The ratio of the daily or monthly request budget of the given RPC node used in the current period",
+	}, []string{"evmChainID", "nodeName", "period"})
+
+	ErrRequestBudgetExhausted = errors.New("request budget exhausted")
+)
+
+const (
+	throttledReasonRateLimit       = "rateLimit"
+	throttledReasonBudgetExhausted = "budgetExhausted"
+
+	// rateLimitedHealthWindow is how long the RPC is reported as throttled in the health report after a request was
+	// delayed by its rate limit
+	rateLimitedHealthWindow = time.Minute
+	// requestUsageFlushInterval is how often the usage of the budgets is persisted, the usage of this interval is lost
+	// if the node crashes
+	requestUsageFlushInterval = 10 * time.Second
+	requestUsageQueryTimeout  = 5 * time.Second
+)
+
+// RequestLimits are the limits of the requests accepted by an RPC, in compute units weighted by method. Zero values
+// are unlimited.
+type RequestLimits struct {
+	// RateLimit is the number of compute units per second
+	RateLimit uint32
+	// DailyBudget is the number of compute units per UTC day
+	DailyBudget uint64
+	// MonthlyBudget is the number of compute units per UTC calendar month
+	MonthlyBudget uint64
+}
+
+func (l RequestLimits) isZero() bool {
+	return l == RequestLimits{}
+}
+
+// requestBudget charges the requests made to an RPC to its rate limit and budgets. Requests are queued while the rate
+// limit is reached, and rejected once a budget is exhausted.
+// The usage of the budgets is loaded from the RequestUsageORM in the background at the start of each day and month, and
+// persisted every requestUsageFlushInterval, so that budgets are not reset when the node restarts. Requests are not
+// delayed while the usage is loaded, they are only charged to the usage of this run until then. Without an ORM,
+// budgets are only kept in memory.
+type requestBudget struct {
+	limits    RequestLimits
+	weights   map[string]uint32
+	threshold float64
+	limiter   *rate.Limiter
+	chainID   string
+	name      string
+	now       func() time.Time
+	orm       RequestUsageORM
+	lggr      logger.Logger
+
+	mu            sync.Mutex
+	day, month    time.Time
+	dailyUsed     uint64
+	monthlyUsed   uint64
+	rateLimitedAt time.Time
+	// loadedDay and loadedMonth are the windows which usage was loaded, or is being loaded, from the orm
+	loadedDay, loadedMonth time.Time
+	// loading is true while the usage of the current windows is being loaded, their units are not flushed until then
+	// so that they are not counted twice
+	loading bool
+	// unflushed are the units charged to the current windows and not persisted yet
+	unflushed uint64
+	flushedAt time.Time
+	// wg waits for the usage being loaded and flushed in the background
+	wg sync.WaitGroup
+}
+
+func newRequestBudget(cfg config.RequestBudget, limits RequestLimits, usage RequestUsageORM, chainID *big.Int, name string, lggr logger.Logger) *requestBudget {
+	b := &requestBudget{
+		limits:    limits,
+		threshold: 1,
+		chainID:   chainID.String(),
+		name:      name,
+		now:       time.Now,
+		orm:       usage,
+		lggr:      logger.Named(lggr, "RequestBudget"),
+	}
+	if limits.isZero() {
+		return b
+	}
+	if cfg != nil {
+		b.weights = cfg.MethodWeights()
+		b.threshold = cfg.NearExhaustionThreshold()
+	}
+	if limits.RateLimit > 0 {
+		b.limiter = rate.NewLimiter(rate.Limit(limits.RateLimit), int(limits.RateLimit))
+	}
+	return b
+}
+
+// weight returns the number of compute units of a request calling the given methods.
+func (b *requestBudget) weight(methods ...string) (units uint64) {
+	for _, m := range methods {
+		if w, ok := b.weights[m]; ok {
+			units += uint64(w)
+		} else {
+			units++
+		}
+	}
+	return
+}
+
+// acquire waits until the rate limit allows a request calling the given methods, and charges it to the budgets.
+// Health check requests are charged without being delayed nor rejected, so that throttling does not make the node
+// look unhealthy.
+func (b *requestBudget) acquire(ctx context.Context, methods ...string) error {
+	if b.limits.isZero() {
+		return nil
+	}
+	units := b.weight(methods...)
+	healthCheck := commonclient.CtxIsHeathCheckRequest(ctx)
+	if !healthCheck {
+		// requests which would exceed a budget are rejected without waiting for the rate limit
+		if err := b.checkBudgets(units); err != nil {
+			promEVMPoolRPCNodeRequestsThrottled.WithLabelValues(b.chainID, b.name, throttledReasonBudgetExhausted).Inc()
+			return err
+		}
+		if err := b.wait(ctx, units); err != nil {
+			return err
+		}
+	}
+	if err := b.charge(units, !healthCheck); err != nil {
+		promEVMPoolRPCNodeRequestsThrottled.WithLabelValues(b.chainID, b.name, throttledReasonBudgetExhausted).Inc()
+		return err
+	}
+	return nil
+}
+
+// wait queues the request until the rate limit allows it, a request larger than the burst of the limiter waits for
+// its units in several chunks.
+func (b *requestBudget) wait(ctx context.Context, units uint64) error {
+	if b.limiter == nil {
+		return nil
+	}
+	for remaining := int(units); remaining > 0; {
+		n := min(remaining, b.limiter.Burst())
+		if b.limiter.Tokens() < float64(n) {
+			promEVMPoolRPCNodeRequestsThrottled.WithLabelValues(b.chainID, b.name, throttledReasonRateLimit).Inc()
+			b.mu.Lock()
+			b.rateLimitedAt = b.now()
+			b.mu.Unlock()
+		}
+		if err := b.limiter.WaitN(ctx, n); err != nil {
+			return fmt.Errorf("rate limit of %d compute units per second: %w", b.limits.RateLimit, err)
+		}
+		remaining -= n
+	}
+	return nil
+}
+
+// resetPeriods resets the used budgets when a new day or month started, once the usage of the previous windows was
+// flushed, and starts loading the usage of the new windows. Must be called with mu held.
+func (b *requestBudget) resetPeriods() {
+	now := b.now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if day.Equal(b.day) && month.Equal(b.month) {
+		return
+	}
+	b.flush()
+	if !day.Equal(b.day) {
+		b.day = day
+		b.dailyUsed = 0
+	}
+	if !month.Equal(b.month) {
+		b.month = month
+		b.monthlyUsed = 0
+	}
+	b.load()
+}
+
+// load adds the usage persisted by previous runs of the node to the current windows in the background, once per
+// window. Budgets only count the requests of this run if the usage cannot be loaded. Must be called with mu held.
+func (b *requestBudget) load() {
+	if b.orm == nil {
+		return
+	}
+	loadDay, loadMonth := !b.loadedDay.Equal(b.day), !b.loadedMonth.Equal(b.month)
+	if !loadDay && !loadMonth {
+		return
+	}
+	b.loadedDay, b.loadedMonth = b.day, b.month
+	b.loading = true
+	day, month := b.day, b.month
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), requestUsageQueryTimeout)
+		defer cancel()
+		var dailyUsed, monthlyUsed uint64
+		var err error
+		if loadMonth {
+			if err = b.orm.DeleteRequestUsageBefore(ctx, month); err != nil {
+				b.lggr.Warnw("Failed to delete the request usage of the previous months", "err", err)
+			}
+			if b.limits.MonthlyBudget > 0 {
+				if monthlyUsed, err = b.orm.RequestUsage(ctx, b.name, periodMonthly, month); err != nil {
+					b.lggr.Errorw("Failed to load the monthly request usage, only the requests of this run are counted", "err", err)
+				}
+			}
+		}
+		if loadDay && b.limits.DailyBudget > 0 {
+			if dailyUsed, err = b.orm.RequestUsage(ctx, b.name, periodDaily, day); err != nil {
+				b.lggr.Errorw("Failed to load the daily request usage, only the requests of this run are counted", "err", err)
+			}
+		}
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if b.day.Equal(day) {
+			b.dailyUsed += dailyUsed
+		}
+		if b.month.Equal(month) {
+			b.monthlyUsed += monthlyUsed
+		}
+		if b.day.Equal(day) && b.month.Equal(month) {
+			// otherwise the load of the new windows is still pending
+			b.loading = false
+		}
+	}()
+}
+
+// flush persists the units charged to the current windows in the background. Must be called with mu held.
+func (b *requestBudget) flush() {
+	b.flushedAt = b.now()
+	if b.orm == nil || b.unflushed == 0 {
+		return
+	}
+	units, day, month := b.unflushed, b.day, b.month
+	b.unflushed = 0
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), requestUsageQueryTimeout)
+		defer cancel()
+		b.persist(ctx, units, day, month)
+	}()
+}
+
+func (b *requestBudget) persist(ctx context.Context, units uint64, day, month time.Time) {
+	if b.limits.DailyBudget > 0 {
+		if err := b.orm.AddRequestUsage(ctx, b.name, periodDaily, day, units); err != nil {
+			b.lggr.Errorw("Failed to persist the daily request usage", "units", units, "err", err)
+		}
+	}
+	if b.limits.MonthlyBudget > 0 {
+		if err := b.orm.AddRequestUsage(ctx, b.name, periodMonthly, month, units); err != nil {
+			b.lggr.Errorw("Failed to persist the monthly request usage", "units", units, "err", err)
+		}
+	}
+}
+
+// close persists the units charged since the last flush, once the usage being loaded and flushed in the background
+// was.
+func (b *requestBudget) close() {
+	if b.orm == nil {
+		return
+	}
+	b.wg.Wait()
+	b.mu.Lock()
+	units, day, month := b.unflushed, b.day, b.month
+	b.unflushed = 0
+	b.mu.Unlock()
+	if units == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestUsageQueryTimeout)
+	defer cancel()
+	b.persist(ctx, units, day, month)
+}
+
+// checkBudgets returns an error if charging the units would exceed a budget.
+func (b *requestBudget) checkBudgets(units uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.exceededErr(units)
+}
+
+// exceededErr describes the budget which charging the units would exceed. Must be called with mu held.
+func (b *requestBudget) exceededErr(units uint64) error {
+	b.resetPeriods()
+	if b.limits.DailyBudget > 0 && b.dailyUsed+units > b.limits.DailyBudget {
+		return fmt.Errorf("%w: %d of %d daily compute units used", ErrRequestBudgetExhausted, b.dailyUsed, b.limits.DailyBudget)
+	}
+	if b.limits.MonthlyBudget > 0 && b.monthlyUsed+units > b.limits.MonthlyBudget {
+		return fmt.Errorf("%w: %d of %d monthly compute units used", ErrRequestBudgetExhausted, b.monthlyUsed, b.limits.MonthlyBudget)
+	}
+	return nil
+}
+
+// charge charges the units to the budgets. If enforce is true, the units are checked against the budgets in the same
+// critical section, so that concurrent requests cannot exceed them together.
+func (b *requestBudget) charge(units uint64, enforce bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if enforce {
+		if err := b.exceededErr(units); err != nil {
+			return err
+		}
+	}
+	b.resetPeriods()
+	b.dailyUsed += units
+	b.monthlyUsed += units
+	b.unflushed += units
+	if !b.loading && b.now().Sub(b.flushedAt) >= requestUsageFlushInterval {
+		b.flush()
+	}
+	promEVMPoolRPCNodeRequestUnits.WithLabelValues(b.chainID, b.name).Add(float64(units))
+	if b.limits.DailyBudget > 0 {
+		promEVMPoolRPCNodeRequestBudgetUsed.WithLabelValues(b.chainID, b.name, "daily").Set(float64(b.dailyUsed) / float64(b.limits.DailyBudget))
+	}
+	if b.limits.MonthlyBudget > 0 {
+		promEVMPoolRPCNodeRequestBudgetUsed.WithLabelValues(b.chainID, b.name, "monthly").Set(float64(b.monthlyUsed) / float64(b.limits.MonthlyBudget))
+	}
+	return nil
+}
+
+// nearlyExhausted returns true once the used ratio of a budget reached the configured threshold.
+func (b *requestBudget) nearlyExhausted() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.nearlyExhaustedErr() != nil
+}
+
+// nearlyExhaustedErr describes the budget which used ratio reached the configured threshold. Must be called with mu held.
+func (b *requestBudget) nearlyExhaustedErr() error {
+	b.resetPeriods()
+	if b.limits.DailyBudget > 0 && float64(b.dailyUsed) >= b.threshold*float64(b.limits.DailyBudget) {
+		return fmt.Errorf("daily request budget nearly exhausted: %d of %d compute units used", b.dailyUsed, b.limits.DailyBudget)
+	}
+	if b.limits.MonthlyBudget > 0 && float64(b.monthlyUsed) >= b.threshold*float64(b.limits.MonthlyBudget) {
+		return fmt.Errorf("monthly request budget nearly exhausted: %d of %d compute units used", b.monthlyUsed, b.limits.MonthlyBudget)
+	}
+	return nil
+}
+
+// health returns an error while the requests are throttled, either because the budgets are nearly exhausted or
+// because requests were recently delayed by the rate limit.
+func (b *requestBudget) health() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.nearlyExhaustedErr(); err != nil {
+		return err
+	}
+	if !b.rateLimitedAt.IsZero() && b.now().Sub(b.rateLimitedAt) < rateLimitedHealthWindow {
+		return fmt.Errorf("requests delayed by the rate limit of %d compute units per second", b.limits.RateLimit)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
+
+type testRequestBudgetConfig struct {
+	threshold float64
+	weights   map[string]uint32
+}
+
+func (c testRequestBudgetConfig) NearExhaustionThreshold() float64 { return c.threshold }
+func (c testRequestBudgetConfig) MethodWeights() map[string]uint32 { return c.weights }
+
+type usageKey struct {
+	node, period string
+	start        time.Time
+}
+
+type testRequestUsageORM struct {
+	mu    sync.Mutex
+	usage map[usageKey]uint64
+}
+
+func (o *testRequestUsageORM) RequestUsage(ctx context.Context, nodeName string, period string, start time.Time) (uint64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.usage[usageKey{nodeName, period, start}], nil
+}
+
+func (o *testRequestUsageORM) AddRequestUsage(ctx context.Context, nodeName string, period string, start time.Time, units uint64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.usage[usageKey{nodeName, period, start}] += units
+	return nil
+}
+
+func (o *testRequestUsageORM) DeleteRequestUsageBefore(ctx context.Context, before time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for k := range o.usage {
+		if k.start.Before(before) {
+			delete(o.usage, k)
+		}
+	}
+	return nil
+}
+
+// blockingRequestUsageORM blocks loading the usage until released.
+type blockingRequestUsageORM struct {
+	*testRequestUsageORM
+	released chan struct{}
+}
+
+func (o *blockingRequestUsageORM) RequestUsage(ctx context.Context, nodeName string, period string, start time.Time) (uint64, error) {
+	select {
+	case <-o.released:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	return o.testRequestUsageORM.RequestUsage(ctx, nodeName, period, start)
+}
+
+func (o *testRequestUsageORM) get(nodeName string, period string, start time.Time) uint64 {
+	units, _ := o.RequestUsage(context.Background(), nodeName, period, start)
+	return units
+}
+
+func TestRequestBudget(t *testing.T) {
+	t.Parallel()
+
+	cfg := testRequestBudgetConfig{threshold: 0.5, weights: map[string]uint32{"eth_getLogs": 10}}
+	chainID := big.NewInt(1)
+
+	t.Run("unlimited", func(t *testing.T) {
+		b := newRequestBudget(nil, RequestLimits{}, nil, chainID, "unlimited", logger.Test(t))
+		for i := 0; i < 100; i++ {
+			require.NoError(t, b.acquire(tests.Context(t), "eth_getLogs"))
+		}
+		assert.False(t, b.nearlyExhausted())
+		assert.NoError(t, b.health())
+	})
+
+	t.Run("weights requests by method", func(t *testing.T) {
+		b := newRequestBudget(cfg, RequestLimits{DailyBudget: 100}, nil, chainID, "weights", logger.Test(t))
+		assert.Equal(t, uint64(10), b.weight("eth_getLogs"))
+		assert.Equal(t, uint64(1), b.weight("eth_call"))
+		assert.Equal(t, uint64(12), b.weight("eth_getLogs", "eth_call", "eth_blockNumber"))
+	})
+
+	t.Run("rejects requests once the budget is exhausted and resets it on the next day", func(t *testing.T) {
+		now := time.Date(2024, 1, 31, 23, 0, 0, 0, time.UTC)
+		b := newRequestBudget(cfg, RequestLimits{DailyBudget: 20, MonthlyBudget: 100}, nil, chainID, "daily", logger.Test(t))
+		b.now = func() time.Time { return now }
+
+		ctx := tests.Context(t)
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		assert.True(t, b.nearlyExhausted())
+		assert.ErrorContains(t, b.health(), "daily request budget nearly exhausted")
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		require.ErrorIs(t, b.acquire(ctx, "eth_call"), ErrRequestBudgetExhausted)
+
+		now = now.Add(2 * time.Hour)
+		assert.False(t, b.nearlyExhausted())
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		assert.Equal(t, uint64(10), b.monthlyUsed, "monthly budget must be reset on the next month")
+	})
+
+	t.Run("charges health check requests without rejecting them", func(t *testing.T) {
+		b := newRequestBudget(cfg, RequestLimits{MonthlyBudget: 10}, nil, chainID, "healthCheck", logger.Test(t))
+		ctx := commonclient.CtxAddHealthCheckFlag(tests.Context(t))
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		assert.Equal(t, uint64(20), b.monthlyUsed)
+		assert.ErrorContains(t, b.health(), "monthly request budget nearly exhausted")
+		require.ErrorIs(t, b.acquire(tests.Context(t), "eth_call"), ErrRequestBudgetExhausted)
+	})
+
+	t.Run("queues requests once the rate limit is reached", func(t *testing.T) {
+		b := newRequestBudget(cfg, RequestLimits{RateLimit: 10}, nil, chainID, "rateLimit", logger.Test(t))
+		ctx := tests.Context(t)
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		assert.NoError(t, b.health())
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		require.ErrorContains(t, b.acquire(ctx, "eth_getLogs"), "rate limit of 10 compute units per second")
+		assert.ErrorContains(t, b.health(), "requests delayed by the rate limit")
+	})
+	t.Run("keeps the usage of the budgets across restarts", func(t *testing.T) {
+		now := time.Date(2024, 1, 31, 22, 0, 0, 0, time.UTC)
+		day, month := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		orm := &testRequestUsageORM{usage: map[usageKey]uint64{
+			{"restart", periodDaily, month.AddDate(0, 0, -1)}:   5,
+			{"restart", periodMonthly, month.AddDate(0, -1, 0)}: 5,
+		}}
+		limits := RequestLimits{DailyBudget: 25, MonthlyBudget: 100}
+		b := newRequestBudget(cfg, limits, orm, chainID, "restart", logger.Test(t))
+		b.now = func() time.Time { return now }
+
+		ctx := tests.Context(t)
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		b.close()
+		require.Eventually(t, func() bool {
+			return orm.get("restart", periodDaily, day) == 20 && orm.get("restart", periodMonthly, month) == 20
+		}, tests.WaitTimeout(t), 10*time.Millisecond)
+		assert.Zero(t, orm.get("restart", periodDaily, month.AddDate(0, 0, -1)), "usage of the previous months must be deleted")
+		assert.Zero(t, orm.get("restart", periodMonthly, month.AddDate(0, -1, 0)), "usage of the previous months must be deleted")
+
+		b = newRequestBudget(cfg, limits, orm, chainID, "restart", logger.Test(t))
+		b.now = func() time.Time { return now }
+		// starts loading the usage of the previous run and waits for it
+		require.NoError(t, b.health())
+		b.wg.Wait()
+		require.ErrorIs(t, b.acquire(ctx, "eth_getLogs"), ErrRequestBudgetExhausted)
+		require.NoError(t, b.acquire(ctx, "eth_call"))
+		assert.Equal(t, uint64(21), b.dailyUsed)
+
+		now = now.Add(3 * time.Hour)
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		b.wg.Wait()
+		assert.Equal(t, uint64(10), b.dailyUsed)
+		b.close()
+		assert.Equal(t, uint64(10), orm.get("restart", periodDaily, day.AddDate(0, 0, 1)))
+		assert.Equal(t, uint64(10), orm.get("restart", periodMonthly, month.AddDate(0, 1, 0)))
+	})
+	t.Run("does not delay requests while the usage is loaded", func(t *testing.T) {
+		now := time.Date(2024, 1, 31, 22, 0, 0, 0, time.UTC)
+		day := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+		orm := &blockingRequestUsageORM{
+			testRequestUsageORM: &testRequestUsageORM{usage: map[usageKey]uint64{{"loading", periodDaily, day}: 15}},
+			released:            make(chan struct{}),
+		}
+		b := newRequestBudget(cfg, RequestLimits{DailyBudget: 25}, orm, chainID, "loading", logger.Test(t))
+		b.now = func() time.Time { return now }
+
+		ctx := tests.Context(t)
+		require.NoError(t, b.acquire(ctx, "eth_getLogs"))
+		close(orm.released)
+		b.wg.Wait()
+		b.mu.Lock()
+		assert.Equal(t, uint64(25), b.dailyUsed)
+		assert.Equal(t, uint64(10), b.unflushed, "units charged while loading must not be flushed before the usage is loaded")
+		b.mu.Unlock()
+		require.ErrorIs(t, b.acquire(ctx, "eth_call"), ErrRequestBudgetExhausted)
+		b.close()
+		assert.Equal(t, uint64(25), orm.get("loading", periodDaily, day))
+	})
+	t.Run("concurrent requests do not exceed the budget", func(t *testing.T) {
+		b := newRequestBudget(cfg, RequestLimits{DailyBudget: 50}, nil, chainID, "concurrent", logger.Test(t))
+
+		ctx := tests.Context(t)
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var accepted int
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if b.acquire(ctx, "eth_call") == nil {
+					mu.Lock()
+					accepted++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 50, accepted)
+		assert.Equal(t, uint64(50), b.dailyUsed)
+	})
+}
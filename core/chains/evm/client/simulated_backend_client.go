@@ -565,6 +565,12 @@ func (c *SimulatedBackendClient) Backend() evmtypes.Backend {
 // NodeStates implements evmclient.Client
 func (c *SimulatedBackendClient) NodeStates() map[string]string { return nil }
 
+// NodeRPCStats implements evmclient.Client
+func (c *SimulatedBackendClient) NodeRPCStats() map[string]commonclient.RPCStats { return nil }
+
+// NodeThrottling implements evmclient.Client
+func (c *SimulatedBackendClient) NodeThrottling() map[string]error { return nil }
+
 // Commit imports all the pending transactions as a single block and starts a
 // fresh new state.
 func (c *SimulatedBackendClient) Commit() common.Hash {
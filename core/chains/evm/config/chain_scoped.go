@@ -4,6 +4,8 @@ import (
 	"math/big"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
@@ -76,6 +78,16 @@ func (e *EVMConfig) GasEstimator() GasEstimator {
 	return &gasEstimatorConfig{c: e.C.GasEstimator, blockDelay: e.C.RPCBlockQueryDelay, transactionsMaxInFlight: e.C.Transactions.MaxInFlight, k: e.C.KeySpecific}
 }
 
+func (e *EVMConfig) RemoteSigners() map[gethcommon.Address]RemoteSigner {
+	signers := make(map[gethcommon.Address]RemoteSigner)
+	for _, k := range e.C.KeySpecific {
+		if k.Key != nil && k.RemoteSigner.URL != nil {
+			signers[k.Key.Address()] = &remoteSignerConfig{c: k.RemoteSigner}
+		}
+	}
+	return signers
+}
+
 func (e *EVMConfig) AutoCreateKey() bool {
 	return *e.C.AutoCreateKey
 }
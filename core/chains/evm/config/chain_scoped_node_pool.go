@@ -51,3 +51,75 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+func (n *NodePoolConfig) LatencyWeighted() LatencyWeighted {
+	return &latencyWeightedConfig{c: n.C.LatencyWeighted}
+}
+
+type latencyWeightedConfig struct {
+	c toml.LatencyWeighted
+}
+
+func (l *latencyWeightedConfig) LatencyP50Weight() float64 {
+	return l.c.LatencyP50Weight.InexactFloat64()
+}
+
+func (l *latencyWeightedConfig) LatencyP99Weight() float64 {
+	return l.c.LatencyP99Weight.InexactFloat64()
+}
+
+func (l *latencyWeightedConfig) ErrorRatePenalty() time.Duration {
+	return l.c.ErrorRatePenalty.Duration()
+}
+
+func (l *latencyWeightedConfig) Hysteresis() float64 {
+	return l.c.Hysteresis.InexactFloat64()
+}
+
+func (n *NodePoolConfig) ReadQuorum() ReadQuorum {
+	return &readQuorumConfig{c: n.C.ReadQuorum}
+}
+
+type readQuorumConfig struct {
+	c toml.ReadQuorum
+}
+
+func (r *readQuorumConfig) Enabled() bool {
+	return *r.c.Enabled
+}
+
+func (r *readQuorumConfig) Methods() []string {
+	return r.c.Methods
+}
+
+func (r *readQuorumConfig) Nodes() uint32 {
+	return *r.c.Nodes
+}
+
+func (r *readQuorumConfig) Agreement() uint32 {
+	return *r.c.Agreement
+}
+
+func (r *readQuorumConfig) DemotionThreshold() uint32 {
+	return *r.c.DemotionThreshold
+}
+
+func (r *readQuorumConfig) DemotionCooldown() time.Duration {
+	return r.c.DemotionCooldown.Duration()
+}
+
+func (n *NodePoolConfig) RequestBudget() RequestBudget {
+	return &requestBudgetConfig{c: n.C.RequestBudget}
+}
+
+type requestBudgetConfig struct {
+	c toml.RequestBudget
+}
+
+func (r *requestBudgetConfig) NearExhaustionThreshold() float64 {
+	return r.c.NearExhaustionThreshold.InexactFloat64()
+}
+
+func (r *requestBudgetConfig) MethodWeights() map[string]uint32 {
+	return r.c.MethodWeights
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+const defaultRemoteSignerTimeout = 10 * time.Second
+
+type remoteSignerConfig struct {
+	c toml.KeySpecificRemoteSigner
+}
+
+func (r *remoteSignerConfig) URL() *url.URL {
+	return r.c.URL.URL()
+}
+
+func (r *remoteSignerConfig) Timeout() time.Duration {
+	if r.c.Timeout == nil {
+		return defaultRemoteSignerTimeout
+	}
+	return r.c.Timeout.Duration()
+}
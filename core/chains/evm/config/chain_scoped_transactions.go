@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -39,6 +41,14 @@ func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
 
+func (t *transactionsConfig) PrivateRelay() PrivateRelayConfig {
+	return &privateRelayConfig{c: t.c.PrivateRelay}
+}
+
+func (t *transactionsConfig) AccessList() AccessListConfig {
+	return &accessListConfig{c: t.c.AccessList}
+}
+
 type autoPurgeConfig struct {
 	c toml.AutoPurgeConfig
 }
@@ -58,3 +68,46 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+type accessListConfig struct {
+	c toml.AccessListConfig
+}
+
+func (a *accessListConfig) Enabled() bool {
+	return *a.c.Enabled
+}
+
+func (a *accessListConfig) CacheTTL() time.Duration {
+	return a.c.CacheTTL.Duration()
+}
+
+type privateRelayConfig struct {
+	c toml.PrivateRelayConfig
+}
+
+func (p *privateRelayConfig) Enabled() bool {
+	return *p.c.Enabled
+}
+
+func (p *privateRelayConfig) URL() *url.URL {
+	return p.c.URL.URL()
+}
+
+func (p *privateRelayConfig) SigningKey() gethcommon.Address {
+	if p.c.SigningKey == nil {
+		return gethcommon.Address{}
+	}
+	return p.c.SigningKey.Address()
+}
+
+func (p *privateRelayConfig) Keys() []gethcommon.Address {
+	keys := make([]gethcommon.Address, len(p.c.Keys))
+	for i, k := range p.c.Keys {
+		keys[i] = k.Address()
+	}
+	return keys
+}
+
+func (p *privateRelayConfig) FallbackBlocks() uint32 {
+	return *p.c.FallbackBlocks
+}
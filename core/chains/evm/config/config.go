@@ -24,6 +24,7 @@ type EVM interface {
 	OCR2() OCR2
 	Workflow() Workflow
 	NodePool() NodePool
+	RemoteSigners() map[gethcommon.Address]RemoteSigner
 
 	AutoCreateKey() bool
 	BlockBackfillDepth() uint64
@@ -54,6 +55,12 @@ type EVM interface {
 	TOMLString() (string, error)
 }
 
+// RemoteSigner configures the external signer holding the private key of a sending key.
+type RemoteSigner interface {
+	URL() *url.URL
+	Timeout() time.Duration
+}
+
 type OCR interface {
 	ContractConfirmations() uint16
 	ContractTransmitterTransmitTimeout() time.Duration
@@ -110,6 +117,8 @@ type Transactions interface {
 	MaxInFlight() uint32
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
+	AccessList() AccessListConfig
+	PrivateRelay() PrivateRelayConfig
 }
 
 type AutoPurgeConfig interface {
@@ -119,6 +128,19 @@ type AutoPurgeConfig interface {
 	DetectionApiUrl() *url.URL
 }
 
+type AccessListConfig interface {
+	Enabled() bool
+	CacheTTL() time.Duration
+}
+
+type PrivateRelayConfig interface {
+	Enabled() bool
+	URL() *url.URL
+	SigningKey() gethcommon.Address
+	Keys() []gethcommon.Address
+	FallbackBlocks() uint32
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
@@ -192,6 +214,33 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	LatencyWeighted() LatencyWeighted
+	ReadQuorum() ReadQuorum
+	RequestBudget() RequestBudget
+}
+
+// LatencyWeighted configures the scoring of the nodes by the LatencyWeighted selection mode.
+type LatencyWeighted interface {
+	LatencyP50Weight() float64
+	LatencyP99Weight() float64
+	ErrorRatePenalty() time.Duration
+	Hysteresis() float64
+}
+
+// ReadQuorum configures the read methods which results must be confirmed by several nodes.
+type ReadQuorum interface {
+	Enabled() bool
+	Methods() []string
+	Nodes() uint32
+	Agreement() uint32
+	DemotionThreshold() uint32
+	DemotionCooldown() time.Duration
+}
+
+// RequestBudget configures how the requests are charged to the rate limits and budgets of the nodes.
+type RequestBudget interface {
+	NearExhaustionThreshold() float64
+	MethodWeights() map[string]uint32
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/pelletier/go-toml/v2"
@@ -479,7 +480,9 @@ type Transactions struct {
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge    AutoPurgeConfig    `toml:",omitempty"`
+	AccessList   AccessListConfig   `toml:",omitempty"`
+	PrivateRelay PrivateRelayConfig `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -502,6 +505,8 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.AccessList.setFrom(&f.AccessList)
+	t.PrivateRelay.setFrom(&f.PrivateRelay)
 }
 
 type AutoPurgeConfig struct {
@@ -526,6 +531,68 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+type AccessListConfig struct {
+	Enabled  *bool
+	CacheTTL *commonconfig.Duration
+}
+
+func (a *AccessListConfig) setFrom(f *AccessListConfig) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.CacheTTL; v != nil {
+		a.CacheTTL = v
+	}
+}
+
+type PrivateRelayConfig struct {
+	Enabled        *bool
+	URL            *commonconfig.URL
+	SigningKey     *types.EIP55Address
+	Keys           []types.EIP55Address
+	FallbackBlocks *uint32
+}
+
+func (p *PrivateRelayConfig) setFrom(f *PrivateRelayConfig) {
+	if v := f.Enabled; v != nil {
+		p.Enabled = v
+	}
+	if v := f.URL; v != nil {
+		p.URL = v
+	}
+	if v := f.SigningKey; v != nil {
+		p.SigningKey = v
+	}
+	if v := f.Keys; v != nil {
+		p.Keys = v
+	}
+	if v := f.FallbackBlocks; v != nil {
+		p.FallbackBlocks = v
+	}
+}
+
+func (p *PrivateRelayConfig) ValidateConfig() (err error) {
+	if p.Enabled == nil || !*p.Enabled {
+		return
+	}
+	if p.URL == nil || p.URL.IsZero() {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "URL", Msg: "must be set if the private relay is enabled"})
+	} else {
+		switch p.URL.Scheme {
+		case "http", "https":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "URL", Value: p.URL.Scheme, Msg: "must be http or https"})
+		}
+	}
+	if p.SigningKey == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "SigningKey", Msg: "must be set if the private relay is enabled"})
+	}
+	if p.FallbackBlocks != nil && *p.FallbackBlocks == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FallbackBlocks", Value: 0, Msg: "must be greater than 0"})
+	}
+	return
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -842,6 +909,7 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 type KeySpecific struct {
 	Key          *types.EIP55Address
 	GasEstimator KeySpecificGasEstimator `toml:",omitempty"`
+	RemoteSigner KeySpecificRemoteSigner `toml:",omitempty"`
 }
 
 type KeySpecificGasEstimator struct {
@@ -854,6 +922,38 @@ func (e *KeySpecificGasEstimator) setFrom(f *KeySpecificGasEstimator) {
 	}
 }
 
+type KeySpecificRemoteSigner struct {
+	URL     *commonconfig.URL
+	Timeout *commonconfig.Duration
+}
+
+func (r *KeySpecificRemoteSigner) setFrom(f *KeySpecificRemoteSigner) {
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+}
+
+func (r *KeySpecificRemoteSigner) ValidateConfig() (err error) {
+	if r.URL == nil {
+		if r.Timeout != nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "URL", Msg: "must be set when Timeout is set"})
+		}
+		return
+	}
+	switch r.URL.Scheme {
+	case "http", "https":
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "URL", Value: r.URL.String(), Msg: "must be an http or https URL"})
+	}
+	if r.Timeout != nil && r.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Timeout", Value: r.Timeout.Duration(), Msg: "must be greater than zero"})
+	}
+	return
+}
+
 type HeadTracker struct {
 	HistoryDepth            *uint32
 	MaxBufferSize           *uint32
@@ -972,6 +1072,9 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	LatencyWeighted            LatencyWeighted `toml:",omitempty"`
+	ReadQuorum                 ReadQuorum      `toml:",omitempty"`
+	RequestBudget              RequestBudget   `toml:",omitempty"`
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -1010,9 +1113,15 @@ func (p *NodePool) setFrom(f *NodePool) {
 	}
 
 	p.Errors.setFrom(&f.Errors)
+	p.LatencyWeighted.setFrom(&f.LatencyWeighted)
+	p.ReadQuorum.setFrom(&f.ReadQuorum)
+	p.RequestBudget.setFrom(&f.RequestBudget)
 }
 
 func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
+	err = multierr.Append(err, p.LatencyWeighted.ValidateConfig())
+	err = multierr.Append(err, p.ReadQuorum.ValidateConfig())
+	err = multierr.Append(err, p.RequestBudget.ValidateConfig())
 	if finalityTagEnabled != nil && *finalityTagEnabled {
 		if p.FinalizedBlockPollInterval == nil {
 			err = multierr.Append(err, commonconfig.ErrMissing{Name: "FinalizedBlockPollInterval", Msg: "required when FinalityTagEnabled is true"})
@@ -1026,6 +1135,140 @@ func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
 	return
 }
 
+// LatencyWeighted configures the scoring of the nodes by the LatencyWeighted selection mode.
+type LatencyWeighted struct {
+	LatencyP50Weight *decimal.Decimal
+	LatencyP99Weight *decimal.Decimal
+	ErrorRatePenalty *commonconfig.Duration
+	Hysteresis       *decimal.Decimal
+}
+
+func (l *LatencyWeighted) setFrom(f *LatencyWeighted) {
+	if v := f.LatencyP50Weight; v != nil {
+		l.LatencyP50Weight = v
+	}
+	if v := f.LatencyP99Weight; v != nil {
+		l.LatencyP99Weight = v
+	}
+	if v := f.ErrorRatePenalty; v != nil {
+		l.ErrorRatePenalty = v
+	}
+	if v := f.Hysteresis; v != nil {
+		l.Hysteresis = v
+	}
+}
+
+func (l *LatencyWeighted) ValidateConfig() (err error) {
+	if l.LatencyP50Weight != nil && l.LatencyP50Weight.IsNegative() {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "LatencyWeighted.LatencyP50Weight", Value: l.LatencyP50Weight,
+			Msg: "must not be negative"})
+	}
+	if l.LatencyP99Weight != nil && l.LatencyP99Weight.IsNegative() {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "LatencyWeighted.LatencyP99Weight", Value: l.LatencyP99Weight,
+			Msg: "must not be negative"})
+	}
+	if l.ErrorRatePenalty != nil && l.ErrorRatePenalty.Duration() < 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "LatencyWeighted.ErrorRatePenalty", Value: l.ErrorRatePenalty,
+			Msg: "must not be negative"})
+	}
+	if l.Hysteresis != nil && (l.Hysteresis.IsNegative() || l.Hysteresis.GreaterThanOrEqual(decimal.NewFromInt(1))) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "LatencyWeighted.Hysteresis", Value: l.Hysteresis,
+			Msg: "must be at least 0 and less than 1"})
+	}
+	return
+}
+
+// ReadQuorumMethods are the read methods which results can be confirmed by a quorum of nodes.
+var ReadQuorumMethods = []string{"CallContract", "HeaderByNumber", "FilterLogs"}
+
+// ReadQuorum configures the read methods which results must be confirmed by several nodes.
+type ReadQuorum struct {
+	Enabled           *bool
+	Methods           []string
+	Nodes             *uint32
+	Agreement         *uint32
+	DemotionThreshold *uint32
+	DemotionCooldown  *commonconfig.Duration
+}
+
+func (r *ReadQuorum) setFrom(f *ReadQuorum) {
+	if v := f.Enabled; v != nil {
+		r.Enabled = v
+	}
+	if v := f.Methods; v != nil {
+		r.Methods = v
+	}
+	if v := f.Nodes; v != nil {
+		r.Nodes = v
+	}
+	if v := f.Agreement; v != nil {
+		r.Agreement = v
+	}
+	if v := f.DemotionThreshold; v != nil {
+		r.DemotionThreshold = v
+	}
+	if v := f.DemotionCooldown; v != nil {
+		r.DemotionCooldown = v
+	}
+}
+
+func (r *ReadQuorum) ValidateConfig() (err error) {
+	for _, m := range r.Methods {
+		if !slices.Contains(ReadQuorumMethods, m) {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ReadQuorum.Methods", Value: m,
+				Msg: fmt.Sprintf("must be one of %s", strings.Join(ReadQuorumMethods, ", "))})
+		}
+	}
+	if r.Agreement != nil && *r.Agreement == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ReadQuorum.Agreement", Value: *r.Agreement,
+			Msg: "must be greater than 0"})
+	}
+	if r.Nodes != nil && r.Agreement != nil {
+		if *r.Nodes < *r.Agreement {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ReadQuorum.Nodes", Value: *r.Nodes,
+				Msg: "must be greater than or equal to Agreement"})
+		} else if *r.Nodes >= 2**r.Agreement {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ReadQuorum.Agreement", Value: *r.Agreement,
+				Msg: "must be a majority of Nodes"})
+		}
+	}
+	return
+}
+
+// RequestBudget configures how the requests are charged to the rate limits and budgets of the nodes.
+type RequestBudget struct {
+	NearExhaustionThreshold *decimal.Decimal
+	MethodWeights           map[string]uint32 `toml:",omitempty"`
+}
+
+func (r *RequestBudget) setFrom(f *RequestBudget) {
+	if v := f.NearExhaustionThreshold; v != nil {
+		r.NearExhaustionThreshold = v
+	}
+	if len(f.MethodWeights) > 0 {
+		if r.MethodWeights == nil {
+			r.MethodWeights = make(map[string]uint32, len(f.MethodWeights))
+		}
+		for method, weight := range f.MethodWeights {
+			r.MethodWeights[method] = weight
+		}
+	}
+}
+
+func (r *RequestBudget) ValidateConfig() (err error) {
+	if r.NearExhaustionThreshold != nil && (!r.NearExhaustionThreshold.IsPositive() || r.NearExhaustionThreshold.GreaterThan(decimal.NewFromInt(1))) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "RequestBudget.NearExhaustionThreshold", Value: r.NearExhaustionThreshold,
+			Msg: "must be greater than 0 and less than or equal to 1"})
+	}
+	for method, weight := range r.MethodWeights {
+		if weight == 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "RequestBudget.MethodWeights." + method, Value: weight,
+				Msg: "must be greater than 0"})
+		}
+	}
+	return
+}
+
 type OCR struct {
 	ContractConfirmations              *uint16
 	ContractTransmitterTransmitTimeout *commonconfig.Duration
@@ -1057,11 +1300,14 @@ func (o *OCR) setFrom(f *OCR) {
 }
 
 type Node struct {
-	Name     *string
-	WSURL    *commonconfig.URL
-	HTTPURL  *commonconfig.URL
-	SendOnly *bool
-	Order    *int32
+	Name          *string
+	WSURL         *commonconfig.URL
+	HTTPURL       *commonconfig.URL
+	SendOnly      *bool
+	Order         *int32
+	RateLimit     *uint32
+	DailyBudget   *uint64
+	MonthlyBudget *uint64
 }
 
 func (n *Node) ValidateConfig() (err error) {
@@ -1099,6 +1345,10 @@ func (n *Node) ValidateConfig() (err error) {
 		n.Order = &z
 	}
 
+	if n.DailyBudget != nil && n.MonthlyBudget != nil && *n.MonthlyBudget > 0 && *n.DailyBudget > *n.MonthlyBudget {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "DailyBudget", Value: *n.DailyBudget, Msg: "must not exceed MonthlyBudget"})
+	}
+
 	return
 }
 
@@ -1118,6 +1368,15 @@ func (n *Node) SetFrom(f *Node) {
 	if f.Order != nil {
 		n.Order = f.Order
 	}
+	if f.RateLimit != nil {
+		n.RateLimit = f.RateLimit
+	}
+	if f.DailyBudget != nil {
+		n.DailyBudget = f.DailyBudget
+	}
+	if f.MonthlyBudget != nil {
+		n.MonthlyBudget = f.MonthlyBudget
+	}
 }
 
 func ChainIDInt64(cid string) (int64, error) {
@@ -3,12 +3,14 @@ package toml_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
 func TestEVMConfig_ValidateConfig(t *testing.T) {
@@ -29,3 +31,27 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestKeySpecificConfig_ValidateConfig_RemoteSigner(t *testing.T) {
+	key := types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	for _, tt := range []struct {
+		name   string
+		signer toml.KeySpecificRemoteSigner
+		errMsg string
+	}{
+		{name: "unset"},
+		{name: "valid", signer: toml.KeySpecificRemoteSigner{URL: config.MustParseURL("https://signer.test"), Timeout: config.MustNewDuration(time.Second)}},
+		{name: "timeout without url", signer: toml.KeySpecificRemoteSigner{Timeout: config.MustNewDuration(time.Second)}, errMsg: "URL: missing: must be set when Timeout is set"},
+		{name: "websocket url", signer: toml.KeySpecificRemoteSigner{URL: config.MustParseURL("wss://signer.test")}, errMsg: "URL: invalid value (wss://signer.test): must be an http or https URL"},
+		{name: "zero timeout", signer: toml.KeySpecificRemoteSigner{URL: config.MustParseURL("http://signer.test"), Timeout: config.MustNewDuration(0)}, errMsg: "Timeout: invalid value (0s): must be greater than zero"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := config.Validate(toml.KeySpecificConfig{{Key: &key, RemoteSigner: tt.signer}})
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
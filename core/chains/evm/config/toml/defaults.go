@@ -252,6 +252,7 @@ func (c *Chain) SetFrom(f *Chain) {
 				c.KeySpecific = append(c.KeySpecific, v)
 			} else {
 				c.KeySpecific[i].GasEstimator.setFrom(&v.GasEstimator)
+				c.KeySpecific[i].RemoteSigner.setFrom(&v.RemoteSigner)
 			}
 		}
 	}
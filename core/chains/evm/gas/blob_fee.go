@@ -0,0 +1,83 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pkgerrors "github.com/pkg/errors"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/label"
+)
+
+const (
+	// blobFeeCapMultiplier is applied to the blob base fee of the next block to get the max fee per blob gas, so that
+	// blob transactions remain includable for a few blocks while the blob base fee rises (by 12.5% per block at most)
+	blobFeeCapMultiplier = 2
+	// BlobTxPriceBump is the percentage by which all the fees of a blob transaction must be bumped for blob pools to
+	// accept the replacement, see https://github.com/ethereum/go-ethereum/blob/v1.14.11/core/txpool/blobpool/config.go
+	BlobTxPriceBump = 100
+)
+
+// blobFeeHistory is the part of the eth_feeHistory response carrying the blob base fees, which ethereum.FeeHistory
+// does not expose.
+type blobFeeHistory struct {
+	// BaseFeePerBlobGas holds the blob base fee of the requested blocks and of the next block, last
+	BaseFeePerBlobGas []*hexutil.Big `json:"baseFeePerBlobGas"`
+}
+
+// fetchBlobBaseFee returns the blob base fee of the next block, from eth_feeHistory or from eth_blobBaseFee if the RPC
+// does not return blob base fees in the fee history.
+func fetchBlobBaseFee(ctx context.Context, client feeEstimatorClient) (*assets.Wei, error) {
+	var history blobFeeHistory
+	if err := client.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint64(1), "latest", []float64{}); err == nil && len(history.BaseFeePerBlobGas) > 0 {
+		if next := history.BaseFeePerBlobGas[len(history.BaseFeePerBlobGas)-1]; next != nil {
+			return assets.NewWei(next.ToInt()), nil
+		}
+	}
+	var blobBaseFee hexutil.Big
+	if err := client.CallContext(ctx, &blobBaseFee, "eth_blobBaseFee"); err != nil {
+		return nil, fmt.Errorf("failed to fetch blob base fee: %w", err)
+	}
+	return assets.NewWei(blobBaseFee.ToInt()), nil
+}
+
+// getBlobFeeCap returns the max fee per blob gas for a new blob transaction.
+func (e *evmFeeEstimator) getBlobFeeCap(ctx context.Context, maxFeePrice *assets.Wei) (*assets.Wei, error) {
+	blobBaseFee, err := fetchBlobBaseFee(ctx, e.ethClient)
+	if err != nil {
+		return nil, err
+	}
+	// the blob base fee is never lower than 1 wei
+	blobFeeCap := assets.WeiMax(blobBaseFee, assets.NewWeiI(1)).Mul(big.NewInt(blobFeeCapMultiplier))
+	return capGasPrice(blobFeeCap, maxFeePrice, e.geCfg.PriceMax()), nil
+}
+
+// bumpBlobFee bumps the fees of a blob transaction by at least BlobTxPriceBump, as required by blob pools to replace
+// it, or up to the current estimates if higher.
+func (e *evmFeeEstimator) bumpBlobFee(ctx context.Context, originalFee EvmFee, bumpedDynamic DynamicFee, maxFeePrice *assets.Wei) (bumpedFee EvmFee, err error) {
+	maxGasPrice := getMaxGasPrice(maxFeePrice, e.geCfg.PriceMax())
+
+	bumpedFee.GasTipCap = assets.WeiMax(bumpedDynamic.GasTipCap, originalFee.GasTipCap.AddPercentage(BlobTxPriceBump))
+	bumpedFee.GasFeeCap = assets.WeiMax(bumpedDynamic.GasFeeCap, originalFee.GasFeeCap.AddPercentage(BlobTxPriceBump))
+	bumpedFee.BlobFeeCap = originalFee.BlobFeeCap.AddPercentage(BlobTxPriceBump)
+	if currentBlobFeeCap, blobErr := e.getBlobFeeCap(ctx, maxFeePrice); blobErr != nil {
+		e.lggr.Warnw("Failed to get the current blob fee, bumping the blob fee of the previous attempt", "err", blobErr)
+	} else {
+		bumpedFee.BlobFeeCap = assets.WeiMax(bumpedFee.BlobFeeCap, currentBlobFeeCap)
+	}
+
+	for _, bumped := range []struct {
+		name string
+		fee  *assets.Wei
+	}{{"tip cap", bumpedFee.GasTipCap}, {"fee cap", bumpedFee.GasFeeCap}, {"blob fee cap", bumpedFee.BlobFeeCap}} {
+		if bumped.fee.Cmp(maxGasPrice) > 0 {
+			return bumpedFee, pkgerrors.Wrapf(commonfee.ErrBumpFeeExceedsLimit, "bumped %s of %s would exceed configured max gas price of %s (original fee: %s). %s",
+				bumped.name, bumped.fee.String(), maxGasPrice, originalFee.String(), label.NodeConnectivityProblemWarning)
+		}
+	}
+	return bumpedFee, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -175,9 +176,14 @@ var _ feetypes.Fee = (*EvmFee)(nil)
 type EvmFee struct {
 	GasPrice *assets.Wei
 	DynamicFee
+	// BlobFeeCap is the max fee per blob gas, only set for blob transactions (EIP-4844)
+	BlobFeeCap *assets.Wei
 }
 
 func (fee EvmFee) String() string {
+	if fee.BlobFeeCap != nil {
+		return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s, BlobFeeCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap, fee.BlobFeeCap)
+	}
 	return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap)
 }
 
@@ -276,10 +282,12 @@ func (e *evmFeeEstimator) L1Oracle() rollups.L1Oracle {
 
 // GetFee returns an initial estimated gas price and gas limit for a transaction
 // The gas limit provided by the caller can be adjusted by gas estimation or for 2D fees
+// Blob transactions, priced with feetypes.OptBlobTx, always get dynamic fees along with their blob fee
 func (e *evmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error) {
 	var chainSpecificFeeLimit uint64
+	blobTx := slices.Contains(opts, feetypes.OptBlobTx)
 	// get dynamic fee
-	if e.EIP1559Enabled {
+	if e.EIP1559Enabled || blobTx {
 		var dynamicFee DynamicFee
 		dynamicFee, err = e.EvmEstimator.GetDynamicFee(ctx, maxFeePrice)
 		if err != nil {
@@ -288,6 +296,12 @@ func (e *evmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit
 		fee.GasFeeCap = dynamicFee.GasFeeCap
 		fee.GasTipCap = dynamicFee.GasTipCap
 		chainSpecificFeeLimit = feeLimit
+		if blobTx {
+			fee.BlobFeeCap, err = e.getBlobFeeCap(ctx, maxFeePrice)
+			if err != nil {
+				return
+			}
+		}
 	} else {
 		// get legacy fee
 		fee.GasPrice, chainSpecificFeeLimit, err = e.EvmEstimator.GetLegacyGas(ctx, calldata, feeLimit, maxFeePrice, opts...)
@@ -338,6 +352,13 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 			return
 		}
 		chainSpecificFeeLimit, err = commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
+		if err != nil {
+			return
+		}
+		if originalFee.BlobFeeCap != nil {
+			bumpedFee, err = e.bumpBlobFee(ctx, originalFee, bumpedDynamic, maxFeePrice)
+			return
+		}
 		bumpedFee.GasFeeCap = bumpedDynamic.GasFeeCap
 		bumpedFee.GasTipCap = bumpedDynamic.GasTipCap
 		return
@@ -1,10 +1,12 @@
 package gas_test
 
 import (
+	"encoding/json"
 	"errors"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -14,7 +16,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	clmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -407,3 +411,96 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestWrappedEvmEstimator_BlobFees(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+
+	dynamicFee := gas.DynamicFee{
+		GasFeeCap: assets.NewWeiI(20),
+		GasTipCap: assets.NewWeiI(1),
+	}
+	maxPrice := assets.NewWeiI(1000)
+	geCfg := gas.NewMockGasConfig()
+	geCfg.LimitMultiplierF = 1
+	geCfg.PriceMaxF = maxPrice
+
+	mockFeeHistory := func(ethClient *clmocks.Client, blobBaseFees ...int64) {
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, "latest", mock.Anything).Run(func(args mock.Arguments) {
+			fees := []*hexutil.Big{}
+			for _, f := range blobBaseFees {
+				fees = append(fees, (*hexutil.Big)(big.NewInt(f)))
+			}
+			b, err := json.Marshal(map[string]any{"baseFeePerBlobGas": fees})
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, args.Get(1)))
+		}).Return(nil).Once()
+	}
+
+	t.Run("GetFee prices blob gas from the blob base fee of the next block", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("GetDynamicFee", mock.Anything, mock.Anything).Return(dynamicFee, nil).Once()
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		mockFeeHistory(ethClient, 10, 15)
+
+		// blob transactions get dynamic fees even if EIP-1559 is disabled
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return est }, false, geCfg, ethClient)
+		fee, _, err := estimator.GetFee(ctx, []byte{}, 10, maxPrice, nil, nil, feetypes.OptBlobTx)
+		require.NoError(t, err)
+		assert.Nil(t, fee.GasPrice)
+		assert.True(t, dynamicFee.GasFeeCap.Equal(fee.GasFeeCap))
+		assert.Equal(t, assets.NewWeiI(30), fee.BlobFeeCap)
+	})
+
+	t.Run("GetFee falls back to eth_blobBaseFee", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("GetDynamicFee", mock.Anything, mock.Anything).Return(dynamicFee, nil).Once()
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		mockFeeHistory(ethClient)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Run(func(args mock.Arguments) {
+			*args.Get(1).(*hexutil.Big) = hexutil.Big(*big.NewInt(7))
+		}).Return(nil).Once()
+
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, ethClient)
+		fee, _, err := estimator.GetFee(ctx, []byte{}, 10, maxPrice, nil, nil, feetypes.OptBlobTx)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(14), fee.BlobFeeCap)
+	})
+
+	t.Run("BumpFee doubles all the fees of blob transactions", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("BumpDynamicFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.DynamicFee{
+			GasFeeCap: assets.NewWeiI(24),
+			GasTipCap: assets.NewWeiI(2),
+		}, nil).Twice()
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, ethClient)
+		original := gas.EvmFee{DynamicFee: dynamicFee, BlobFeeCap: assets.NewWeiI(30)}
+
+		mockFeeHistory(ethClient, 10, 15)
+		bumped, _, err := estimator.BumpFee(ctx, original, 10, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(40), bumped.GasFeeCap)
+		assert.Equal(t, assets.NewWeiI(2), bumped.GasTipCap)
+		assert.Equal(t, assets.NewWeiI(60), bumped.BlobFeeCap)
+
+		// the blob fee is bumped up to the current estimate if higher
+		mockFeeHistory(ethClient, 10, 50)
+		bumped, _, err = estimator.BumpFee(ctx, original, 10, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), bumped.BlobFeeCap)
+	})
+
+	t.Run("BumpFee fails if a bumped blob fee exceeds the max price", func(t *testing.T) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("BumpDynamicFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dynamicFee, nil).Once()
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		mockFeeHistory(ethClient, 10, 15)
+		estimator := gas.NewEvmFeeEstimator(lggr, func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, ethClient)
+
+		_, _, err := estimator.BumpFee(ctx, gas.EvmFee{DynamicFee: dynamicFee, BlobFeeCap: assets.NewWeiI(600)}, 10, maxPrice, nil)
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+		require.ErrorContains(t, err, "bumped blob fee cap of 1.2 kwei would exceed configured max gas price of 1 kwei")
+	})
+}
@@ -12,6 +12,7 @@ import (
 type Eth interface {
 	CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error
 	EnabledAddressesForChain(ctx context.Context, chainID *big.Int) (addresses []common.Address, err error)
+	SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error)
 	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
 	SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, unsub func())
 }
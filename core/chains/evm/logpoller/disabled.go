@@ -122,6 +122,18 @@ func (d disabled) FilteredLogs(_ context.Context, _ []query.Expression, _ query.
 	return nil, ErrDisabled
 }
 
+func (d disabled) Subscribe(ctx context.Context, filterName string, confs evmtypes.Confirmations, fromBlock int64) (Subscription, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) ReorgsCreatedAfter(ctx context.Context, after time.Time) ([]Reorg, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) ReorgedLogs(ctx context.Context, reorgID int64) ([]Log, error) {
+	return nil, ErrDisabled
+}
+
 func (d disabled) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
 	return nil, ErrDisabled
 }
@@ -19,4 +19,7 @@
 //   - After calling Replay(fromBlock), all blocks including that one to the latest chain tip will be polled
 //     with the current filter. This can be used on first time job add to specify a start block from which you wish to capture
 //     existing logs.
+//   - Subscribe(filterName, confs, fromBlock) streams the logs of a registered filter as soon as they are persisted,
+//     followed by removal notices for delivered logs that get orphaned by a reorg. Subscribers falling behind are
+//     terminated and can resume from the last fully delivered block without gaps.
 package logpoller
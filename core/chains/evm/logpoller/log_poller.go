@@ -49,6 +49,10 @@ type LogPoller interface {
 	FindLCA(ctx context.Context) (*LogPollerBlock, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
 
+	// Reorg journal
+	ReorgsCreatedAfter(ctx context.Context, after time.Time) ([]Reorg, error)
+	ReorgedLogs(ctx context.Context, reorgID int64) ([]Log, error)
+
 	// General querying
 	Logs(ctx context.Context, start, end int64, eventSig common.Hash, address common.Address) ([]Log, error)
 	LogsWithSigs(ctx context.Context, start, end int64, eventSigs []common.Hash, address common.Address) ([]Log, error)
@@ -71,6 +75,9 @@ type LogPoller interface {
 
 	// chainlink-common query filtering
 	FilteredLogs(ctx context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, queryName string) ([]Log, error)
+
+	// Push based consumption
+	Subscribe(ctx context.Context, filterName string, confs evmtypes.Confirmations, fromBlock int64) (Subscription, error)
 }
 
 type LogPollerTest interface {
@@ -125,6 +132,8 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	subs *subscriptions
+
 	replayStart    chan int64
 	replayComplete chan error
 	stopCh         services.StopChan
@@ -161,12 +170,14 @@ type Opts struct {
 // How fast that can be done depends largely on network speed and DB, but even for the fastest
 // support chain, polygon, which has 2s block times, we need RPCs roughly with <= 500ms latency
 func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracker, opts Opts) *logPoller {
+	sugared := logger.Sugared(logger.Named(lggr, "LogPoller"))
 	return &logPoller{
 		stopCh:                   make(chan struct{}),
 		ec:                       ec,
 		orm:                      orm,
 		headTracker:              headTracker,
-		lggr:                     logger.Sugared(logger.Named(lggr, "LogPoller")),
+		lggr:                     sugared,
+		subs:                     newSubscriptions(sugared, orm),
 		replayStart:              make(chan int64),
 		replayComplete:           make(chan error),
 		pollPeriod:               opts.PollPeriod,
@@ -296,6 +307,7 @@ func (lp *logPoller) RegisterFilter(ctx context.Context, filter Filter) error {
 	}
 	lp.filters[filter.Name] = filter
 	lp.filterDirty = true
+	lp.subs.updateFilter(filter)
 	if filter.MaxLogsKept > 0 {
 		lp.countBasedLogPruningActive.Store(true)
 	}
@@ -320,6 +332,7 @@ func (lp *logPoller) UnregisterFilter(ctx context.Context, name string) error {
 	}
 	delete(lp.filters, name)
 	lp.filterDirty = true
+	lp.subs.closeFilter(name)
 	return nil
 }
 
@@ -519,6 +532,7 @@ func (lp *logPoller) Close() error {
 		}
 		close(lp.stopCh)
 		lp.wg.Wait()
+		lp.subs.closeAll()
 		return nil
 	})
 }
@@ -901,11 +915,14 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 		}
 
 		lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
-		err = lp.orm.InsertLogsWithBlock(ctx, convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), endblock)
+		logs := convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID())
+		err = lp.orm.InsertLogsWithBlock(ctx, logs, endblock)
 		if err != nil {
 			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
 			return err
 		}
+		// Backfill is only ever called for finalized ranges, so the whole batch is final.
+		lp.subs.publish(logs, to, to)
 	}
 	return nil
 }
@@ -970,18 +987,19 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 
 		lp.lggr.Infow("Reorg detected", "blockAfterLCA", blockAfterLCA.Number, "currentBlockNumber", currentBlockNumber)
 		// We truncate all the blocks and logs after the LCA.
-		// We could preserve the logs for forensics, since its possible
-		// that applications see them and take action upon it, however that
-		// results in significantly slower reads since we must then compute
-		// the canonical set per read. Typically, if an application took action on a log
-		// it would be saved elsewhere e.g. evm.txes, so it seems better to just support the fast reads.
+		// Keeping orphaned logs in evm.logs would result in significantly slower reads,
+		// since we must then compute the canonical set per read. Instead, the removed logs
+		// are moved to the reorg journal, so that applications which took action upon them
+		// can find out about it (see ReorgsCreatedAfter and ReorgedLogs), while the reads stay fast.
 		// Its also nicely analogous to reading from the chain itself.
-		err2 = lp.orm.DeleteLogsAndBlocksAfter(ctx, blockAfterLCA.Number)
+		reorg, err2 := lp.orm.DeleteReorgedLogsAndBlocksAfter(ctx, blockAfterLCA.Number, blockAfterLCA.Hash)
 		if err2 != nil {
 			// If we error on db commit, we can't know if the tx went through or not.
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.lggr.Infow("Reorg journaled", "id", reorg.ID, "depth", reorg.Depth, "oldBlockHash", reorg.OldBlockHash, "newBlockHash", reorg.NewBlockHash, "removedLogs", len(reorg.RemovedLogIDs))
+		lp.subs.reorg(blockAfterLCA.Number)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -1066,15 +1084,13 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 			BlockTimestamp:       currentBlock.Timestamp,
 			FinalizedBlockNumber: latestFinalizedBlockNumber,
 		}
-		err = lp.orm.InsertLogsWithBlock(
-			ctx,
-			convertLogs(logs, []LogPollerBlock{block}, lp.lggr, lp.ec.ConfiguredChainID()),
-			block,
-		)
+		convertedLogs := convertLogs(logs, []LogPollerBlock{block}, lp.lggr, lp.ec.ConfiguredChainID())
+		err = lp.orm.InsertLogsWithBlock(ctx, convertedLogs, block)
 		if err != nil {
 			lp.lggr.Warnw("Unable to save logs resuming from last saved block + 1", "err", err, "block", currentBlockNumber)
 			return
 		}
+		lp.subs.publish(convertedLogs, block.BlockNumber, block.FinalizedBlockNumber)
 		// Update current block.
 		// Same reorg detection on unfinalized blocks.
 		currentBlockNumber++
@@ -1163,7 +1179,14 @@ func (lp *logPoller) PruneOldBlocks(ctx context.Context) (bool, error) {
 		latestBlock.FinalizedBlockNumber-lp.keepFinalizedBlocksDepth,
 		lp.logPrunePageSize,
 	)
-	return lp.logPrunePageSize == 0 || rowsRemoved < lp.logPrunePageSize, err
+	if err != nil {
+		return false, err
+	}
+	// Reorg journal is kept as long as the blocks it refers to
+	if _, err = lp.orm.DeleteReorgsBefore(ctx, latestBlock.FinalizedBlockNumber-lp.keepFinalizedBlocksDepth); err != nil {
+		return false, err
+	}
+	return lp.logPrunePageSize == 0 || rowsRemoved < lp.logPrunePageSize, nil
 }
 
 // PruneExpiredLogs will attempt to remove any logs which have passed their retention period. Returns whether all expired
@@ -1530,7 +1553,23 @@ func (lp *logPoller) IndexedLogsWithSigsExcluding(ctx context.Context, address c
 
 // DeleteLogsAndBlocksAfter - removes blocks and logs starting from the specified block
 func (lp *logPoller) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
-	return lp.orm.DeleteLogsAndBlocksAfter(ctx, start)
+	if err := lp.orm.DeleteLogsAndBlocksAfter(ctx, start); err != nil {
+		return err
+	}
+	lp.subs.reorg(start)
+	return nil
+}
+
+// ReorgsCreatedAfter returns the reorg journal entries recorded after the given time, oldest first.
+// Each entry describes a single rewind of the LogPoller's state: the first removed block, the depth,
+// the block hashes before and after the reorg and the IDs of all logs that were orphaned.
+func (lp *logPoller) ReorgsCreatedAfter(ctx context.Context, after time.Time) ([]Reorg, error) {
+	return lp.orm.SelectReorgsCreatedAfter(ctx, after)
+}
+
+// ReorgedLogs returns the logs removed from the db by the given reorg, so they can be compensated for.
+func (lp *logPoller) ReorgedLogs(ctx context.Context, reorgID int64) ([]Log, error) {
+	return lp.orm.SelectReorgedLogs(ctx, reorgID)
 }
 
 func (lp *logPoller) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
@@ -1631,6 +1670,23 @@ func EvmWord(i uint64) common.Hash {
 	return common.BytesToHash(b)
 }
 
+// Subscribe streams logs matching the registered filter to the caller as soon as they are persisted,
+// instead of requiring the caller to poll the database. Logs are delivered once they have the requested
+// number of confirmations (or are finalized when confs is evmtypes.Finalized), ordered by block number and log index.
+// If a delivered log is orphaned by a reorg, a LogEvent with Removed set is emitted.
+// If fromBlock is positive, logs already persisted starting from that block are replayed first; otherwise only
+// logs persisted after the call are delivered. Subscribers which don't keep up with the stream are terminated
+// with ErrSubscriptionLagging and can resume from Subscription.LastBlock() + 1.
+func (lp *logPoller) Subscribe(ctx context.Context, filterName string, confs evmtypes.Confirmations, fromBlock int64) (Subscription, error) {
+	lp.filterMu.RLock()
+	filter, ok := lp.filters[filterName]
+	lp.filterMu.RUnlock()
+	if !ok {
+		return nil, pkgerrors.Errorf("filter %q is not registered", filterName)
+	}
+	return lp.subs.subscribe(ctx, filter, confs, fromBlock)
+}
+
 func (lp *logPoller) FilteredLogs(ctx context.Context, queryFilter []query.Expression, limitAndSort query.LimitAndSort, queryName string) ([]Log, error) {
 	return lp.orm.FilteredLogs(ctx, queryFilter, limitAndSort, queryName)
 }
@@ -1683,6 +1683,124 @@ func (_c *LogPoller_RegisterFilter_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ReorgedLogs provides a mock function with given fields: ctx, reorgID
+func (_m *LogPoller) ReorgedLogs(ctx context.Context, reorgID int64) ([]logpoller.Log, error) {
+	ret := _m.Called(ctx, reorgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorgedLogs")
+	}
+
+	var r0 []logpoller.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]logpoller.Log, error)); ok {
+		return rf(ctx, reorgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []logpoller.Log); ok {
+		r0 = rf(ctx, reorgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, reorgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_ReorgedLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorgedLogs'
+type LogPoller_ReorgedLogs_Call struct {
+	*mock.Call
+}
+
+// ReorgedLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reorgID int64
+func (_e *LogPoller_Expecter) ReorgedLogs(ctx interface{}, reorgID interface{}) *LogPoller_ReorgedLogs_Call {
+	return &LogPoller_ReorgedLogs_Call{Call: _e.mock.On("ReorgedLogs", ctx, reorgID)}
+}
+
+func (_c *LogPoller_ReorgedLogs_Call) Run(run func(ctx context.Context, reorgID int64)) *LogPoller_ReorgedLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ReorgedLogs_Call) Return(_a0 []logpoller.Log, _a1 error) *LogPoller_ReorgedLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_ReorgedLogs_Call) RunAndReturn(run func(context.Context, int64) ([]logpoller.Log, error)) *LogPoller_ReorgedLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorgsCreatedAfter provides a mock function with given fields: ctx, after
+func (_m *LogPoller) ReorgsCreatedAfter(ctx context.Context, after time.Time) ([]logpoller.Reorg, error) {
+	ret := _m.Called(ctx, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorgsCreatedAfter")
+	}
+
+	var r0 []logpoller.Reorg
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]logpoller.Reorg, error)); ok {
+		return rf(ctx, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []logpoller.Reorg); ok {
+		r0 = rf(ctx, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Reorg)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_ReorgsCreatedAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorgsCreatedAfter'
+type LogPoller_ReorgsCreatedAfter_Call struct {
+	*mock.Call
+}
+
+// ReorgsCreatedAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after time.Time
+func (_e *LogPoller_Expecter) ReorgsCreatedAfter(ctx interface{}, after interface{}) *LogPoller_ReorgsCreatedAfter_Call {
+	return &LogPoller_ReorgsCreatedAfter_Call{Call: _e.mock.On("ReorgsCreatedAfter", ctx, after)}
+}
+
+func (_c *LogPoller_ReorgsCreatedAfter_Call) Run(run func(ctx context.Context, after time.Time)) *LogPoller_ReorgsCreatedAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ReorgsCreatedAfter_Call) Return(_a0 []logpoller.Reorg, _a1 error) *LogPoller_ReorgsCreatedAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_ReorgsCreatedAfter_Call) RunAndReturn(run func(context.Context, time.Time) ([]logpoller.Reorg, error)) *LogPoller_ReorgsCreatedAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Replay provides a mock function with given fields: ctx, fromBlock
 func (_m *LogPoller) Replay(ctx context.Context, fromBlock int64) error {
 	ret := _m.Called(ctx, fromBlock)
@@ -1809,6 +1927,67 @@ func (_c *LogPoller_Start_Call) RunAndReturn(run func(context.Context) error) *L
 	return _c
 }
 
+// Subscribe provides a mock function with given fields: ctx, filterName, confs, fromBlock
+func (_m *LogPoller) Subscribe(ctx context.Context, filterName string, confs types.Confirmations, fromBlock int64) (logpoller.Subscription, error) {
+	ret := _m.Called(ctx, filterName, confs, fromBlock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 logpoller.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.Confirmations, int64) (logpoller.Subscription, error)); ok {
+		return rf(ctx, filterName, confs, fromBlock)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.Confirmations, int64) logpoller.Subscription); ok {
+		r0 = rf(ctx, filterName, confs, fromBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logpoller.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.Confirmations, int64) error); ok {
+		r1 = rf(ctx, filterName, confs, fromBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type LogPoller_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filterName string
+//   - confs types.Confirmations
+//   - fromBlock int64
+func (_e *LogPoller_Expecter) Subscribe(ctx interface{}, filterName interface{}, confs interface{}, fromBlock interface{}) *LogPoller_Subscribe_Call {
+	return &LogPoller_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, filterName, confs, fromBlock)}
+}
+
+func (_c *LogPoller_Subscribe_Call) Run(run func(ctx context.Context, filterName string, confs types.Confirmations, fromBlock int64)) *LogPoller_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(types.Confirmations), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) Return(_a0 logpoller.Subscription, _a1 error) *LogPoller_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) RunAndReturn(run func(context.Context, string, types.Confirmations, int64) (logpoller.Subscription, error)) *LogPoller_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnregisterFilter provides a mock function with given fields: ctx, name
 func (_m *LogPoller) UnregisterFilter(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
@@ -37,6 +37,20 @@ type Log struct {
 	CreatedAt      time.Time
 }
 
+// Reorg is an entry of the reorg journal, recorded every time the LogPoller rewinds
+// its state after detecting that the chain has been reorganized.
+type Reorg struct {
+	ID         int64
+	EvmChainId *big.Big
+	// BlockNumber is the first block removed from the db, i.e. the block after the LCA.
+	BlockNumber   int64
+	Depth         int64
+	OldBlockHash  common.Hash
+	NewBlockHash  common.Hash
+	RemovedLogIDs pq.Int64Array `db:"removed_log_ids"`
+	CreatedAt     time.Time
+}
+
 func (l *Log) GetTopics() []common.Hash {
 	var tps []common.Hash
 	for _, topic := range l.Topics {
@@ -64,6 +64,18 @@ var (
 		Name: "log_poller_blocks_inserted",
 		Help: "Counter to track number of blocks inserted by Log Poller",
 	}, []string{"evmChainID"})
+	lpReorgs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_poller_reorgs",
+		Help: "Counter to track number of reorgs handled by Log Poller",
+	}, []string{"evmChainID"})
+	lpReorgDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_last_reorg_depth",
+		Help: "Number of blocks removed by the latest reorg handled by Log Poller",
+	}, []string{"evmChainID"})
+	lpLogsReorged = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_poller_logs_reorged",
+		Help: "Counter to track number of logs removed by reorgs in Log Poller",
+	}, []string{"evmChainID"})
 )
 
 // ObservedORM is a decorator layer for ORM used by LogPoller, responsible for pushing Prometheus metrics reporting duration and size of result set for the queries.
@@ -74,6 +86,9 @@ type ObservedORM struct {
 	datasetSize    *prometheus.GaugeVec
 	logsInserted   *prometheus.CounterVec
 	blocksInserted *prometheus.CounterVec
+	reorgs         *prometheus.CounterVec
+	reorgDepth     *prometheus.GaugeVec
+	logsReorged    *prometheus.CounterVec
 	chainId        string
 }
 
@@ -86,6 +101,9 @@ func NewObservedORM(chainID *big.Int, ds sqlutil.DataSource, lggr logger.Logger)
 		datasetSize:    lpQueryDataSets,
 		logsInserted:   lpLogsInserted,
 		blocksInserted: lpBlockInserted,
+		reorgs:         lpReorgs,
+		reorgDepth:     lpReorgDepth,
+		logsReorged:    lpLogsReorged,
 		chainId:        chainID.String(),
 	}
 }
@@ -136,6 +154,22 @@ func (o *ObservedORM) DeleteLogsAndBlocksAfter(ctx context.Context, start int64)
 	})
 }
 
+func (o *ObservedORM) DeleteReorgedLogsAndBlocksAfter(ctx context.Context, start int64, newBlockHash common.Hash) (*Reorg, error) {
+	var reorg *Reorg
+	err := withObservedExec(o, "DeleteReorgedLogsAndBlocksAfter", del, func() (err error) {
+		reorg, err = o.ORM.DeleteReorgedLogsAndBlocksAfter(ctx, start, newBlockHash)
+		return err
+	})
+	trackReorg(o, reorg, err)
+	return reorg, err
+}
+
+func (o *ObservedORM) DeleteReorgsBefore(ctx context.Context, end int64) (int64, error) {
+	return withObservedExecAndRowsAffected(o, "DeleteReorgsBefore", del, func() (int64, error) {
+		return o.ORM.DeleteReorgsBefore(ctx, end)
+	})
+}
+
 func (o *ObservedORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error) {
 	return withObservedExecAndRowsAffected(o, "DeleteExpiredLogs", del, func() (int64, error) {
 		return o.ORM.DeleteExpiredLogs(ctx, limit)
@@ -220,6 +254,18 @@ func (o *ObservedORM) SelectIndexedLogsWithSigsExcluding(ctx context.Context, si
 	})
 }
 
+func (o *ObservedORM) SelectReorgsCreatedAfter(ctx context.Context, after time.Time) ([]Reorg, error) {
+	return withObservedQueryAndResults(o, "SelectReorgsCreatedAfter", func() ([]Reorg, error) {
+		return o.ORM.SelectReorgsCreatedAfter(ctx, after)
+	})
+}
+
+func (o *ObservedORM) SelectReorgedLogs(ctx context.Context, reorgID int64) ([]Log, error) {
+	return withObservedQueryAndResults(o, "SelectReorgedLogs", func() ([]Log, error) {
+		return o.ORM.SelectReorgedLogs(ctx, reorgID)
+	})
+}
+
 func (o *ObservedORM) SelectLogs(ctx context.Context, start, end int64, address common.Address, eventSig common.Hash) ([]Log, error) {
 	return withObservedQueryAndResults(o, "SelectLogs", func() ([]Log, error) {
 		return o.ORM.SelectLogs(ctx, start, end, address, eventSig)
@@ -346,3 +392,18 @@ func trackInsertedLogsAndBlock(o *ObservedORM, logs []Log, block *LogPollerBlock
 			Inc()
 	}
 }
+
+func trackReorg(o *ObservedORM, reorg *Reorg, err error) {
+	if err != nil || reorg == nil {
+		return
+	}
+	o.reorgs.
+		WithLabelValues(o.chainId).
+		Inc()
+	o.reorgDepth.
+		WithLabelValues(o.chainId).
+		Set(float64(reorg.Depth))
+	o.logsReorged.
+		WithLabelValues(o.chainId).
+		Add(float64(len(reorg.RemovedLogIDs)))
+}
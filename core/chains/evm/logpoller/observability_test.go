@@ -150,6 +150,24 @@ func TestCountersAreProperlyPopulatedForWrites(t *testing.T) {
 	assert.Equal(t, float64(2), testutil.ToFloat64(orm.blocksInserted.WithLabelValues("420")))
 }
 
+func TestReorgMetricsArePublished(t *testing.T) {
+	ctx := testutils.Context(t)
+	orm := createObservedORM(t, 300)
+	t.Cleanup(func() { resetMetrics(*orm) })
+
+	logs := generateRandomLogs(300, 3)
+	for _, log := range logs {
+		require.NoError(t, orm.InsertLogsWithBlock(ctx, []Log{log}, LogPollerBlock{BlockHash: log.BlockHash, BlockNumber: log.BlockNumber, BlockTimestamp: time.Now(), FinalizedBlockNumber: 1}))
+	}
+
+	_, err := orm.DeleteReorgedLogsAndBlocksAfter(ctx, 2, utils.RandomBytes32())
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(orm.reorgs.WithLabelValues("300")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(orm.reorgDepth.WithLabelValues("300")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(orm.logsReorged.WithLabelValues("300")))
+}
+
 func generateRandomLogs(chainId, count int) []Log {
 	logs := make([]Log, count)
 	for i := range logs {
@@ -181,6 +199,9 @@ func resetMetrics(lp ObservedORM) {
 	lp.datasetSize.Reset()
 	lp.logsInserted.Reset()
 	lp.blocksInserted.Reset()
+	lp.reorgs.Reset()
+	lp.reorgDepth.Reset()
+	lp.logsReorged.Reset()
 }
 
 func counterFromGaugeByLabels(gaugeVec *prometheus.GaugeVec, labels ...string) int {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -38,6 +39,8 @@ type ORM interface {
 	InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error
 	DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
+	DeleteReorgedLogsAndBlocksAfter(ctx context.Context, start int64, newBlockHash common.Hash) (*Reorg, error)
+	DeleteReorgsBefore(ctx context.Context, end int64) (int64, error)
 	SelectUnmatchedLogIDs(ctx context.Context, limit int64) (ids []uint64, err error)
 	DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error)
 	SelectExcessLogIDs(ctx context.Context, limit int64) (rowIDs []uint64, err error)
@@ -55,6 +58,8 @@ type ORM interface {
 	SelectLatestLogEventSigsAddrsWithConfs(ctx context.Context, fromBlock int64, addresses []common.Address, eventSigs []common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 	SelectLatestBlockByEventSigsAddrsWithConfs(ctx context.Context, fromBlock int64, eventSigs []common.Hash, addresses []common.Address, confs evmtypes.Confirmations) (int64, error)
 	SelectLogsByBlockRange(ctx context.Context, start, end int64) ([]Log, error)
+	SelectReorgsCreatedAfter(ctx context.Context, after time.Time) ([]Reorg, error)
+	SelectReorgedLogs(ctx context.Context, reorgID int64) ([]Log, error)
 
 	SelectIndexedLogs(ctx context.Context, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 	SelectIndexedLogsByBlockRange(ctx context.Context, start, end int64, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash) ([]Log, error)
@@ -376,37 +381,137 @@ func (o *DSORM) DeleteBlocksBefore(ctx context.Context, end int64, limit int64)
 }
 
 func (o *DSORM) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
+	return o.Transact(ctx, func(orm *DSORM) error {
+		return orm.deleteLogsAndBlocksAfter(ctx, start)
+	})
+}
+
+func (o *DSORM) deleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
 	// These deletes are bounded by reorg depth, so they are
 	// fast and should not slow down the log readers.
-	return o.Transact(ctx, func(orm *DSORM) error {
-		// Applying upper bound filter is critical for Postgres performance (especially for evm.logs table)
-		// because it allows the planner to properly estimate the number of rows to be scanned.
-		// If not applied, these queries can become very slow. After some critical number
-		// of logs, Postgres will try to scan all the logs in the index by block_number.
-		// Latency without upper bound filter can be orders of magnitude higher for large number of logs.
-		_, err := o.ds.ExecContext(ctx, `DELETE FROM evm.log_poller_blocks
+
+	// Applying upper bound filter is critical for Postgres performance (especially for evm.logs table)
+	// because it allows the planner to properly estimate the number of rows to be scanned.
+	// If not applied, these queries can become very slow. After some critical number
+	// of logs, Postgres will try to scan all the logs in the index by block_number.
+	// Latency without upper bound filter can be orders of magnitude higher for large number of logs.
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM evm.log_poller_blocks
        						WHERE evm_chain_id = $1
 							AND block_number >= $2
 							AND block_number <= (SELECT MAX(block_number)
 						 		FROM evm.log_poller_blocks
 						 		WHERE evm_chain_id = $1)`,
-			ubig.New(o.chainID), start)
-		if err != nil {
-			o.lggr.Warnw("Unable to clear reorged blocks, retrying", "err", err)
-			return err
-		}
+		ubig.New(o.chainID), start)
+	if err != nil {
+		o.lggr.Warnw("Unable to clear reorged blocks, retrying", "err", err)
+		return err
+	}
 
-		_, err = o.ds.ExecContext(ctx, `DELETE FROM evm.logs
+	_, err = o.ds.ExecContext(ctx, `DELETE FROM evm.logs
        						WHERE evm_chain_id = $1
        						AND block_number >= $2
        						AND block_number <= (SELECT MAX(block_number) FROM evm.logs WHERE evm_chain_id = $1)`,
+		ubig.New(o.chainID), start)
+	if err != nil {
+		o.lggr.Warnw("Unable to clear reorged logs, retrying", "err", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteReorgedLogsAndBlocksAfter works like DeleteLogsAndBlocksAfter, but within the same transaction it records
+// the reorg in the journal (evm.log_poller_reorgs) and keeps copies of all the removed logs, so that consumers
+// can find out which of the logs they have already acted upon were orphaned.
+func (o *DSORM) DeleteReorgedLogsAndBlocksAfter(ctx context.Context, start int64, newBlockHash common.Hash) (*Reorg, error) {
+	reorg := Reorg{
+		EvmChainId:   ubig.New(o.chainID),
+		BlockNumber:  start,
+		NewBlockHash: newBlockHash,
+	}
+	err := o.Transact(ctx, func(orm *DSORM) error {
+		oldBlock, err := orm.SelectBlockByNumber(ctx, start)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if oldBlock != nil {
+			reorg.OldBlockHash = oldBlock.BlockHash
+		}
+
+		var latest sql.NullInt64
+		err = orm.ds.GetContext(ctx, &latest, `SELECT MAX(block_number) FROM evm.log_poller_blocks WHERE evm_chain_id = $1`, ubig.New(o.chainID))
+		if err != nil {
+			return err
+		}
+		if latest.Valid && latest.Int64 >= start {
+			reorg.Depth = latest.Int64 - start + 1
+		}
+
+		reorg.RemovedLogIDs = pq.Int64Array{}
+		err = orm.ds.SelectContext(ctx, &reorg.RemovedLogIDs, `SELECT id FROM evm.logs
+			WHERE evm_chain_id = $1
+			AND block_number >= $2
+			AND block_number <= (SELECT MAX(block_number) FROM evm.logs WHERE evm_chain_id = $1)
+			ORDER BY block_number, log_index`,
 			ubig.New(o.chainID), start)
 		if err != nil {
-			o.lggr.Warnw("Unable to clear reorged logs, retrying", "err", err)
 			return err
 		}
-		return nil
+
+		err = orm.ds.GetContext(ctx, &reorg, `INSERT INTO evm.log_poller_reorgs
+			(evm_chain_id, block_number, depth, old_block_hash, new_block_hash, removed_log_ids)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id, created_at`,
+			reorg.EvmChainId, reorg.BlockNumber, reorg.Depth, reorg.OldBlockHash.Bytes(), reorg.NewBlockHash.Bytes(), reorg.RemovedLogIDs)
+		if err != nil {
+			return err
+		}
+
+		if len(reorg.RemovedLogIDs) > 0 {
+			_, err = orm.ds.ExecContext(ctx, fmt.Sprintf(`INSERT INTO evm.log_poller_reorged_logs (reorg_id, log_id, %[1]s)
+				SELECT $1, id, %[1]s FROM evm.logs WHERE id = ANY($2)`, strings.Join(logsFields[:], ", ")),
+				reorg.ID, reorg.RemovedLogIDs)
+			if err != nil {
+				return err
+			}
+		}
+		return orm.deleteLogsAndBlocksAfter(ctx, start)
 	})
+	if err != nil {
+		return nil, err
+	}
+	return &reorg, nil
+}
+
+// SelectReorgsCreatedAfter returns the reorg journal entries recorded after the given time, oldest first.
+func (o *DSORM) SelectReorgsCreatedAfter(ctx context.Context, after time.Time) ([]Reorg, error) {
+	var reorgs []Reorg
+	err := o.ds.SelectContext(ctx, &reorgs, `SELECT id, evm_chain_id, block_number, depth, old_block_hash, new_block_hash, removed_log_ids, created_at
+		FROM evm.log_poller_reorgs
+		WHERE evm_chain_id = $1 AND created_at > $2
+		ORDER BY id`,
+		ubig.New(o.chainID), after)
+	return reorgs, err
+}
+
+// SelectReorgedLogs returns copies of the logs removed by the given reorg.
+func (o *DSORM) SelectReorgedLogs(ctx context.Context, reorgID int64) ([]Log, error) {
+	var logs []Log
+	err := o.ds.SelectContext(ctx, &logs, fmt.Sprintf(`SELECT %s FROM evm.log_poller_reorged_logs
+		WHERE evm_chain_id = $1 AND reorg_id = $2
+		ORDER BY block_number, log_index`, strings.Join(logsFields[:], ", ")),
+		ubig.New(o.chainID), reorgID)
+	return logs, err
+}
+
+// DeleteReorgsBefore removes the journal entries of reorgs which started before and including end,
+// together with the copies of their removed logs.
+func (o *DSORM) DeleteReorgsBefore(ctx context.Context, end int64) (int64, error) {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM evm.log_poller_reorgs WHERE evm_chain_id = $1 AND block_number <= $2`,
+		ubig.New(o.chainID), end)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 type Exp struct {
@@ -1403,6 +1403,122 @@ func TestORM_DeleteBlocksBefore(t *testing.T) {
 	require.Equal(t, err, sql.ErrNoRows)
 }
 
+func TestORM_ReorgJournal(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o1 := th.ORM
+	o2 := th.ORM2
+	ctx := testutils.Context(t)
+	event := EmitterABI.Events["Log1"].ID
+	address := common.HexToAddress("0x1234")
+
+	for i := int64(1); i <= 4; i++ {
+		hash := common.BigToHash(big.NewInt(i))
+		require.NoError(t, o1.InsertLogsWithBlock(ctx,
+			[]logpoller.Log{GenLog(th.ChainID, 0, i, hash.String(), event[:], address)},
+			logpoller.LogPollerBlock{BlockHash: hash, BlockNumber: i, BlockTimestamp: time.Now(), FinalizedBlockNumber: 1},
+		))
+	}
+	require.NoError(t, o2.InsertLogsWithBlock(ctx,
+		[]logpoller.Log{GenLog(th.ChainID2, 0, 3, "0x3", event[:], address)},
+		logpoller.LogPollerBlock{BlockHash: common.HexToHash("0x3"), BlockNumber: 3, BlockTimestamp: time.Now(), FinalizedBlockNumber: 1},
+	))
+	before := time.Now().Add(-time.Minute)
+
+	newHash := common.HexToHash("0x33")
+	reorg, err := o1.DeleteReorgedLogsAndBlocksAfter(ctx, 3, newHash)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), reorg.BlockNumber)
+	assert.Equal(t, int64(2), reorg.Depth)
+	assert.Equal(t, common.BigToHash(big.NewInt(3)), reorg.OldBlockHash)
+	assert.Equal(t, newHash, reorg.NewBlockHash)
+	assert.Len(t, reorg.RemovedLogIDs, 2)
+
+	// Logs and blocks are gone, but the journal keeps them
+	logs, err := o1.SelectLogs(ctx, 1, 4, address, event)
+	require.NoError(t, err)
+	assert.Len(t, logs, 2)
+	_, err = o1.SelectBlockByNumber(ctx, 3)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	reorgs, err := o1.SelectReorgsCreatedAfter(ctx, before)
+	require.NoError(t, err)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, reorg.ID, reorgs[0].ID)
+	assert.Equal(t, reorg.RemovedLogIDs, reorgs[0].RemovedLogIDs)
+
+	removed, err := o1.SelectReorgedLogs(ctx, reorg.ID)
+	require.NoError(t, err)
+	require.Len(t, removed, 2)
+	assert.Equal(t, int64(3), removed[0].BlockNumber)
+	assert.Equal(t, int64(4), removed[1].BlockNumber)
+	assert.Equal(t, common.BigToHash(big.NewInt(3)), removed[0].BlockHash)
+
+	// Other chains are not affected
+	logs, err = o2.SelectLogs(ctx, 1, 4, address, event)
+	require.NoError(t, err)
+	assert.Len(t, logs, 1)
+	reorgs, err = o2.SelectReorgsCreatedAfter(ctx, before)
+	require.NoError(t, err)
+	assert.Empty(t, reorgs)
+
+	deleted, err := o1.DeleteReorgsBefore(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+	deleted, err = o1.DeleteReorgsBefore(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	removed, err = o1.SelectReorgedLogs(ctx, reorg.ID)
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+}
+
+func TestORM_SelectReorgsCreatedAfter_ScansJournalRow(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o := th.ORM
+	ctx := testutils.Context(t)
+	event := EmitterABI.Events["Log1"].ID
+	address := common.HexToAddress("0x1234")
+
+	for i := int64(1); i <= 3; i++ {
+		hash := common.BigToHash(big.NewInt(i))
+		require.NoError(t, o.InsertLogsWithBlock(ctx,
+			[]logpoller.Log{
+				GenLog(th.ChainID, 0, i, hash.String(), event[:], address),
+				GenLog(th.ChainID, 1, i, hash.String(), event[:], address),
+			},
+			logpoller.LogPollerBlock{BlockHash: hash, BlockNumber: i, BlockTimestamp: time.Now(), FinalizedBlockNumber: 1},
+		))
+	}
+	before := time.Now().Add(-time.Minute)
+
+	newHash := common.HexToHash("0x22")
+	reorg, err := o.DeleteReorgedLogsAndBlocksAfter(ctx, 2, newHash)
+	require.NoError(t, err)
+
+	// Read the row back from the db and make sure every column lands in its field
+	reorgs, err := o.SelectReorgsCreatedAfter(ctx, before)
+	require.NoError(t, err)
+	require.Len(t, reorgs, 1)
+	got := reorgs[0]
+	assert.Equal(t, reorg.ID, got.ID)
+	assert.Equal(t, th.ChainID.String(), got.EvmChainId.String())
+	assert.Equal(t, int64(2), got.BlockNumber)
+	assert.Equal(t, int64(2), got.Depth)
+	assert.Equal(t, common.BigToHash(big.NewInt(2)), got.OldBlockHash)
+	assert.Equal(t, newHash, got.NewBlockHash)
+	require.Len(t, got.RemovedLogIDs, 4)
+	assert.Equal(t, reorg.RemovedLogIDs, got.RemovedLogIDs)
+	assert.False(t, got.CreatedAt.IsZero())
+
+	removed, err := o.SelectReorgedLogs(ctx, got.ID)
+	require.NoError(t, err)
+	assert.Len(t, removed, len(got.RemovedLogIDs))
+
+	reorgs, err = o.SelectReorgsCreatedAfter(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, reorgs)
+}
+
 func TestLogPoller_Logs(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -0,0 +1,534 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+const (
+	// subscriptionBufferSize is the number of events buffered for every subscriber. A subscriber that falls
+	// behind by more than that is closed with ErrSubscriptionLagging and is expected to resubscribe from LastBlock() + 1.
+	subscriptionBufferSize = 1000
+	// backlogPageSize is the number of blocks which logs are read at once when delivering the backlog of a subscription.
+	backlogPageSize = 100
+)
+
+var (
+	ErrSubscriptionLagging = pkgerrors.New("subscription closed, consumer is not keeping up with log delivery")
+	ErrFilterUnregistered  = pkgerrors.New("subscription closed, filter has been unregistered")
+	ErrSubscriptionClosed  = pkgerrors.New("subscription closed, log poller is shutting down")
+)
+
+// LogEvent is a single notification delivered to a Subscription.
+// Removed is set when a log that was previously delivered has been orphaned by a reorg,
+// consumers should compensate for any action taken upon it.
+type LogEvent struct {
+	Log     Log
+	Removed bool
+}
+
+// Subscription streams logs matching a registered filter as soon as they are persisted by the LogPoller.
+type Subscription interface {
+	// Events returns the channel logs are delivered on. It is closed when the subscription ends.
+	Events() <-chan LogEvent
+	// Err returns a channel which receives the reason the subscription was terminated by the LogPoller.
+	// It is closed without a value when Unsubscribe is called.
+	Err() <-chan error
+	// LastBlock returns the highest block for which all matching logs have been delivered.
+	// It can be passed (+1) as fromBlock to resume a terminated subscription without gaps.
+	LastBlock() int64
+	// Unsubscribe stops the delivery of events and closes both channels. It is safe to call multiple times.
+	Unsubscribe()
+}
+
+type subscription struct {
+	filter Filter
+	confs  evmtypes.Confirmations
+	events chan LogEvent
+	errCh  chan error
+	done   func(*subscription)
+
+	// pending holds matching logs which are persisted, but not deep enough yet to satisfy confs.
+	pending []Log
+	// delivered holds logs which have been sent to the consumer, but are not finalized, so they still can be reorged.
+	delivered []Log
+	lastBlock atomic.Int64
+	closeOnce sync.Once
+
+	// backfilling is set while the backlog is being delivered, the backlog goroutine then owns the events channel:
+	// events are queued in outbox for it to send, and live logs are held in pending until the backlog is delivered.
+	backfilling bool
+	outbox      []LogEvent
+	// backfilled is the highest block which logs were read from the database.
+	backfilled int64
+	// latest and finalized are the last chain state published while backfilling.
+	latest, finalized int64
+	// stop is closed when the subscription ends while backfilling, closeErr being the reason.
+	stop     chan struct{}
+	closeErr error
+}
+
+var _ Subscription = &subscription{}
+
+func (s *subscription) Events() <-chan LogEvent { return s.events }
+
+func (s *subscription) Err() <-chan error { return s.errCh }
+
+func (s *subscription) LastBlock() int64 { return s.lastBlock.Load() }
+
+func (s *subscription) Unsubscribe() { s.done(s) }
+
+// close must be called with subscriptions.mu held. While backfilling, the channels are closed by the backlog
+// goroutine instead, once it stopped sending.
+func (s *subscription) close(err error) {
+	s.closeOnce.Do(func() {
+		if s.backfilling {
+			s.closeErr = err
+			close(s.stop)
+			return
+		}
+		s.finish(err)
+	})
+}
+
+// finish reports the error and closes the channels.
+func (s *subscription) finish(err error) {
+	if err != nil {
+		s.errCh <- err
+	}
+	close(s.errCh)
+	close(s.events)
+}
+
+// stopped returns true if the subscription ended while backfilling.
+func (s *subscription) stopped() bool {
+	select {
+	case <-s.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// send delivers the events queued while backfilling, waiting for the consumer. Returns false if the subscription ended.
+func (s *subscription) send(events []LogEvent) bool {
+	for _, ev := range events {
+		select {
+		case s.events <- ev:
+		case <-s.stop:
+			return false
+		}
+	}
+	return true
+}
+
+// deliver sends the event to the consumer, or queues it while backfilling.
+func (s *subscription) deliver(ev LogEvent) {
+	if s.backfilling {
+		s.outbox = append(s.outbox, ev)
+		return
+	}
+	s.events <- ev
+}
+
+// hasRoom returns true if n events can be delivered without blocking. Events queued while backfilling are bounded
+// by the backlog page and the unfinalized logs instead.
+func (s *subscription) hasRoom(n int) bool {
+	return s.backfilling || n <= cap(s.events)-len(s.events)
+}
+
+// confirmed returns the highest block which logs are deep enough to be delivered.
+func (s *subscription) confirmed(latest, finalized int64) int64 {
+	if s.confs == evmtypes.Finalized {
+		return finalized
+	}
+	return latest - int64(s.confs)
+}
+
+// enqueue adds newly persisted logs to the pending set and releases everything that is already deep enough.
+// While backfilling, the logs are held until the backlog is delivered.
+// Returns false if the consumer is lagging and the events could not be delivered.
+func (s *subscription) enqueue(logs []Log, latest, finalized int64) bool {
+	lastBlock := s.lastBlock.Load()
+	for _, log := range logs {
+		if log.BlockNumber <= lastBlock || !s.filter.matches(&log) {
+			continue
+		}
+		s.addPending(log)
+	}
+	if s.backfilling {
+		s.latest, s.finalized = latest, finalized
+		return true
+	}
+	return s.release(s.confirmed(latest, finalized), finalized)
+}
+
+// release delivers the pending logs up to the confirmed block.
+// Returns false if the consumer is lagging and the events could not be delivered.
+func (s *subscription) release(confirmed, finalized int64) bool {
+	lastBlock := s.lastBlock.Load()
+	var ready []Log
+	remaining := s.pending[:0]
+	for _, log := range s.pending {
+		if log.BlockNumber <= confirmed {
+			ready = append(ready, log)
+		} else {
+			remaining = append(remaining, log)
+		}
+	}
+	if !s.hasRoom(len(ready)) {
+		return false
+	}
+	s.pending = remaining
+
+	sort.Slice(ready, func(i, j int) bool {
+		if ready[i].BlockNumber == ready[j].BlockNumber {
+			return ready[i].LogIndex < ready[j].LogIndex
+		}
+		return ready[i].BlockNumber < ready[j].BlockNumber
+	})
+	for _, log := range ready {
+		s.deliver(LogEvent{Log: log})
+		if log.BlockNumber > finalized {
+			s.delivered = append(s.delivered, log)
+		}
+	}
+
+	stillUnfinalized := s.delivered[:0]
+	for _, log := range s.delivered {
+		if log.BlockNumber > finalized {
+			stillUnfinalized = append(stillUnfinalized, log)
+		}
+	}
+	s.delivered = stillUnfinalized
+
+	if confirmed > lastBlock {
+		s.lastBlock.Store(confirmed)
+	}
+	return true
+}
+
+// addPending stores the log, replacing the previous version of it if the same block is being re-processed (e.g. during replay).
+func (s *subscription) addPending(log Log) {
+	for i := range s.pending {
+		if s.pending[i].BlockNumber == log.BlockNumber && s.pending[i].LogIndex == log.LogIndex {
+			s.pending[i] = log
+			return
+		}
+	}
+	s.pending = append(s.pending, log)
+}
+
+// reorg drops all pending logs starting from the given block and notifies the consumer about the removal of
+// all already delivered ones. Returns false if the consumer is lagging and the events could not be delivered.
+func (s *subscription) reorg(fromBlock int64) bool {
+	remaining := s.pending[:0]
+	for _, log := range s.pending {
+		if log.BlockNumber < fromBlock {
+			remaining = append(remaining, log)
+		}
+	}
+	s.pending = remaining
+
+	var removed []Log
+	kept := s.delivered[:0]
+	for _, log := range s.delivered {
+		if log.BlockNumber >= fromBlock {
+			removed = append(removed, log)
+		} else {
+			kept = append(kept, log)
+		}
+	}
+	if !s.hasRoom(len(removed)) {
+		return false
+	}
+	s.delivered = kept
+	// Notify in reverse order, so that consumers can unwind their state the same way the chain did.
+	for i := len(removed) - 1; i >= 0; i-- {
+		s.deliver(LogEvent{Log: removed[i], Removed: true})
+	}
+
+	if s.lastBlock.Load() >= fromBlock {
+		s.lastBlock.Store(fromBlock - 1)
+	}
+	// the backlog of the orphaned blocks is read again
+	s.backfilled = min(s.backfilled, fromBlock-1)
+	s.latest = min(s.latest, fromBlock-1)
+	return true
+}
+
+// matches returns true if the log would be captured by this filter
+func (filter *Filter) matches(log *Log) bool {
+	if !containsAddress(filter.Addresses, log.Address) {
+		return false
+	}
+	if !containsHash(filter.EventSigs, log.EventSig) {
+		return false
+	}
+	for i, topicValues := range []evmtypes.HashArray{filter.Topic2, filter.Topic3, filter.Topic4} {
+		if len(topicValues) == 0 {
+			continue
+		}
+		if len(log.Topics) <= i+1 || !containsHash(topicValues, common.BytesToHash(log.Topics[i+1])) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHash(hashes []common.Hash, hash common.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// subscriptions fans out logs persisted by the LogPoller to in-process subscribers.
+type subscriptions struct {
+	lggr logger.SugaredLogger
+	orm  ORM
+
+	mu   sync.Mutex
+	subs map[*subscription]struct{}
+	// wg waits for the goroutines delivering the backlogs
+	wg sync.WaitGroup
+}
+
+func newSubscriptions(lggr logger.SugaredLogger, orm ORM) *subscriptions {
+	return &subscriptions{
+		lggr: lggr,
+		orm:  orm,
+		subs: make(map[*subscription]struct{}),
+	}
+}
+
+// subscribe registers a new subscriber for the filter. If fromBlock is positive, logs already persisted
+// starting from fromBlock are delivered first, otherwise only logs persisted after subscribing are delivered.
+// The backlog is read from the database and delivered in the background, without holding mu, live logs being held
+// until then so that there is no gap between the backlog and the live stream.
+func (s *subscriptions) subscribe(ctx context.Context, filter Filter, confs evmtypes.Confirmations, fromBlock int64) (*subscription, error) {
+	var latest, finalized int64
+	latestBlock, err := s.orm.SelectLatestBlock(ctx)
+	if err != nil && !pkgerrors.Is(err, sql.ErrNoRows) {
+		return nil, pkgerrors.Wrap(err, "failed to select latest block")
+	}
+	if latestBlock != nil {
+		latest, finalized = latestBlock.BlockNumber, latestBlock.FinalizedBlockNumber
+	}
+
+	start := latest + 1
+	if fromBlock > 0 {
+		start = fromBlock
+	}
+	sub := &subscription{
+		filter:      filter,
+		confs:       confs,
+		events:      make(chan LogEvent, subscriptionBufferSize),
+		errCh:       make(chan error, 1),
+		done:        s.unsubscribe,
+		backfilling: true,
+		backfilled:  start - 1,
+		latest:      latest,
+		finalized:   finalized,
+		stop:        make(chan struct{}),
+	}
+	sub.lastBlock.Store(start - 1)
+
+	s.mu.Lock()
+	s.subs[sub] = struct{}{}
+	s.wg.Add(1)
+	s.mu.Unlock()
+	go s.backfill(sub)
+
+	s.lggr.Debugw("Subscribed to logs", "filter", filter.Name, "confs", confs, "fromBlock", fromBlock)
+	return sub, nil
+}
+
+// backfill delivers the logs persisted since the start of the subscription, one page of blocks at a time, and then
+// switches it to the live stream. The database is read without holding mu, and the events are sent waiting for
+// the consumer.
+func (s *subscriptions) backfill(sub *subscription) {
+	defer s.wg.Done()
+	ctx, cancel := services.StopChan(sub.stop).NewCtx()
+	defer cancel()
+
+	for {
+		s.mu.Lock()
+		if sub.stopped() {
+			s.mu.Unlock()
+			sub.finish(sub.closeErr)
+			return
+		}
+		outbox, cursor := sub.outbox, sub.backfilled+1
+		sub.outbox = nil
+		s.mu.Unlock()
+
+		if len(outbox) > 0 {
+			sub.send(outbox)
+			continue
+		}
+
+		latest, finalized, err := s.latestBlock(ctx)
+		if err != nil {
+			s.fail(sub, err)
+			continue
+		}
+		if cursor > latest {
+			s.mu.Lock()
+			// the backlog is complete, unless a reorg or new events came in meanwhile
+			if !sub.stopped() && sub.backfilled+1 == cursor && len(sub.outbox) == 0 {
+				sub.backfilling = false
+				if !sub.release(sub.confirmed(sub.latest, sub.finalized), sub.finalized) {
+					s.drop(sub, ErrSubscriptionLagging)
+				}
+				s.mu.Unlock()
+				return
+			}
+			s.mu.Unlock()
+			continue
+		}
+
+		to := min(cursor+backlogPageSize-1, latest)
+		var logs []Log
+		for _, address := range sub.filter.Addresses {
+			addressLogs, err2 := s.orm.SelectLogsWithSigs(ctx, cursor, to, address, sub.filter.EventSigs)
+			if err2 != nil {
+				err = err2
+				break
+			}
+			logs = append(logs, addressLogs...)
+		}
+		if err != nil {
+			s.fail(sub, pkgerrors.Wrap(err, "failed to select logs for the subscription backlog"))
+			continue
+		}
+
+		s.mu.Lock()
+		// logs read before a reorg of their blocks are discarded and read again
+		if !sub.stopped() && sub.backfilled+1 == cursor {
+			if latest > sub.latest {
+				sub.latest, sub.finalized = latest, finalized
+			}
+			lastBlock := sub.lastBlock.Load()
+			for _, log := range logs {
+				if log.BlockNumber > lastBlock && sub.filter.matches(&log) {
+					sub.addPending(log)
+				}
+			}
+			sub.backfilled = to
+			sub.release(min(sub.confirmed(sub.latest, sub.finalized), to), sub.finalized)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// latestBlock returns the latest and finalized block persisted by the LogPoller.
+func (s *subscriptions) latestBlock(ctx context.Context) (latest, finalized int64, err error) {
+	latestBlock, err := s.orm.SelectLatestBlock(ctx)
+	if err != nil && !pkgerrors.Is(err, sql.ErrNoRows) {
+		return 0, 0, pkgerrors.Wrap(err, "failed to select latest block")
+	}
+	if latestBlock != nil {
+		return latestBlock.BlockNumber, latestBlock.FinalizedBlockNumber, nil
+	}
+	return 0, 0, nil
+}
+
+// fail terminates a subscription which backlog could not be read, unless it already ended.
+func (s *subscriptions) fail(sub *subscription, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !sub.stopped() {
+		s.drop(sub, err)
+	}
+}
+
+func (s *subscriptions) unsubscribe(sub *subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subs, sub)
+	sub.close(nil)
+}
+
+// publish delivers freshly persisted logs to the subscribers. latest and finalized describe the chain
+// as persisted by the LogPoller at the moment logs were saved and are used to evaluate confirmations.
+func (s *subscriptions) publish(logs []Log, latest, finalized int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs {
+		if !sub.enqueue(logs, latest, finalized) {
+			s.drop(sub, ErrSubscriptionLagging)
+		}
+	}
+}
+
+// reorg notifies the subscribers that all blocks starting from fromBlock have been removed.
+func (s *subscriptions) reorg(fromBlock int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs {
+		if !sub.reorg(fromBlock) {
+			s.drop(sub, ErrSubscriptionLagging)
+		}
+	}
+}
+
+// updateFilter makes subscribers of an already registered filter pick up its new definition.
+func (s *subscriptions) updateFilter(filter Filter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs {
+		if sub.filter.Name == filter.Name {
+			sub.filter = filter
+		}
+	}
+}
+
+// closeFilter terminates all subscriptions of the given filter.
+func (s *subscriptions) closeFilter(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs {
+		if sub.filter.Name == name {
+			s.drop(sub, ErrFilterUnregistered)
+		}
+	}
+}
+
+// closeAll terminates all subscriptions, it's called when LogPoller is stopped.
+func (s *subscriptions) closeAll() {
+	s.mu.Lock()
+	for sub := range s.subs {
+		s.drop(sub, ErrSubscriptionClosed)
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+func (s *subscriptions) drop(sub *subscription, err error) {
+	s.lggr.Warnw("Closing log subscription", "filter", sub.filter.Name, "lastBlock", sub.LastBlock(), "err", err)
+	delete(s.subs, sub)
+	sub.close(err)
+}
@@ -0,0 +1,320 @@
+package logpoller
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func newTestSubscription(s *subscriptions, filter Filter, confs evmtypes.Confirmations, bufferSize int) *subscription {
+	sub := &subscription{
+		filter: filter,
+		confs:  confs,
+		events: make(chan LogEvent, bufferSize),
+		errCh:  make(chan error, 1),
+		done:   s.unsubscribe,
+	}
+	s.subs[sub] = struct{}{}
+	return sub
+}
+
+func drainEvents(t *testing.T, sub *subscription) []LogEvent {
+	t.Helper()
+	var events []LogEvent
+	for {
+		select {
+		case ev, ok := <-sub.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, ev)
+		default:
+			return events
+		}
+	}
+}
+
+// receiveEvents waits for the next n events of the subscription.
+func receiveEvents(t *testing.T, sub Subscription, n int) []LogEvent {
+	t.Helper()
+	events := make([]LogEvent, 0, n)
+	for len(events) < n {
+		select {
+		case ev, ok := <-sub.Events():
+			require.True(t, ok, "subscription closed after %d events", len(events))
+			events = append(events, ev)
+		case <-time.After(tests.WaitTimeout(t)):
+			require.FailNow(t, "timed out waiting for events", "received %d of %d", len(events), n)
+		}
+	}
+	return events
+}
+
+// backlogORM serves the latest block and the logs of the subscription backlogs.
+type backlogORM struct {
+	ORM
+	mu     sync.Mutex
+	latest int64
+	logs   []Log
+}
+
+func (o *backlogORM) SelectLatestBlock(ctx context.Context) (*LogPollerBlock, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return &LogPollerBlock{BlockNumber: o.latest, FinalizedBlockNumber: o.latest}, nil
+}
+
+func (o *backlogORM) SelectLogsWithSigs(ctx context.Context, start, end int64, address common.Address, eventSigs []common.Hash) ([]Log, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var logs []Log
+	for _, log := range o.logs {
+		if log.BlockNumber >= start && log.BlockNumber <= end && log.Address == address {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func TestSubscriptions_Backlog(t *testing.T) {
+	addr := testutils.NewAddress()
+	sig := common.HexToHash("0x1234")
+	filter := Filter{Name: "test", Addresses: []common.Address{addr}, EventSigs: []common.Hash{sig}}
+	log := func(block, index int64) Log {
+		return Log{BlockNumber: block, LogIndex: index, Address: addr, EventSig: sig, Topics: [][]byte{sig.Bytes()}}
+	}
+
+	// the backlog is larger than the buffer of the subscription
+	orm := &backlogORM{latest: 1000}
+	for block := int64(1); block <= orm.latest; block++ {
+		orm.logs = append(orm.logs, log(block, 0), log(block, 1), log(block, 2))
+	}
+	s := newSubscriptions(logger.Sugared(logger.Test(t)), orm)
+
+	sub, err := s.subscribe(testutils.Context(t), filter, evmtypes.Unconfirmed, 1)
+	require.NoError(t, err)
+	assert.Equal(t, subscriptionBufferSize, cap(sub.Events()))
+
+	// live logs are published without waiting for the backlog, and delivered after it
+	orm.mu.Lock()
+	orm.latest = 1001
+	orm.logs = append(orm.logs, log(1001, 0))
+	orm.mu.Unlock()
+	s.publish([]Log{log(1001, 0)}, 1001, 1001)
+	s.publish([]Log{log(1002, 0)}, 1002, 1002)
+
+	events := receiveEvents(t, sub, 3002)
+	for i, ev := range events[:3000] {
+		assert.Equal(t, int64(i/3+1), ev.Log.BlockNumber)
+		assert.Equal(t, int64(i%3), ev.Log.LogIndex)
+	}
+	assert.Equal(t, int64(1001), events[3000].Log.BlockNumber)
+	assert.Equal(t, int64(1002), events[3001].Log.BlockNumber)
+	require.Eventually(t, func() bool { return sub.LastBlock() == 1002 }, tests.WaitTimeout(t), 10*time.Millisecond)
+
+	s.publish([]Log{log(1003, 0)}, 1003, 1003)
+	events = receiveEvents(t, sub, 1)
+	assert.Equal(t, int64(1003), events[0].Log.BlockNumber)
+
+	t.Run("unsubscribing while the backlog is delivered", func(t *testing.T) {
+		sub, err := s.subscribe(testutils.Context(t), filter, evmtypes.Unconfirmed, 1)
+		require.NoError(t, err)
+		receiveEvents(t, sub, 1)
+		sub.Unsubscribe()
+		s.wg.Wait()
+		_, ok := <-sub.Err()
+		assert.False(t, ok)
+	})
+}
+
+func TestSubscriptions_Confirmations(t *testing.T) {
+	addr := testutils.NewAddress()
+	sig := common.HexToHash("0x1234")
+	filter := Filter{Name: "test", Addresses: []common.Address{addr}, EventSigs: []common.Hash{sig}}
+	log := func(block, index int64) Log {
+		return Log{BlockNumber: block, LogIndex: index, Address: addr, EventSig: sig, Topics: [][]byte{sig.Bytes()}}
+	}
+
+	s := newSubscriptions(logger.Sugared(logger.Test(t)), nil)
+	unconfirmed := newTestSubscription(s, filter, evmtypes.Unconfirmed, 10)
+	twoConfs := newTestSubscription(s, filter, 2, 10)
+	finalized := newTestSubscription(s, filter, evmtypes.Finalized, 10)
+
+	s.publish([]Log{log(10, 1), log(10, 0)}, 10, 5)
+	events := drainEvents(t, unconfirmed)
+	require.Len(t, events, 2)
+	assert.Equal(t, int64(0), events[0].Log.LogIndex, "logs are ordered by index within a block")
+	assert.Equal(t, int64(1), events[1].Log.LogIndex)
+	assert.Equal(t, int64(10), unconfirmed.LastBlock())
+	assert.Empty(t, drainEvents(t, twoConfs))
+	assert.Empty(t, drainEvents(t, finalized))
+
+	s.publish(nil, 11, 6)
+	assert.Empty(t, drainEvents(t, twoConfs))
+
+	s.publish([]Log{log(12, 0)}, 12, 10)
+	assert.Len(t, drainEvents(t, unconfirmed), 1)
+	assert.Len(t, drainEvents(t, twoConfs), 2)
+	assert.Equal(t, int64(10), twoConfs.LastBlock())
+	assert.Len(t, drainEvents(t, finalized), 2)
+
+	// Logs already delivered are skipped when the same blocks are processed again, e.g. during replay
+	s.publish([]Log{log(10, 0), log(12, 0)}, 12, 10)
+	assert.Empty(t, drainEvents(t, unconfirmed))
+	assert.Empty(t, drainEvents(t, finalized))
+}
+
+func TestSubscriptions_Filtering(t *testing.T) {
+	addr := testutils.NewAddress()
+	sig := common.HexToHash("0x1234")
+	topic := common.HexToHash("0x5678")
+	filter := Filter{Name: "test", Addresses: []common.Address{addr}, EventSigs: []common.Hash{sig}, Topic2: []common.Hash{topic}}
+
+	s := newSubscriptions(logger.Sugared(logger.Test(t)), nil)
+	sub := newTestSubscription(s, filter, evmtypes.Unconfirmed, 10)
+
+	s.publish([]Log{
+		{BlockNumber: 1, LogIndex: 0, Address: addr, EventSig: sig, Topics: [][]byte{sig.Bytes(), topic.Bytes()}},
+		{BlockNumber: 1, LogIndex: 1, Address: addr, EventSig: sig, Topics: [][]byte{sig.Bytes(), sig.Bytes()}},
+		{BlockNumber: 1, LogIndex: 2, Address: addr, EventSig: sig, Topics: [][]byte{sig.Bytes()}},
+		{BlockNumber: 1, LogIndex: 3, Address: testutils.NewAddress(), EventSig: sig, Topics: [][]byte{sig.Bytes(), topic.Bytes()}},
+		{BlockNumber: 1, LogIndex: 4, Address: addr, EventSig: topic, Topics: [][]byte{topic.Bytes(), topic.Bytes()}},
+	}, 1, 1)
+
+	events := drainEvents(t, sub)
+	require.Len(t, events, 1)
+	assert.Equal(t, int64(0), events[0].Log.LogIndex)
+}
+
+func TestSubscriptions_Reorg(t *testing.T) {
+	addr := testutils.NewAddress()
+	sig := common.HexToHash("0x1234")
+	filter := Filter{Name: "test", Addresses: []common.Address{addr}, EventSigs: []common.Hash{sig}}
+	log := func(block int64, hash common.Hash) Log {
+		return Log{BlockNumber: block, BlockHash: hash, Address: addr, EventSig: sig, Topics: [][]byte{sig.Bytes()}}
+	}
+
+	s := newSubscriptions(logger.Sugared(logger.Test(t)), nil)
+	sub := newTestSubscription(s, filter, 1, 10)
+
+	s.publish([]Log{log(5, common.HexToHash("0x5"))}, 5, 2)
+	s.publish([]Log{log(6, common.HexToHash("0x6"))}, 6, 2)
+	s.publish([]Log{log(7, common.HexToHash("0x7"))}, 7, 2)
+	require.Len(t, drainEvents(t, sub), 2)
+
+	// Block 6 was delivered, block 7 was still waiting for confirmations
+	s.reorg(6)
+	events := drainEvents(t, sub)
+	require.Len(t, events, 1)
+	assert.True(t, events[0].Removed)
+	assert.Equal(t, common.HexToHash("0x6"), events[0].Log.BlockHash)
+	assert.Equal(t, int64(5), sub.LastBlock())
+
+	s.publish([]Log{log(6, common.HexToHash("0x66"))}, 6, 2)
+	s.publish([]Log{log(7, common.HexToHash("0x77"))}, 7, 2)
+	events = drainEvents(t, sub)
+	require.Len(t, events, 1)
+	assert.False(t, events[0].Removed)
+	assert.Equal(t, common.HexToHash("0x66"), events[0].Log.BlockHash)
+}
+
+func TestSubscriptions_Termination(t *testing.T) {
+	addr := testutils.NewAddress()
+	sig := common.HexToHash("0x1234")
+	filter := Filter{Name: "test", Addresses: []common.Address{addr}, EventSigs: []common.Hash{sig}}
+	other := Filter{Name: "other", Addresses: []common.Address{addr}, EventSigs: []common.Hash{sig}}
+	logs := []Log{
+		{BlockNumber: 1, LogIndex: 0, Address: addr, EventSig: sig},
+		{BlockNumber: 1, LogIndex: 1, Address: addr, EventSig: sig},
+	}
+
+	s := newSubscriptions(logger.Sugared(logger.Test(t)), nil)
+	lagging := newTestSubscription(s, filter, evmtypes.Unconfirmed, 1)
+	unregistered := newTestSubscription(s, other, evmtypes.Unconfirmed, 10)
+	unsubscribed := newTestSubscription(s, other, evmtypes.Unconfirmed, 10)
+
+	s.publish(logs, 1, 1)
+	assert.ErrorIs(t, <-lagging.Err(), ErrSubscriptionLagging)
+	assert.Equal(t, int64(0), lagging.LastBlock())
+
+	unsubscribed.Unsubscribe()
+	unsubscribed.Unsubscribe()
+	_, ok := <-unsubscribed.Err()
+	assert.False(t, ok)
+
+	s.closeFilter(other.Name)
+	assert.Len(t, drainEvents(t, unregistered), 2)
+	assert.ErrorIs(t, <-unregistered.Err(), ErrFilterUnregistered)
+	assert.Empty(t, s.subs)
+}
+
+func TestLogPoller_Subscribe(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+	chainID := testutils.NewRandomEVMChainID()
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr)
+	lp := NewLogPoller(orm, nil, lggr, nil, Opts{})
+
+	addr := testutils.NewAddress()
+	sig := common.HexToHash("0x1234")
+	_, err := lp.Subscribe(ctx, "missing", evmtypes.Unconfirmed, 0)
+	require.Error(t, err)
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "test", Addresses: []common.Address{addr}, EventSigs: []common.Hash{sig}}))
+
+	log := func(block int64) Log {
+		return Log{
+			EvmChainId:  ubig.New(chainID),
+			BlockNumber: block,
+			BlockHash:   common.BigToHash(big.NewInt(block)),
+			Address:     addr,
+			EventSig:    sig,
+			Topics:      [][]byte{sig.Bytes()},
+			TxHash:      common.HexToHash("0x1"),
+		}
+	}
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, orm.InsertLogsWithBlock(ctx, []Log{log(i)}, LogPollerBlock{BlockHash: common.BigToHash(big.NewInt(i)), BlockNumber: i, FinalizedBlockNumber: 1}))
+	}
+
+	// Resuming from block 2 delivers the persisted backlog first
+	sub, err := lp.Subscribe(ctx, "test", evmtypes.Unconfirmed, 2)
+	require.NoError(t, err)
+	events := receiveEvents(t, sub, 2)
+	assert.Equal(t, int64(2), events[0].Log.BlockNumber)
+	assert.Equal(t, int64(3), events[1].Log.BlockNumber)
+
+	// Subscribing without fromBlock only delivers new logs
+	live, err := lp.Subscribe(ctx, "test", evmtypes.Unconfirmed, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), live.LastBlock())
+	require.Eventually(t, func() bool {
+		lp.subs.mu.Lock()
+		defer lp.subs.mu.Unlock()
+		return !sub.(*subscription).backfilling && !live.(*subscription).backfilling
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+	assert.Empty(t, drainEvents(t, live.(*subscription)))
+
+	require.NoError(t, lp.DeleteLogsAndBlocksAfter(ctx, 3))
+	events = drainEvents(t, sub.(*subscription))
+	require.Len(t, events, 1)
+	assert.True(t, events[0].Removed)
+
+	require.NoError(t, lp.UnregisterFilter(ctx, "test"))
+	assert.ErrorIs(t, <-sub.Err(), ErrFilterUnregistered)
+	assert.ErrorIs(t, <-live.Err(), ErrFilterUnregistered)
+}
@@ -0,0 +1,139 @@
+package txmgr
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+// accessListUnsupportedBackoff is how long access lists are not created for, once the RPC reported that it does not
+// support eth_createAccessList.
+const accessListUnsupportedBackoff = time.Hour
+
+type accessListClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+}
+
+type accessListKey struct {
+	to       common.Address
+	selector [4]byte
+}
+
+type cachedAccessList struct {
+	// accessList is nil if the transactions are cheaper without one
+	accessList types.AccessList
+	// gasUsed is the gas used by the call along with the access list
+	gasUsed   uint64
+	expiresAt time.Time
+}
+
+// accessListProvider creates the EIP-2930 access lists attached to dynamic fee attempts. Access lists are cached per
+// contract and function selector, since transmits and upkeeps touch the same storage slots every round. An access list
+// is only attached if it fits in the gas limit of the attempt and makes the call cheaper than without it. Any failure
+// to create an access list is not fatal, the attempt is then built without one.
+type accessListProvider struct {
+	client   accessListClient
+	lggr     logger.SugaredLogger
+	cacheTTL time.Duration
+	now      func() time.Time
+
+	mu               sync.Mutex
+	cache            map[accessListKey]cachedAccessList
+	unsupportedUntil time.Time
+}
+
+func newAccessListProvider(c accessListClient, lggr logger.Logger, cfg config.AccessListConfig) *accessListProvider {
+	return &accessListProvider{
+		client:   c,
+		lggr:     logger.Sugared(logger.Named(lggr, "AccessListProvider")),
+		cacheTTL: cfg.CacheTTL(),
+		now:      time.Now,
+		cache:    map[accessListKey]cachedAccessList{},
+	}
+}
+
+// AccessList returns the access list to attach to an attempt of etx with the given gas limit, or nil if the attempt
+// should be sent without one.
+func (p *accessListProvider) AccessList(ctx context.Context, etx Tx, gasLimit uint64) types.AccessList {
+	if len(etx.EncodedPayload) < 4 {
+		// plain transfers and purge attempts do not touch any storage worth listing
+		return nil
+	}
+	key := accessListKey{to: etx.ToAddress, selector: [4]byte(etx.EncodedPayload[:4])}
+	cacheable := p.cacheTTL > 0
+	if meta, err := etx.GetMeta(); err != nil || (meta != nil && meta.FwdrDestAddress != nil) {
+		// forwarded transactions share the selector of the forwarder whatever the destination, do not mix them up
+		cacheable = false
+	}
+
+	now := p.now()
+	p.mu.Lock()
+	if now.Before(p.unsupportedUntil) {
+		p.mu.Unlock()
+		return nil
+	}
+	if cached, ok := p.cache[key]; cacheable && ok && now.Before(cached.expiresAt) {
+		p.mu.Unlock()
+		if cached.accessList != nil && cached.gasUsed > gasLimit {
+			p.lggr.Debugw("Transaction with cached access list would exceed its gas limit, sending it without access list", "txID", etx.ID, "gasUsed", cached.gasUsed, "gasLimit", gasLimit)
+			return nil
+		}
+		return cached.accessList
+	}
+	p.mu.Unlock()
+
+	msg := ethereum.CallMsg{
+		From:  etx.FromAddress,
+		To:    &etx.ToAddress,
+		Gas:   gasLimit,
+		Value: &etx.Value,
+		Data:  etx.EncodedPayload,
+	}
+	accessList, gasUsed, err := client.CreateAccessList(ctx, p.client, msg)
+	if client.IsMethodNotFound(err) {
+		p.mu.Lock()
+		p.unsupportedUntil = now.Add(accessListUnsupportedBackoff)
+		p.mu.Unlock()
+		p.lggr.Warnw("RPC does not support eth_createAccessList, sending transactions without access lists", "err", err, "retryIn", accessListUnsupportedBackoff)
+		return nil
+	}
+	if err != nil {
+		p.lggr.Debugw("Failed to create access list, sending transaction without it", "err", err, "txID", etx.ID)
+		return nil
+	}
+	if len(accessList) > 0 {
+		// every entry costs intrinsic gas, which is only recovered if the call accesses it
+		gasWithout, err := p.client.EstimateGas(ctx, msg)
+		if err != nil {
+			p.lggr.Debugw("Failed to estimate gas without access list, sending transaction without it", "err", err, "txID", etx.ID)
+			return nil
+		}
+		if gasUsed >= gasWithout {
+			p.lggr.Debugw("Access list does not save gas, sending transaction without it", "txID", etx.ID, "gasUsed", gasUsed, "gasWithoutAccessList", gasWithout)
+			accessList = nil
+		}
+	} else {
+		accessList = nil
+	}
+
+	if cacheable {
+		p.mu.Lock()
+		p.cache[key] = cachedAccessList{accessList: accessList, gasUsed: gasUsed, expiresAt: now.Add(p.cacheTTL)}
+		p.mu.Unlock()
+	}
+	if accessList != nil && gasUsed > gasLimit {
+		p.lggr.Debugw("Transaction with access list would exceed its gas limit, sending it without access list", "txID", etx.ID, "gasUsed", gasUsed, "gasLimit", gasLimit)
+		return nil
+	}
+	return accessList
+}
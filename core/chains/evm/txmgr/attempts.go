@@ -8,13 +8,17 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
 	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontypes "github.com/smartcontractkit/chainlink/v2/common/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
@@ -30,23 +34,35 @@ type evmTxAttemptBuilder struct {
 	feeConfig evmTxAttemptBuilderFeeConfig
 	keystore  TxAttemptSigner[common.Address]
 	gas.EvmFeeEstimator
+	// accessLists is set if EIP-2930 access lists are attached to dynamic fee attempts
+	accessLists *accessListProvider
 }
 
 type evmTxAttemptBuilderFeeConfig interface {
 	EIP1559DynamicFees() bool
+	BumpPercent() uint16
+	BumpMin() *assets.Wei
 	PriceMaxKey(common.Address) *assets.Wei
 	LimitDefault() uint64
 }
 
 func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeConfig, keystore TxAttemptSigner[common.Address], estimator gas.EvmFeeEstimator) *evmTxAttemptBuilder {
-	return &evmTxAttemptBuilder{chainID, feeConfig, keystore, estimator}
+	return &evmTxAttemptBuilder{chainID: chainID, feeConfig: feeConfig, keystore: keystore, EvmFeeEstimator: estimator}
+}
+
+// EnableAccessLists makes the builder attach EIP-2930 access lists, created with eth_createAccessList, to dynamic fee
+// attempts.
+func (c *evmTxAttemptBuilder) EnableAccessLists(client accessListClient, lggr logger.Logger, cfg evmconfig.AccessListConfig) {
+	c.accessLists = newAccessListProvider(client, lggr, cfg)
 }
 
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
 // used for when a brand new transaction is being created in the txm
 func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	txType := 0x0
-	if c.feeConfig.EIP1559DynamicFees() {
+	if etx.BlobSidecar != nil {
+		txType = 0x3
+	} else if c.feeConfig.EIP1559DynamicFees() {
 		txType = 0x2
 	}
 	return c.NewTxAttemptWithType(ctx, etx, lggr, txType, opts...)
@@ -56,6 +72,9 @@ func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr log
 // used for L2 re-estimation on broadcasting (note EIP1559 must be disabled otherwise this will fail with mismatched fees + tx type)
 func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx, lggr logger.Logger, txType int, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
+	if txType == 0x3 {
+		opts = append(opts, feetypes.OptBlobTx)
+	}
 	fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
@@ -109,6 +128,45 @@ func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lgg
 	return attempt, nil
 }
 
+// NewReplacementTxAttempt builds an attempt at the given fee and gas limit, with the tx type of the latest attempt of the
+// transaction. Nodes only accept a replacement priced above the attempt it replaces, so the fee must be at least the fee
+// of the latest attempt bumped by EVM.GasEstimator.BumpPercent or EVM.GasEstimator.BumpMin, whichever is larger.
+func (c *evmTxAttemptBuilder) NewReplacementTxAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, lggr logger.Logger) (attempt TxAttempt, err error) {
+	if len(etx.TxAttempts) == 0 {
+		return attempt, fmt.Errorf("expected transaction %d to have at least one attempt", etx.ID)
+	}
+	previousAttempt := etx.TxAttempts[0]
+	if err = c.validateReplacementFee(previousAttempt.TxFee, fee); err != nil {
+		return attempt, err
+	}
+	attempt, _, err = c.NewCustomTxAttempt(ctx, etx, fee, gasLimit, previousAttempt.TxType, lggr)
+	return attempt, err
+}
+
+// validateReplacementFee checks that each component of the fee set in the previous fee is bumped by the bump rules.
+func (c *evmTxAttemptBuilder) validateReplacementFee(previous, fee gas.EvmFee) error {
+	for _, component := range []struct {
+		name           string
+		previous, next *assets.Wei
+	}{
+		{"gas price", previous.GasPrice, fee.GasPrice},
+		{"tip cap", previous.GasTipCap, fee.GasTipCap},
+		{"fee cap", previous.GasFeeCap, fee.GasFeeCap},
+		{"blob fee cap", previous.BlobFeeCap, fee.BlobFeeCap},
+	} {
+		if component.previous == nil || component.next == nil {
+			// A missing component is rejected when the attempt is built for the tx type
+			continue
+		}
+		minimum := assets.NewWei(commonfee.MaxBumpedFee(component.previous.ToInt(), c.feeConfig.BumpPercent(), c.feeConfig.BumpMin().ToInt()))
+		if component.next.Cmp(minimum) < 0 {
+			return fmt.Errorf("%w: %s of %s must be at least %s, the previous %s of %s bumped by EVM.GasEstimator.BumpPercent or EVM.GasEstimator.BumpMin",
+				commonfee.ErrFeeBelowBump, component.name, component.next, minimum, component.name, component.previous)
+		}
+	}
+	return nil
+}
+
 // NewCustomTxAttempt is the lowest level func where the fee parameters + tx type must be passed in
 // used in the txm for force rebroadcast where fees and tx type are pre-determined without an estimator
 func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, txType int, lggr logger.Logger) (attempt TxAttempt, retryable bool, err error) {
@@ -132,6 +190,14 @@ func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fe
 			GasTipCap: fee.GasTipCap,
 		}, gasLimit)
 		return attempt, true, err
+	case 0x3: // blob, EIP4844
+		if !fee.ValidDynamic() || fee.BlobFeeCap == nil {
+			err = pkgerrors.Errorf("Attempt %v is a type 3 transaction but estimator did not return blob fee bump", attempt.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		attempt, err = c.newBlobAttempt(ctx, etx, fee, gasLimit)
+		return attempt, true, err
 	default:
 		err = pkgerrors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
@@ -184,6 +250,9 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 		fee.GasFeeCap,
 		etx.EncodedPayload,
 	)
+	if c.accessLists != nil {
+		d.AccessList = c.accessLists.AccessList(ctx, etx, gasLimit)
+	}
 	tx := types.NewTx(&d)
 	attempt, err = c.newSignedAttempt(ctx, etx, tx)
 	if err != nil {
@@ -197,6 +266,78 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+func (c *evmTxAttemptBuilder) newBlobAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64) (attempt TxAttempt, err error) {
+	if err = validateDynamicFeeGas(c.feeConfig, fee.DynamicFee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+	if fee.BlobFeeCap.ToInt().Cmp(Max256BitUInt) > 0 {
+		return attempt, pkgerrors.New("impossibly large blob fee cap")
+	}
+	sidecar, err := etx.GetBlobSidecar()
+	if err != nil {
+		return attempt, err
+	}
+	if sidecar == nil {
+		return attempt, pkgerrors.Errorf("cannot create blob tx attempt: tx %v has no blob sidecar", etx.ID)
+	}
+	blobTxSidecar, err := newBlobTxSidecar(sidecar)
+	if err != nil {
+		return attempt, pkgerrors.Wrap(err, "invalid blob sidecar")
+	}
+
+	tx := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(&c.chainID),
+		Nonce:      uint64(*etx.Sequence),
+		GasTipCap:  uint256.MustFromBig(fee.GasTipCap.ToInt()),
+		GasFeeCap:  uint256.MustFromBig(fee.GasFeeCap.ToInt()),
+		Gas:        gasLimit,
+		To:         etx.ToAddress,
+		Value:      uint256.MustFromBig(&etx.Value),
+		Data:       etx.EncodedPayload,
+		BlobFeeCap: uint256.MustFromBig(fee.BlobFeeCap.ToInt()),
+		BlobHashes: blobTxSidecar.BlobHashes(),
+		Sidecar:    blobTxSidecar,
+	})
+	attempt, err = c.newSignedAttempt(ctx, etx, tx)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFee: gas.DynamicFee{GasFeeCap: fee.GasFeeCap, GasTipCap: fee.GasTipCap},
+		BlobFeeCap: fee.BlobFeeCap,
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = 3
+	return attempt, nil
+}
+
+// newBlobTxSidecar converts a blob sidecar to its geth form, verifying the KZG proof of each of its blobs.
+func newBlobTxSidecar(sidecar *txmgrtypes.BlobSidecar) (*types.BlobTxSidecar, error) {
+	if err := sidecar.Validate(); err != nil {
+		return nil, err
+	}
+	blobTxSidecar := &types.BlobTxSidecar{
+		Blobs:       make([]kzg4844.Blob, len(sidecar.Blobs)),
+		Commitments: make([]kzg4844.Commitment, len(sidecar.Commitments)),
+		Proofs:      make([]kzg4844.Proof, len(sidecar.Proofs)),
+	}
+	for i := range sidecar.Blobs {
+		if len(sidecar.Blobs[i]) != len(kzg4844.Blob{}) {
+			return nil, fmt.Errorf("blob %d has %d bytes, expected %d", i, len(sidecar.Blobs[i]), len(kzg4844.Blob{}))
+		}
+		if len(sidecar.Commitments[i]) != len(kzg4844.Commitment{}) || len(sidecar.Proofs[i]) != len(kzg4844.Proof{}) {
+			return nil, fmt.Errorf("blob %d has a malformed commitment or proof", i)
+		}
+		copy(blobTxSidecar.Blobs[i][:], sidecar.Blobs[i])
+		copy(blobTxSidecar.Commitments[i][:], sidecar.Commitments[i])
+		copy(blobTxSidecar.Proofs[i][:], sidecar.Proofs[i])
+		if err := kzg4844.VerifyBlobProof(&blobTxSidecar.Blobs[i], blobTxSidecar.Commitments[i], blobTxSidecar.Proofs[i]); err != nil {
+			return nil, fmt.Errorf("blob %d has an invalid proof: %w", i, err)
+		}
+	}
+	return blobTxSidecar, nil
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
@@ -1,22 +1,32 @@
 package txmgr_test
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -37,6 +47,8 @@ type feeConfig struct {
 	priceMin           *assets.Wei
 	priceMax           *assets.Wei
 	limitDefault       uint64
+	bumpPercent        uint16
+	bumpMin            *assets.Wei
 }
 
 func newFeeConfig() *feeConfig {
@@ -44,6 +56,7 @@ func newFeeConfig() *feeConfig {
 		tipCapMin: assets.NewWeiI(0),
 		priceMin:  assets.NewWeiI(0),
 		priceMax:  assets.NewWeiI(0),
+		bumpMin:   assets.NewWeiI(0),
 	}
 }
 
@@ -52,6 +65,8 @@ func (g *feeConfig) TipCapMin() *assets.Wei                          { return g.
 func (g *feeConfig) PriceMin() *assets.Wei                           { return g.priceMin }
 func (g *feeConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei { return g.priceMax }
 func (g *feeConfig) LimitDefault() uint64                            { return g.limitDefault }
+func (g *feeConfig) BumpPercent() uint16                             { return g.bumpPercent }
+func (g *feeConfig) BumpMin() *assets.Wei                            { return g.bumpMin }
 
 func TestTxm_SignTx(t *testing.T) {
 	t.Parallel()
@@ -301,6 +316,55 @@ func TestTxm_NewPurgeAttempt(t *testing.T) {
 	})
 }
 
+func TestTxm_NewReplacementTxAttempt(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	tx := types.NewTx(&types.LegacyTx{})
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(tx, nil).Maybe()
+	gc := newFeeConfig()
+	gc.priceMax = assets.GWei(50)
+	gc.bumpPercent = 10
+	gc.bumpMin = assets.GWei(1)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, nil)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+
+	newTx := func(t *testing.T, fee gas.EvmFee, txType int) txmgr.Tx {
+		n := evmtypes.Nonce(0)
+		etx := txmgr.Tx{Sequence: &n, FromAddress: addr, EncodedPayload: []byte{1, 2, 3}}
+		prevAttempt, _, err := cks.NewCustomTxAttempt(ctx, etx, fee, 100, txType, lggr)
+		require.NoError(t, err)
+		etx.TxAttempts = append(etx.TxAttempts, prevAttempt)
+		return etx
+	}
+
+	t.Run("creates an attempt with the tx type of the previous attempt", func(t *testing.T) {
+		etx := newTx(t, gas.EvmFee{GasPrice: assets.GWei(20)}, 0x0)
+		a, err := cks.NewReplacementTxAttempt(ctx, etx, gas.EvmFee{GasPrice: assets.GWei(22)}, 200, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 0x0, a.TxType)
+		assert.Equal(t, assets.GWei(22).String(), a.TxFee.GasPrice.String())
+		assert.Equal(t, uint64(200), a.ChainSpecificFeeLimit)
+	})
+
+	t.Run("rejects a gas price below the bump of the previous gas price", func(t *testing.T) {
+		etx := newTx(t, gas.EvmFee{GasPrice: assets.GWei(20)}, 0x0)
+		_, err := cks.NewReplacementTxAttempt(ctx, etx, gas.EvmFee{GasPrice: assets.GWei(21)}, 100, lggr)
+		require.ErrorIs(t, err, commonfee.ErrFeeBelowBump)
+	})
+
+	t.Run("rejects a dynamic fee with a tip cap below the bump of the previous tip cap", func(t *testing.T) {
+		etx := newTx(t, gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(10), GasFeeCap: assets.GWei(20)}}, 0x2)
+		_, err := cks.NewReplacementTxAttempt(ctx, etx, gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(10), GasFeeCap: assets.GWei(30)}}, 100, lggr)
+		require.ErrorIs(t, err, commonfee.ErrFeeBelowBump)
+		assert.Contains(t, err.Error(), "tip cap")
+
+		a, err := cks.NewReplacementTxAttempt(ctx, etx, gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(11), GasFeeCap: assets.GWei(22)}}, 100, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 0x2, a.TxType)
+	})
+}
+
 func TestTxm_NewCustomTxAttempt_NonRetryableErrors(t *testing.T) {
 	t.Parallel()
 
@@ -360,3 +424,219 @@ func TestTxm_EvmTxAttemptBuilder_RetryableEstimatorError(t *testing.T) {
 		assert.True(t, retryable)
 	})
 }
+
+type accessListClient struct {
+	calls  int
+	result string
+	err    error
+	// estimate is the gas used without access list
+	estimate  uint64
+	estimates int
+}
+
+func (c *accessListClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	c.calls++
+	if c.err != nil {
+		return c.err
+	}
+	return json.Unmarshal([]byte(c.result), result)
+}
+
+func (c *accessListClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	c.estimates++
+	return c.estimate, nil
+}
+
+type accessListConfig struct {
+	cacheTTL time.Duration
+}
+
+func (c accessListConfig) Enabled() bool           { return true }
+func (c accessListConfig) CacheTTL() time.Duration { return c.cacheTTL }
+
+func TestTxm_NewDynamicFeeTx_AccessLists(t *testing.T) {
+	t.Parallel()
+
+	addr := NewEvmAddress()
+	toAddr := NewEvmAddress()
+	slotAddr := NewEvmAddress()
+	lggr := logger.Test(t)
+	var n evmtypes.Nonce
+	fee := gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(1), GasFeeCap: assets.GWei(2)}}
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, ToAddress: toAddr, EncodedPayload: []byte{0xde, 0xad, 0xbe, 0xef, 0x01}}
+	result := fmt.Sprintf(`{"accessList":[{"address":"%s","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000001"]}],"gasUsed":"0x5208"}`, slotAddr.Hex())
+
+	newBuilder := func(t *testing.T, c *accessListClient, cacheTTL time.Duration) (txmgr.TxAttemptBuilder, *[]*types.Transaction) {
+		var signed []*types.Transaction
+		kst := ksmocks.NewEth(t)
+		kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Run(func(args mock.Arguments) {
+			signed = append(signed, args.Get(2).(*types.Transaction))
+		}).Return(types.NewTx(&types.DynamicFeeTx{}), nil)
+		feeCfg := newFeeConfig()
+		feeCfg.priceMax = assets.GWei(200)
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, nil)
+		cks.EnableAccessLists(c, lggr, accessListConfig{cacheTTL: cacheTTL})
+		return cks, &signed
+	}
+
+	t.Run("attaches the access list and reuses it for the same contract and selector", func(t *testing.T) {
+		c := &accessListClient{result: result, estimate: 23_000}
+		cks, signed := newBuilder(t, c, time.Hour)
+
+		for i := 0; i < 2; i++ {
+			_, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 100_000, 0x2, lggr)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, c.calls)
+		assert.Equal(t, 1, c.estimates)
+		require.Len(t, *signed, 2)
+		for _, tx := range *signed {
+			require.Len(t, tx.AccessList(), 1)
+			assert.Equal(t, slotAddr, tx.AccessList()[0].Address)
+			assert.Len(t, tx.AccessList()[0].StorageKeys, 1)
+		}
+	})
+
+	t.Run("creates a new access list every time without cache", func(t *testing.T) {
+		c := &accessListClient{result: result, estimate: 23_000}
+		cks, _ := newBuilder(t, c, 0)
+
+		for i := 0; i < 2; i++ {
+			_, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 100_000, 0x2, lggr)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, c.calls)
+	})
+
+	t.Run("falls back without access list if the RPC does not support it", func(t *testing.T) {
+		c := &accessListClient{err: errors.New("the method eth_createAccessList does not exist/is not available")}
+		cks, signed := newBuilder(t, c, time.Hour)
+
+		for i := 0; i < 2; i++ {
+			_, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 100_000, 0x2, lggr)
+			require.NoError(t, err)
+		}
+		// the RPC is not asked again once it reported the method as unsupported
+		assert.Equal(t, 1, c.calls)
+		for _, tx := range *signed {
+			assert.Empty(t, tx.AccessList())
+		}
+	})
+
+	t.Run("falls back without access list if it would exceed the gas limit", func(t *testing.T) {
+		c := &accessListClient{result: result, estimate: 23_000}
+		cks, signed := newBuilder(t, c, time.Hour)
+
+		_, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 20_000, 0x2, lggr)
+		require.NoError(t, err)
+		require.Len(t, *signed, 1)
+		assert.Empty(t, (*signed)[0].AccessList())
+	})
+
+	t.Run("checks cached access lists against the gas limit", func(t *testing.T) {
+		c := &accessListClient{result: result, estimate: 23_000}
+		cks, signed := newBuilder(t, c, time.Hour)
+
+		_, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 100_000, 0x2, lggr)
+		require.NoError(t, err)
+		_, _, err = cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 20_000, 0x2, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 1, c.calls)
+		require.Len(t, *signed, 2)
+		assert.Len(t, (*signed)[0].AccessList(), 1)
+		assert.Empty(t, (*signed)[1].AccessList())
+	})
+
+	t.Run("does not attach access lists which do not save gas", func(t *testing.T) {
+		c := &accessListClient{result: result, estimate: 21_000}
+		cks, signed := newBuilder(t, c, time.Hour)
+
+		for i := 0; i < 2; i++ {
+			_, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 100_000, 0x2, lggr)
+			require.NoError(t, err)
+		}
+		// the outcome is cached as well
+		assert.Equal(t, 1, c.calls)
+		assert.Equal(t, 1, c.estimates)
+		require.Len(t, *signed, 2)
+		for _, tx := range *signed {
+			assert.Empty(t, tx.AccessList())
+		}
+	})
+
+	t.Run("does not create access lists for legacy attempts", func(t *testing.T) {
+		c := &accessListClient{result: result}
+		cks, signed := newBuilder(t, c, time.Hour)
+
+		_, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, gas.EvmFee{GasPrice: assets.GWei(1)}, 100_000, 0x0, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 0, c.calls)
+		require.Len(t, *signed, 1)
+		assert.Empty(t, (*signed)[0].AccessList())
+	})
+}
+
+func TestTxm_NewBlobTx(t *testing.T) {
+	t.Parallel()
+
+	addr := NewEvmAddress()
+	toAddr := NewEvmAddress()
+	lggr := logger.Test(t)
+	var n evmtypes.Nonce
+	fee := gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(1), GasFeeCap: assets.GWei(2)}, BlobFeeCap: assets.GWei(3)}
+
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(&blob)
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(&blob, commitment)
+	require.NoError(t, err)
+	newTx := func(t *testing.T, sidecar txmgrtypes.BlobSidecar) txmgr.Tx {
+		b, err := json.Marshal(sidecar)
+		require.NoError(t, err)
+		raw := sqlutil.JSON(b)
+		return txmgr.Tx{Sequence: &n, FromAddress: addr, ToAddress: toAddr, EncodedPayload: []byte{1, 2, 3}, BlobSidecar: &raw}
+	}
+	validSidecar := txmgrtypes.BlobSidecar{Blobs: [][]byte{blob[:]}, Commitments: [][]byte{commitment[:]}, Proofs: [][]byte{proof[:]}}
+
+	newBuilder := func(t *testing.T) (txmgr.TxAttemptBuilder, *[]*types.Transaction) {
+		var signed []*types.Transaction
+		kst := ksmocks.NewEth(t)
+		kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Run(func(args mock.Arguments) {
+			signed = append(signed, args.Get(2).(*types.Transaction))
+		}).Return(types.NewTx(&types.BlobTx{}), nil).Maybe()
+		feeCfg := newFeeConfig()
+		feeCfg.priceMax = assets.GWei(200)
+		return txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, nil), &signed
+	}
+
+	t.Run("creates a blob transaction with the sidecar", func(t *testing.T) {
+		cks, signed := newBuilder(t)
+		a, _, err := cks.NewCustomTxAttempt(tests.Context(t), newTx(t, validSidecar), fee, 100_000, 0x3, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+		assert.Equal(t, fee.BlobFeeCap, a.TxFee.BlobFeeCap)
+
+		require.Len(t, *signed, 1)
+		tx := (*signed)[0]
+		assert.Equal(t, uint8(types.BlobTxType), tx.Type())
+		assert.Equal(t, fee.BlobFeeCap.ToInt(), tx.BlobGasFeeCap())
+		assert.Equal(t, []gethcommon.Hash{kzg4844.CalcBlobHashV1(sha256.New(), &commitment)}, tx.BlobHashes())
+		require.NotNil(t, tx.BlobTxSidecar())
+		assert.Equal(t, []kzg4844.Blob{blob}, tx.BlobTxSidecar().Blobs)
+	})
+
+	t.Run("fails without blob fee", func(t *testing.T) {
+		cks, _ := newBuilder(t)
+		_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), newTx(t, validSidecar), gas.EvmFee{DynamicFee: fee.DynamicFee}, 100_000, 0x3, lggr)
+		require.ErrorContains(t, err, "estimator did not return blob fee")
+		assert.False(t, retryable)
+	})
+
+	t.Run("fails with an invalid proof", func(t *testing.T) {
+		cks, _ := newBuilder(t)
+		invalid := validSidecar
+		invalid.Proofs = [][]byte{make([]byte, len(proof))}
+		_, _, err := cks.NewCustomTxAttempt(tests.Context(t), newTx(t, invalid), fee, 100_000, 0x3, lggr)
+		require.ErrorContains(t, err, "blob 0 has an invalid proof")
+	})
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -28,12 +29,20 @@ var _ TxmClient = (*evmTxmClient)(nil)
 type evmTxmClient struct {
 	client       client.Client
 	clientErrors config.ClientErrors
+	// privateRelay is set if transactions are sent to a private relay rather than to the public mempool
+	privateRelay *privateRelay
 }
 
 func NewEvmTxmClient(c client.Client, clientErrors config.ClientErrors) *evmTxmClient {
 	return &evmTxmClient{client: c, clientErrors: clientErrors}
 }
 
+// UsePrivateRelay makes the client send the transactions of the keys handled by the relay to it, rather than to the
+// RPC nodes.
+func (c *evmTxmClient) UsePrivateRelay(relay *privateRelay) {
+	c.privateRelay = relay
+}
+
 func (c *evmTxmClient) PendingSequenceAt(ctx context.Context, addr common.Address) (evmtypes.Nonce, error) {
 	return c.PendingNonceAt(ctx, addr)
 }
@@ -58,12 +67,31 @@ func (c *evmTxmClient) BatchSendTransactions(
 	codes = make([]commonclient.SendTxReturnCode, len(attempts))
 	txErrs = make([]error, len(attempts))
 
-	reqs, broadcastTime, successfulTxIDs, batchErr := batchSendTransactions(ctx, attempts, batchSize, lggr, c.client)
+	// attempts accepted by the private relay are not broadcast to the RPC nodes
+	var privateTxIDs []int64
+	publicIdxs := make([]int, 0, len(attempts))
+	publicAttempts := make([]TxAttempt, 0, len(attempts))
+	sentPrivately := make([]bool, len(attempts))
+	if c.privateRelay != nil {
+		sentPrivately = c.privateRelay.SendBatch(ctx, attempts)
+	}
+	for i := range attempts {
+		if sentPrivately[i] {
+			codes[i] = commonclient.Successful
+			privateTxIDs = append(privateTxIDs, attempts[i].TxID)
+			continue
+		}
+		publicIdxs = append(publicIdxs, i)
+		publicAttempts = append(publicAttempts, attempts[i])
+	}
+
+	reqs, broadcastTime, successfulTxIDs, batchErr := batchSendTransactions(ctx, publicAttempts, batchSize, lggr, c.client)
+	successfulTxIDs = append(successfulTxIDs, privateTxIDs...)
 	err = errors.Join(err, batchErr) // this error does not block processing
 
 	// safety check - exits before processing
-	if len(reqs) != len(attempts) {
-		lenErr := fmt.Errorf("Returned request data length (%d) != number of tx attempts (%d)", len(reqs), len(attempts))
+	if len(reqs) != len(publicAttempts) {
+		lenErr := fmt.Errorf("Returned request data length (%d) != number of tx attempts (%d)", len(reqs), len(publicAttempts))
 		err = errors.Join(err, lenErr)
 		lggr.Criticalw("Mismatched length", "err", err)
 		return
@@ -74,8 +102,9 @@ func (c *evmTxmClient) BatchSendTransactions(
 	wg.Add(len(reqs))
 	processingErr := make([]error, len(attempts))
 	for index := range reqs {
-		go func(i int) {
+		go func(j int) {
 			defer wg.Done()
+			i := publicIdxs[j]
 
 			// convert to tx for logging purposes - exits early if error occurs
 			tx, signedErr := GetGethSignedTx(attempts[i].SignedRawTx)
@@ -85,7 +114,7 @@ func (c *evmTxmClient) BatchSendTransactions(
 				processingErr[i] = fmt.Errorf("%s: %w", signedErrMsg, signedErr)
 				return
 			}
-			sendErr := reqs[i].Error
+			sendErr := reqs[j].Error
 			codes[i] = client.ClassifySendError(sendErr, c.clientErrors, lggr, tx, attempts[i].Tx.FromAddress, c.client.IsL2())
 			txErrs[i] = sendErr
 		}(index)
@@ -101,6 +130,9 @@ func (c *evmTxmClient) SendTransactionReturnCode(ctx context.Context, etx Tx, at
 		lggr.Criticalw("Fatal error signing transaction", "err", err, "etx", etx)
 		return commonclient.Fatal, err
 	}
+	if c.privateRelay != nil && c.privateRelay.Send(ctx, etx.FromAddress, attempt) {
+		return commonclient.Successful, nil
+	}
 	return c.client.SendTransactionReturnCode(ctx, signedTx, etx.FromAddress)
 }
 
@@ -175,6 +207,11 @@ func (c *evmTxmClient) SendEmptyTransaction(
 }
 
 func (c *evmTxmClient) CallContract(ctx context.Context, a TxAttempt, blockNumber *big.Int) (rpcErr fmt.Stringer, extractErr error) {
+	var accessList types.AccessList
+	if signedTx, err := GetGethSignedTx(a.SignedRawTx); err == nil {
+		// replay the call with the access list the attempt was sent with, if any
+		accessList = signedTx.AccessList()
+	}
 	_, errCall := c.client.CallContract(ctx, ethereum.CallMsg{
 		From:       a.Tx.FromAddress,
 		To:         &a.Tx.ToAddress,
@@ -184,7 +221,7 @@ func (c *evmTxmClient) CallContract(ctx context.Context, a TxAttempt, blockNumbe
 		GasTipCap:  a.TxFee.GasTipCap.ToInt(),
 		Value:      nil,
 		Data:       a.Tx.EncodedPayload,
-		AccessList: nil,
+		AccessList: accessList,
 	}, blockNumber)
 	return client.ExtractRPCError(errCall)
 }
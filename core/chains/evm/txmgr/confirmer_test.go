@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -3000,6 +3001,123 @@ func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_ReplaceUnconfirmedTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	gconfig, config := newTestChainScopedConfig(t)
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, fromAddress)
+	etx1 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+
+	gasPriceWei := gas.EvmFee{GasPrice: assets.GWei(52)}
+	ctx := tests.Context(t)
+
+	newEthConfirmerWithAttempt := func(t *testing.T, ethClient client.Client) (*txmgr.Confirmer, func(context.Context, *txmgr.Tx) (txmgr.TxAttempt, error)) {
+		ec := newEthConfirmer(t, txStore, ethClient, gconfig, config, ethKeyStore, nil)
+		return ec, func(ctx context.Context, etx *txmgr.Tx) (txmgr.TxAttempt, error) {
+			attempt, _, err := ec.NewCustomTxAttempt(ctx, *etx, gasPriceWei, etx.FeeLimit, 0x0, logger.Test(t))
+			return attempt, err
+		}
+	}
+
+	t.Run("fails if no transaction has the nonce", func(t *testing.T) {
+		ec, newAttempt := newEthConfirmerWithAttempt(t, testutils.NewEthClientMockWithDefaultChain(t))
+		_, err := ec.ReplaceUnconfirmedTx(ctx, fromAddress, evmtypes.Nonce(5), newAttempt)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotFound)
+	})
+
+	t.Run("fails if the transaction is confirmed", func(t *testing.T) {
+		ec, newAttempt := newEthConfirmerWithAttempt(t, testutils.NewEthClientMockWithDefaultChain(t))
+		_, err := ec.ReplaceUnconfirmedTx(ctx, fromAddress, evmtypes.Nonce(0), newAttempt)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotReplaceable)
+	})
+
+	t.Run("sends and saves the new attempt of the unconfirmed transaction", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec, newAttempt := newEthConfirmerWithAttempt(t, ethClient)
+
+		head := &evmtypes.Head{Hash: utils.NewHash(), Number: 10}
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(head, nil).Once()
+		ethClient.On("LatestFinalizedBlock", mock.Anything).Return(head, nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(*etx1.Sequence) && tx.GasPrice().Int64() == gasPriceWei.GasPrice.Int64()
+		}), mock.Anything).Return(commonclient.Successful, nil).Once()
+
+		attempt, err := ec.ReplaceUnconfirmedTx(ctx, fromAddress, evmtypes.Nonce(1), newAttempt)
+		require.NoError(t, err)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx1.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, attempt.Hash, etx.TxAttempts[0].Hash)
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, etx.TxAttempts[0].State)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+	})
+
+	t.Run("saves the payload set by newAttempt along with the new attempt", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec, _ := newEthConfirmerWithAttempt(t, ethClient)
+		etx3 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+		payload := []byte{4, 5, 6}
+
+		head := &evmtypes.Head{Hash: utils.NewHash(), Number: 10}
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(head, nil).Once()
+		ethClient.On("LatestFinalizedBlock", mock.Anything).Return(head, nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(3) && bytes.Equal(tx.Data(), payload)
+		}), mock.Anything).Return(commonclient.Successful, nil).Once()
+
+		attempt, err := ec.ReplaceUnconfirmedTx(ctx, fromAddress, evmtypes.Nonce(3), func(ctx context.Context, etx *txmgr.Tx) (txmgr.TxAttempt, error) {
+			etx.EncodedPayload = payload
+			attempt, _, err := ec.NewCustomTxAttempt(ctx, *etx, gasPriceWei, etx.FeeLimit, 0x0, logger.Test(t))
+			return attempt, err
+		})
+		require.NoError(t, err)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx3.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, payload, etx.EncodedPayload)
+		assert.Equal(t, attempt.Hash, etx.TxAttempts[0].Hash)
+	})
+
+	t.Run("keeps the payload if the new attempt cannot be built", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec, _ := newEthConfirmerWithAttempt(t, ethClient)
+		etx4 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 4, fromAddress)
+
+		head := &evmtypes.Head{Hash: utils.NewHash(), Number: 10}
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(head, nil).Once()
+		ethClient.On("LatestFinalizedBlock", mock.Anything).Return(head, nil).Once()
+
+		_, err := ec.ReplaceUnconfirmedTx(ctx, fromAddress, evmtypes.Nonce(4), func(ctx context.Context, etx *txmgr.Tx) (txmgr.TxAttempt, error) {
+			etx.EncodedPayload = []byte{4, 5, 6}
+			return txmgr.TxAttempt{}, errors.New("fee below bump")
+		})
+		require.Error(t, err)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx4.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 1)
+		assert.Equal(t, etx4.EncodedPayload, etx.EncodedPayload)
+	})
+
+	t.Run("fails if the transaction has an attempt in progress", func(t *testing.T) {
+		ec, newAttempt := newEthConfirmerWithAttempt(t, testutils.NewEthClientMockWithDefaultChain(t))
+		etx2 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+		attempt := cltest.NewLegacyEthTxAttempt(t, etx2.ID)
+		require.NoError(t, txStore.InsertTxAttempt(ctx, &attempt))
+
+		_, err := ec.ReplaceUnconfirmedTx(ctx, fromAddress, evmtypes.Nonce(2), newAttempt)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotReplaceable)
+	})
+}
+
 func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 	t.Parallel()
 
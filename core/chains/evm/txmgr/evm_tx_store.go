@@ -47,7 +47,7 @@ type EvmTxStore interface {
 
 	// methods used solely in EVM components
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []Receipt, err error)
-	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) error
+	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) ([]Tx, error)
 }
 
 // TxStoreWebApi encapsulates the methods that are not used by the txmgr and only used by the various web controllers, readers, or evm specific components
@@ -199,6 +199,8 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	// Marshalled BlobSidecar of blob transactions
+	BlobSidecar *sqlutil.JSON
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -221,6 +223,7 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.BlobSidecar = tx.BlobSidecar
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -256,6 +259,7 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.BlobSidecar = db.BlobSidecar
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -288,6 +292,7 @@ type DbEthTxAttempt struct {
 	TxType                  int
 	GasTipCap               *assets.Wei
 	GasFeeCap               *assets.Wei
+	MaxFeePerBlobGas        *assets.Wei
 	IsPurgeAttempt          bool
 }
 
@@ -303,6 +308,7 @@ func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
 	db.TxType = attempt.TxType
 	db.GasTipCap = attempt.TxFee.GasTipCap
 	db.GasFeeCap = attempt.TxFee.GasFeeCap
+	db.MaxFeePerBlobGas = attempt.TxFee.BlobFeeCap
 	db.IsPurgeAttempt = attempt.IsPurgeAttempt
 
 	// handle state naming difference between generic + EVM
@@ -333,6 +339,7 @@ func (db DbEthTxAttempt) ToTxAttempt(attempt *TxAttempt) {
 	attempt.TxFee = gas.EvmFee{
 		GasPrice:   db.GasPrice,
 		DynamicFee: gas.DynamicFee{GasTipCap: db.GasTipCap, GasFeeCap: db.GasFeeCap},
+		BlobFeeCap: db.MaxFeePerBlobGas,
 	}
 	attempt.IsPurgeAttempt = db.IsPurgeAttempt
 }
@@ -358,8 +365,8 @@ func NewTxStore(
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt)
+INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, max_fee_per_blob_gas, is_purge_attempt)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :max_fee_per_blob_gas, :is_purge_attempt)
 RETURNING *;
 `
 
@@ -530,8 +537,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, blob_sidecar) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :blob_sidecar
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -1002,11 +1009,12 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 //
 // We will continue to try to fetch a receipt for these attempts until all
 // attempts are equal to or below the LatestFinalizedBlockNum from current head.
-func (o *evmTxStore) MarkAllConfirmedMissingReceipt(ctx context.Context, chainID *big.Int) (err error) {
+func (o *evmTxStore) MarkAllConfirmedMissingReceipt(ctx context.Context, chainID *big.Int) ([]Tx, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
-	res, err := o.q.ExecContext(ctx, `
+	var dbEtxs []DbEthTx
+	err := o.q.SelectContext(ctx, &dbEtxs, `
 UPDATE evm.txes
 SET state = 'confirmed_missing_receipt'
 FROM (
@@ -1019,18 +1027,15 @@ WHERE state = 'unconfirmed'
 	AND evm_chain_id = $1
 	AND nonce < max_table.max_nonce
 	AND evm.txes.from_address = max_table.from_address
+RETURNING evm.txes.*
 	`, chainID.String())
 	if err != nil {
-		return pkgerrors.Wrap(err, "markAllConfirmedMissingReceipt failed")
+		return nil, pkgerrors.Wrap(err, "markAllConfirmedMissingReceipt failed")
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return pkgerrors.Wrap(err, "markAllConfirmedMissingReceipt RowsAffected failed")
+	if len(dbEtxs) > 0 {
+		o.logger.Infow(fmt.Sprintf("%d transactions missing receipt", len(dbEtxs)), "n", len(dbEtxs))
 	}
-	if rowsAffected > 0 {
-		o.logger.Infow(fmt.Sprintf("%d transactions missing receipt", rowsAffected), "n", rowsAffected)
-	}
-	return
+	return dbEthTxsToEvmEthTxs(dbEtxs), nil
 }
 
 func (o *evmTxStore) GetInProgressTxAttempts(ctx context.Context, address common.Address, chainID *big.Int) (attempts []TxAttempt, err error) {
@@ -1091,6 +1096,29 @@ func (o *evmTxStore) UpdateTxCallbackCompleted(ctx context.Context, pipelineTask
 	return nil
 }
 
+// SaveInProgressAttemptWithPayload saves the payload and the gas limit of an unconfirmed transaction being replaced
+// together with the in_progress attempt replacing it, so that the transaction never carries a payload that no attempt
+// was built for
+func (o *evmTxStore) SaveInProgressAttemptWithPayload(ctx context.Context, etx *Tx, attempt *TxAttempt) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		res, err := orm.q.ExecContext(ctx, `UPDATE evm.txes SET encoded_payload = $1, gas_limit = $2 WHERE id = $3 AND state = 'unconfirmed'`, etx.EncodedPayload, etx.FeeLimit, etx.ID)
+		if err != nil {
+			return fmt.Errorf("failed to update transaction payload: %w", err)
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to update transaction payload: %w", err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("no unconfirmed transaction found with ID %d", etx.ID)
+		}
+		return orm.SaveInProgressAttempt(ctx, attempt)
+	})
+}
+
 func (o *evmTxStore) FindLatestSequence(ctx context.Context, fromAddress common.Address, chainId *big.Int) (nonce evmtypes.Nonce, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1479,17 +1507,17 @@ ORDER BY nonce ASC
 //
 // The job run will also be marked as errored in this case since we never got a
 // receipt and thus cannot pass on any transaction hash
-func (o *evmTxStore) MarkOldTxesMissingReceiptAsErrored(ctx context.Context, blockNum int64, latestFinalizedBlockNum int64, chainID *big.Int) error {
+func (o *evmTxStore) MarkOldTxesMissingReceiptAsErrored(ctx context.Context, blockNum int64, latestFinalizedBlockNum int64, chainID *big.Int) (erroredTxs []Tx, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	// Any 'confirmed_missing_receipt' eth_tx with all attempts equal to or older than latestFinalizedBlockNum will be marked as errored
 	// We will not try to query for receipts for this transaction anymore
 	if latestFinalizedBlockNum <= 0 {
-		return nil
+		return nil, nil
 	}
 	// note: if QOpt passes in a sql.Tx this will reuse it
-	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
 		type etx struct {
 			ID    int64
 			Nonce int64
@@ -1526,6 +1554,14 @@ RETURNING e0.id, e0.nonce`, ErrCouldNotGetReceipt, latestFinalizedBlockNum, chai
 		for i := 0; i < len(data); i++ {
 			etxIDs[i] = data[i].ID
 		}
+		if len(etxIDs) == 0 {
+			return nil
+		}
+		var dbEtxs []DbEthTx
+		if err = orm.q.SelectContext(ctx, &dbEtxs, `SELECT * FROM evm.txes WHERE id = ANY($1) ORDER BY id`, pq.Array(etxIDs)); err != nil {
+			return pkgerrors.Wrap(err, "markOldTxesMissingReceiptAsErrored failed to load errored txes")
+		}
+		erroredTxs = dbEthTxsToEvmEthTxs(dbEtxs)
 
 		type result struct {
 			ID                         int64
@@ -1565,6 +1601,7 @@ GROUP BY e.id
 
 		return nil
 	})
+	return erroredTxs, err
 }
 
 func (o *evmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAttempt TxAttempt, replacementAttempt *TxAttempt) error {
@@ -1839,6 +1876,15 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
+	var blobSidecar *sqlutil.JSON
+	if txRequest.BlobSidecar != nil {
+		b, err := json.Marshal(txRequest.BlobSidecar)
+		if err != nil {
+			return tx, pkgerrors.Wrap(err, "CreateEthTransaction failed to marshal blob sidecar")
+		}
+		sidecar := sqlutil.JSON(b)
+		blobSidecar = &sidecar
+	}
 	var dbEtx DbEthTx
 	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
 		if txRequest.PipelineTaskRunID != nil {
@@ -1853,12 +1899,12 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, blob_sidecar)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, blobSidecar)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -1987,12 +2033,16 @@ AND evm_chain_id = $3`, limit, timeThreshold, chainID.String())
 	return nil
 }
 
-func (o *evmTxStore) Abandon(ctx context.Context, chainID *big.Int, addr common.Address) error {
+func (o *evmTxStore) Abandon(ctx context.Context, chainID *big.Int, addr common.Address) ([]Tx, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
-	_, err := o.q.ExecContext(ctx, `UPDATE evm.txes SET state='fatal_error', nonce = NULL, error = 'abandoned' WHERE state IN ('unconfirmed', 'in_progress', 'unstarted') AND evm_chain_id = $1 AND from_address = $2`, chainID.String(), addr)
-	return err
+	var dbEtxs []DbEthTx
+	err := o.q.SelectContext(ctx, &dbEtxs, `UPDATE evm.txes SET state='fatal_error', nonce = NULL, error = 'abandoned' WHERE state IN ('unconfirmed', 'in_progress', 'unstarted') AND evm_chain_id = $1 AND from_address = $2 RETURNING *`, chainID.String(), addr)
+	if err != nil {
+		return nil, err
+	}
+	return dbEthTxsToEvmEthTxs(dbEtxs), nil
 }
 
 // Find transactions by a field in the TxMeta blob and transaction states
@@ -2128,9 +2178,9 @@ func (o *evmTxStore) FindConfirmedTxesReceipts(ctx context.Context, finalizedBlo
 }
 
 // Mark transactions corresponding to receipt IDs as finalized
-func (o *evmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, receiptIDs []int64, chainId *big.Int) error {
+func (o *evmTxStore) UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, receiptIDs []int64, chainId *big.Int) ([]Tx, error) {
 	if len(receiptIDs) == 0 {
-		return nil
+		return nil, nil
 	}
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -2140,7 +2190,11 @@ UPDATE evm.txes SET state = 'finalized' WHERE evm.txes.evm_chain_id = $1 AND evm
 	INNER JOIN evm.tx_attempts ON evm.tx_attempts.eth_tx_id = evm.txes.id
 	INNER JOIN evm.receipts ON evm.receipts.tx_hash = evm.tx_attempts.hash
 	WHERE evm.receipts.id = ANY($2))
+RETURNING evm.txes.*
 `
-	_, err := o.q.ExecContext(ctx, sql, chainId.String(), pq.Array(receiptIDs))
-	return err
+	var dbEtxs []DbEthTx
+	if err := o.q.SelectContext(ctx, &dbEtxs, sql, chainId.String(), pq.Array(receiptIDs)); err != nil {
+		return nil, err
+	}
+	return dbEthTxsToEvmEthTxs(dbEtxs), nil
 }
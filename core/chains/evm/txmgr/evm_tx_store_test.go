@@ -556,8 +556,11 @@ func TestORM_MarkAllConfirmedMissingReceipt(t *testing.T) {
 	assert.Equal(t, etx1.State, txmgrcommon.TxConfirmed)
 
 	// mark transaction 0 confirmed_missing_receipt
-	err := txStore.MarkAllConfirmedMissingReceipt(tests.Context(t), ethClient.ConfiguredChainID())
+	missingReceiptTxs, err := txStore.MarkAllConfirmedMissingReceipt(tests.Context(t), ethClient.ConfiguredChainID())
 	require.NoError(t, err)
+	require.Len(t, missingReceiptTxs, 1)
+	assert.Equal(t, etx0.ID, missingReceiptTxs[0].ID)
+	assert.Equal(t, txmgrcommon.TxConfirmedMissingReceipt, missingReceiptTxs[0].State)
 	etx0, err = txStore.FindTxWithAttempts(ctx, etx0.ID)
 	require.NoError(t, err)
 	assert.Equal(t, txmgrcommon.TxConfirmedMissingReceipt, etx0.State)
@@ -800,6 +803,45 @@ func TestORM_UpdateTxForRebroadcast(t *testing.T) {
 	})
 }
 
+func TestORM_SaveInProgressAttemptWithPayload(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ctx := tests.Context(t)
+	payload := []byte{1, 2, 3}
+
+	t.Run("saves the payload and the gas limit of an unconfirmed transaction with the attempt", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+		etx.EncodedPayload = payload
+		etx.FeeLimit = 50_000
+		attempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		require.NoError(t, txStore.SaveInProgressAttemptWithPayload(ctx, &etx, &attempt))
+		assert.NotZero(t, attempt.ID)
+
+		resultTx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, payload, resultTx.EncodedPayload)
+		assert.Equal(t, uint64(50_000), resultTx.FeeLimit)
+		require.Len(t, resultTx.TxAttempts, 2)
+		assert.Equal(t, txmgrtypes.TxAttemptInProgress, resultTx.TxAttempts[0].State)
+	})
+
+	t.Run("saves neither if the transaction is not unconfirmed", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 1, 1, fromAddress)
+		etx.EncodedPayload = payload
+		attempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		require.Error(t, txStore.SaveInProgressAttemptWithPayload(ctx, &etx, &attempt))
+
+		resultTx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.NotEqual(t, payload, resultTx.EncodedPayload)
+		assert.Len(t, resultTx.TxAttempts, 1)
+	})
+}
+
 func TestORM_FindTransactionsConfirmedInBlockRange(t *testing.T) {
 	t.Parallel()
 
@@ -1148,8 +1190,11 @@ func TestORM_MarkOldTxesMissingReceiptAsErrored(t *testing.T) {
 	t.Run("successfully mark errored transactions", func(t *testing.T) {
 		etx := mustInsertConfirmedMissingReceiptEthTxWithLegacyAttempt(t, txStore, 1, 7, time.Now(), fromAddress)
 
-		err := txStore.MarkOldTxesMissingReceiptAsErrored(tests.Context(t), 10, latestFinalizedBlockNum, ethClient.ConfiguredChainID())
+		erroredTxs, err := txStore.MarkOldTxesMissingReceiptAsErrored(tests.Context(t), 10, latestFinalizedBlockNum, ethClient.ConfiguredChainID())
 		require.NoError(t, err)
+		require.Len(t, erroredTxs, 1)
+		assert.Equal(t, etx.ID, erroredTxs[0].ID)
+		assert.Equal(t, txmgrcommon.TxFatalError, erroredTxs[0].State)
 
 		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
 		require.NoError(t, err)
@@ -1158,7 +1203,7 @@ func TestORM_MarkOldTxesMissingReceiptAsErrored(t *testing.T) {
 
 	t.Run("successfully mark errored transactions w/ qopt passing in sql.Tx", func(t *testing.T) {
 		etx := mustInsertConfirmedMissingReceiptEthTxWithLegacyAttempt(t, txStore, 1, 7, time.Now(), fromAddress)
-		err := txStore.MarkOldTxesMissingReceiptAsErrored(tests.Context(t), 10, latestFinalizedBlockNum, ethClient.ConfiguredChainID())
+		_, err := txStore.MarkOldTxesMissingReceiptAsErrored(tests.Context(t), 10, latestFinalizedBlockNum, ethClient.ConfiguredChainID())
 		require.NoError(t, err)
 
 		// must run other query outside of postgres transaction so changes are committed
@@ -1383,7 +1428,7 @@ func TestORM_UpdateTxUnstartedToInProgress(t *testing.T) {
 		evmTxmCfg := txmgr.NewEvmTxmConfig(ccfg.EVM())
 		ec := evmtest.NewEthClientMockWithDefaultChain(t)
 		txMgr := txmgr.NewEvmTxm(ec.ConfiguredChainID(), evmTxmCfg, ccfg.EVM().Transactions(), nil, logger.Test(t), nil, nil,
-			nil, txStore, nil, nil, nil, nil, nil)
+			nil, txStore, nil, nil, nil, nil, nil, txmgr.NewEvmTxEvents(logger.Test(t), ec.ConfiguredChainID()))
 		err := txMgr.XXXTestAbandon(fromAddress) // mark transaction as abandoned
 		require.NoError(t, err)
 
@@ -1814,6 +1859,46 @@ func TestORM_CreateTransaction(t *testing.T) {
 		assert.Equal(t, fromAddress, dbEthTx.FromAddress)
 		assert.Equal(t, true, dbEthTx.SignalCallback)
 	})
+
+	t.Run("inserts blob transactions and their attempts", func(t *testing.T) {
+		ctx := tests.Context(t)
+		testTxStore := cltest.NewTestTxStore(t, db)
+		sidecar := &txmgrtypes.BlobSidecar{
+			Blobs:       [][]byte{{1, 2, 3}},
+			Commitments: [][]byte{{4, 5, 6}},
+			Proofs:      [][]byte{{7, 8, 9}},
+		}
+		etx, err := txStore.CreateTransaction(ctx, txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			FeeLimit:       gasLimit,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			BlobSidecar:    sidecar,
+		}, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+
+		attempt := cltest.NewDynamicFeeEthTxAttempt(t, etx.ID)
+		attempt.TxType = 0x3
+		attempt.TxFee.BlobFeeCap = assets.NewWeiI(3)
+		require.NoError(t, testTxStore.InsertTxAttempt(ctx, &attempt))
+
+		etx, err = testTxStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		loaded, err := etx.GetBlobSidecar()
+		require.NoError(t, err)
+		assert.Equal(t, sidecar, loaded)
+		require.Len(t, etx.TxAttempts, 1)
+		assert.Equal(t, 0x3, etx.TxAttempts[0].TxType)
+		assert.Equal(t, assets.NewWeiI(3), etx.TxAttempts[0].TxFee.BlobFeeCap)
+		assert.Equal(t, assets.NewWeiI(1), etx.TxAttempts[0].TxFee.GasFeeCap)
+
+		t.Run("rejects blob attempts without a blob fee cap", func(t *testing.T) {
+			attempt := cltest.NewDynamicFeeEthTxAttempt(t, etx.ID)
+			attempt.TxType = 0x3
+			require.ErrorContains(t, testTxStore.InsertTxAttempt(ctx, &attempt), "chk_legacy_or_dynamic")
+		})
+	})
 }
 
 func TestORM_PruneUnstartedTxQueue(t *testing.T) {
@@ -1922,8 +2007,10 @@ func TestORM_UpdateTxesFinalized(t *testing.T) {
 		err = txStore.InsertTxAttempt(ctx, &attempt)
 		require.NoError(t, err)
 		receipt := mustInsertEthReceipt(t, txStore, 100, testutils.NewHash(), attempt.Hash)
-		err = txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, []int64{receipt.ID}, testutils.FixtureChainID)
+		finalized, err := txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, []int64{receipt.ID}, testutils.FixtureChainID)
 		require.NoError(t, err)
+		require.Len(t, finalized, 1)
+		require.Equal(t, tx.ID, finalized[0].ID)
 		etx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
 		require.NoError(t, err)
 		require.Equal(t, txmgrcommon.TxFinalized, etx.State)
@@ -25,7 +25,7 @@ const processHeadTimeout = 10 * time.Minute
 
 type finalizerTxStore interface {
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) ([]Receipt, error)
-	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, txs []int64, chainId *big.Int) error
+	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, txs []int64, chainId *big.Int) ([]Tx, error)
 }
 
 type finalizerChainClient interface {
@@ -46,6 +46,7 @@ type evmFinalizer struct {
 	txStore     finalizerTxStore
 	client      finalizerChainClient
 	headTracker finalizerHeadTracker
+	events      *TxEvents
 
 	mb     *mailbox.Mailbox[*evmtypes.Head]
 	stopCh services.StopChan
@@ -61,6 +62,7 @@ func NewEvmFinalizer(
 	txStore finalizerTxStore,
 	client finalizerChainClient,
 	headTracker finalizerHeadTracker,
+	events *TxEvents,
 ) *evmFinalizer {
 	lggr = logger.Named(lggr, "Finalizer")
 	return &evmFinalizer{
@@ -70,6 +72,7 @@ func NewEvmFinalizer(
 		txStore:      txStore,
 		client:       client,
 		headTracker:  headTracker,
+		events:       events,
 		mb:           mailbox.NewSingle[*evmtypes.Head](),
 	}
 }
@@ -206,10 +209,13 @@ func (f *evmFinalizer) processFinalizedHead(ctx context.Context, latestFinalized
 
 	receiptIDs := f.buildReceiptIdList(finalizedReceipts)
 
-	err = f.txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, receiptIDs, f.chainId)
+	finalizedTxs, err := f.txStore.UpdateTxStatesToFinalizedUsingReceiptIds(ctx, receiptIDs, f.chainId)
 	if err != nil {
 		return fmt.Errorf("failed to update transactions as finalized: %w", err)
 	}
+	for _, tx := range finalizedTxs {
+		f.events.StateChanged(tx, nil)
+	}
 	// Update lastProcessedFinalizedBlockNum after processing has completed to allow failed processing to retry on subsequent heads
 	// Does not need to be protected with mutex lock because the Finalizer only runs in a single loop
 	f.lastProcessedFinalizedBlockNum = latestFinalizedHead.BlockNumber()
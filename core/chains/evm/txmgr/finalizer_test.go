@@ -51,7 +51,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	head.Parent.Store(h99)
 
 	t.Run("returns not finalized for tx with receipt newer than finalized block", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht, nil)
 		servicetest.Run(t, finalizer)
 
 		idempotencyKey := uuid.New().String()
@@ -81,7 +81,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	})
 
 	t.Run("returns not finalized for tx with receipt re-org'd out", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht, nil)
 		servicetest.Run(t, finalizer)
 
 		idempotencyKey := uuid.New().String()
@@ -111,7 +111,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	})
 
 	t.Run("returns finalized for tx with receipt in a finalized block", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht, nil)
 		servicetest.Run(t, finalizer)
 
 		idempotencyKey := uuid.New().String()
@@ -141,7 +141,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	})
 
 	t.Run("returns finalized for tx with receipt older than block history depth", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht, nil)
 		servicetest.Run(t, finalizer)
 
 		idempotencyKey := uuid.New().String()
@@ -209,7 +209,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	})
 
 	t.Run("returns error if failed to retrieve latest head in headtracker", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht, nil)
 		servicetest.Run(t, finalizer)
 
 		ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(nil, errors.New("failed to get latest head")).Once()
@@ -218,7 +218,7 @@ func TestFinalizer_MarkTxFinalized(t *testing.T) {
 	})
 
 	t.Run("returns error if failed to calculate latest finalized head in headtracker", func(t *testing.T) {
-		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht)
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, txStore, ethClient, ht, nil)
 		servicetest.Run(t, finalizer)
 
 		ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(head, nil).Once()
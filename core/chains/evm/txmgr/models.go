@@ -43,6 +43,8 @@ type (
 	TransactionClient      = txmgrtypes.TransactionClient[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	ChainReceipt           = txmgrtypes.ChainReceipt[common.Hash, common.Hash]
 	Finalizer              = txmgrtypes.Finalizer[common.Hash, *evmtypes.Head]
+	TxEvent                = txmgr.TxEvent[*big.Int, common.Address, common.Hash]
+	TxEvents               = txmgr.TxEvents[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 )
 
 var _ KeyStore = (keystore.Eth)(nil) // check interface in txmgr to avoid circular import
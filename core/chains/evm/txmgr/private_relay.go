@@ -0,0 +1,227 @@
+package txmgr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+// privateRelayForgetAfterBlocks is how many blocks past their fallback the transactions sent to the private relay are
+// tracked for, transactions left unconfirmed for that long are considered abandoned.
+const privateRelayForgetAfterBlocks = 10_000
+
+// privateRelayMaxBlocksAhead is how far ahead of the current block the max block number of a transaction can be, relays
+// reject transactions which max block number is further ahead, e.g. Flashbots rejects more than 25 blocks.
+const privateRelayMaxBlocksAhead = 25
+
+type relayClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+type latestBlockHeightClient interface {
+	LatestBlockHeight(ctx context.Context) (*big.Int, error)
+}
+
+type messageSigner interface {
+	SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error)
+}
+
+type privateTransactionRequest struct {
+	Tx             hexutil.Bytes  `json:"tx"`
+	MaxBlockNumber hexutil.Uint64 `json:"maxBlockNumber"`
+}
+
+// privateRelay submits transactions to a private relay with eth_sendPrivateTransaction, so that they are not exposed
+// in the public mempool until they are included. Requests are signed with the signing key in the X-Flashbots-Signature
+// header, which identifies the node to the relay.
+//
+// Transactions fall back to the public mempool:
+//   - if their key does not use the relay,
+//   - if the latest block height is unknown,
+//   - if the relay fails to accept them,
+//   - once they are sent again FallbackBlocks blocks or more after they were first sent to the relay, i.e. when they
+//     are resent or bumped. The first send is tracked in memory, so the window starts over when the node restarts.
+//     The window is capped at privateRelayMaxBlocksAhead blocks, after which the relay stops trying to include them.
+type privateRelay struct {
+	relay          relayClient
+	heads          latestBlockHeightClient
+	keys           map[common.Address]struct{}
+	fallbackBlocks int64
+	lggr           logger.SugaredLogger
+
+	mu sync.Mutex
+	// firstSentAt holds the block height at which each transaction was first sent to the relay, by tx ID
+	firstSentAt map[int64]int64
+}
+
+// NewPrivateRelay returns a submission transport sending the transactions of the configured keys to the private relay.
+// The signer must hold the signing key of the relay.
+func NewPrivateRelay(cfg config.PrivateRelayConfig, heads latestBlockHeightClient, signer messageSigner, lggr logger.Logger) (*privateRelay, error) {
+	httpClient := &http.Client{Transport: &flashbotsSigningTransport{
+		signer:  signer,
+		address: cfg.SigningKey(),
+		base:    http.DefaultTransport,
+	}}
+	relay, err := rpc.DialOptions(context.Background(), cfg.URL().String(), rpc.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial private relay: %w", err)
+	}
+	return newPrivateRelay(relay, cfg.Keys(), int64(cfg.FallbackBlocks()), heads, lggr), nil
+}
+
+func newPrivateRelay(relay relayClient, keys []common.Address, fallbackBlocks int64, heads latestBlockHeightClient, lggr logger.Logger) *privateRelay {
+	p := &privateRelay{
+		relay:          relay,
+		heads:          heads,
+		keys:           map[common.Address]struct{}{},
+		fallbackBlocks: fallbackBlocks,
+		lggr:           logger.Sugared(logger.Named(lggr, "PrivateRelay")),
+		firstSentAt:    map[int64]int64{},
+	}
+	for _, k := range keys {
+		p.keys[k] = struct{}{}
+	}
+	return p
+}
+
+func (p *privateRelay) handles(from common.Address) bool {
+	if len(p.keys) == 0 {
+		return true
+	}
+	_, ok := p.keys[from]
+	return ok
+}
+
+// Send sends the attempt to the private relay. It returns false if the attempt must be broadcast publicly instead.
+func (p *privateRelay) Send(ctx context.Context, fromAddress common.Address, attempt TxAttempt) bool {
+	if !p.handles(fromAddress) {
+		return false
+	}
+	height, ok := p.latestBlockHeight(ctx)
+	if !ok {
+		return false
+	}
+	return p.send(ctx, fromAddress, attempt, height)
+}
+
+// SendBatch sends the attempts to the private relay, the latest block height is only fetched once. It returns, for
+// each attempt, false if it must be broadcast publicly instead.
+func (p *privateRelay) SendBatch(ctx context.Context, attempts []TxAttempt) []bool {
+	sent := make([]bool, len(attempts))
+	var height int64
+	var fetched, ok bool
+	for i := range attempts {
+		from := attempts[i].Tx.FromAddress
+		if !p.handles(from) {
+			continue
+		}
+		if !fetched {
+			height, ok = p.latestBlockHeight(ctx)
+			fetched = true
+		}
+		if !ok {
+			return sent
+		}
+		sent[i] = p.send(ctx, from, attempts[i], height)
+	}
+	return sent
+}
+
+func (p *privateRelay) latestBlockHeight(ctx context.Context) (int64, bool) {
+	height, err := p.heads.LatestBlockHeight(ctx)
+	if err != nil {
+		p.lggr.Warnw("Failed to get the latest block height, broadcasting transactions publicly", "err", err)
+		return 0, false
+	}
+	return height.Int64(), true
+}
+
+func (p *privateRelay) send(ctx context.Context, fromAddress common.Address, attempt TxAttempt, current int64) bool {
+	lggr := p.lggr.With("txID", attempt.TxID, "txHash", attempt.Hash, "fromAddress", fromAddress)
+
+	p.mu.Lock()
+	firstSentAt, ok := p.firstSentAt[attempt.TxID]
+	if !ok {
+		firstSentAt = current
+		p.firstSentAt[attempt.TxID] = current
+	}
+	for id, sentAt := range p.firstSentAt {
+		if current-sentAt > p.fallbackBlocks+privateRelayForgetAfterBlocks {
+			delete(p.firstSentAt, id)
+		}
+	}
+	p.mu.Unlock()
+
+	fallbackBlocks := min(p.fallbackBlocks, privateRelayMaxBlocksAhead)
+	if current-firstSentAt >= fallbackBlocks {
+		lggr.Infow("Transaction not included by the private relay in time, broadcasting it publicly", "firstSentAt", firstSentAt, "fallbackBlocks", fallbackBlocks)
+		return false
+	}
+	// at most privateRelayMaxBlocksAhead blocks ahead of the current block, as current >= firstSentAt
+	maxBlockNumber := firstSentAt + fallbackBlocks
+
+	signedTx, err := GetGethSignedTx(attempt.SignedRawTx)
+	if err != nil {
+		lggr.Errorw("Failed to decode signed transaction, broadcasting it publicly", "err", err)
+		return false
+	}
+	txBytes, err := signedTx.MarshalBinary()
+	if err != nil {
+		lggr.Errorw("Failed to encode signed transaction, broadcasting it publicly", "err", err)
+		return false
+	}
+
+	var hash common.Hash
+	err = p.relay.CallContext(ctx, &hash, "eth_sendPrivateTransaction", privateTransactionRequest{
+		Tx: txBytes,
+		// the relay stops trying to include the transaction once it is broadcast publicly
+		MaxBlockNumber: hexutil.Uint64(maxBlockNumber), //nolint:gosec // block heights are positive
+	})
+	if err != nil {
+		lggr.Warnw("Private relay failed to accept transaction, broadcasting it publicly", "err", err)
+		return false
+	}
+	lggr.Debugw("Sent transaction to private relay", "maxBlockNumber", maxBlockNumber)
+	return true
+}
+
+// flashbotsSigningTransport signs the body of the requests with the signing key, as <address>:<signature> in the
+// X-Flashbots-Signature header. The signature is an EIP-191 signature of the hex encoded keccak256 hash of the body.
+type flashbotsSigningTransport struct {
+	signer  messageSigner
+	address common.Address
+	base    http.RoundTripper
+}
+
+func (t *flashbotsSigningTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	signature, err := t.signer.SignMessage(req.Context(), t.address, []byte(hexutil.Encode(crypto.Keccak256(body))))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign private relay request: %w", err)
+	}
+	signed := req.Clone(req.Context())
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+	signed.Header.Set("X-Flashbots-Signature", t.address.Hex()+":"+hexutil.Encode(signature))
+	return t.base.RoundTrip(signed)
+}
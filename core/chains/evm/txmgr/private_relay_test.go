@@ -0,0 +1,294 @@
+package txmgr_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	clmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+type testPrivateRelayConfig struct {
+	url            *url.URL
+	signingKey     common.Address
+	keys           []common.Address
+	fallbackBlocks uint32
+}
+
+func (t testPrivateRelayConfig) Enabled() bool              { return true }
+func (t testPrivateRelayConfig) URL() *url.URL              { return t.url }
+func (t testPrivateRelayConfig) SigningKey() common.Address { return t.signingKey }
+func (t testPrivateRelayConfig) Keys() []common.Address     { return t.keys }
+func (t testPrivateRelayConfig) FallbackBlocks() uint32     { return t.fallbackBlocks }
+
+type privateTransaction struct {
+	Tx             hexutil.Bytes  `json:"tx"`
+	MaxBlockNumber hexutil.Uint64 `json:"maxBlockNumber"`
+}
+
+type testSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s testSigner) SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error) {
+	if address != crypto.PubkeyToAddress(s.key.PublicKey) {
+		return nil, errors.New("unknown key")
+	}
+	return crypto.Sign(accounts.TextHash(message), s.key)
+}
+
+// mockRelay serves eth_sendPrivateTransaction like a Flashbots-style private relay, requests must be signed in the
+// X-Flashbots-Signature header.
+type mockRelay struct {
+	mu      sync.Mutex
+	txs     []privateTransaction
+	err     error
+	signers []common.Address
+}
+
+func (r *mockRelay) SendPrivateTransaction(tx privateTransaction) (common.Hash, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return common.Hash{}, r.err
+	}
+	r.txs = append(r.txs, tx)
+	return crypto.Keccak256Hash(tx.Tx), nil
+}
+
+func (r *mockRelay) received() []privateTransaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.txs
+}
+
+func (r *mockRelay) verify(req *http.Request) error {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	address, signature, ok := strings.Cut(req.Header.Get("X-Flashbots-Signature"), ":")
+	if !ok {
+		return errors.New("missing signature")
+	}
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return err
+	}
+	pub, err := crypto.SigToPub(accounts.TextHash([]byte(hexutil.Encode(crypto.Keccak256(body)))), sig)
+	if err != nil {
+		return err
+	}
+	if signer := crypto.PubkeyToAddress(*pub); signer != common.HexToAddress(address) {
+		return fmt.Errorf("signed by %s, not %s", signer, address)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.signers = append(r.signers, common.HexToAddress(address))
+	return nil
+}
+
+func (r *mockRelay) signedBy() []common.Address {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.signers
+}
+
+func newMockRelay(t *testing.T) (*mockRelay, *url.URL) {
+	relay := &mockRelay{}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", relay))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if err := relay.verify(req); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		server.ServeHTTP(w, req)
+	}))
+	t.Cleanup(ts.Close)
+	t.Cleanup(server.Stop)
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	return relay, u
+}
+
+func newSignedAttempt(t *testing.T, txID int64, nonce uint64) (txmgr.TxAttempt, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := gethtypes.LatestSignerForChainID(testutils.FixtureChainID)
+	signedTx, err := gethtypes.SignNewTx(key, signer, &gethtypes.DynamicFeeTx{
+		ChainID:   testutils.FixtureChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       21000,
+		To:        &common.Address{},
+	})
+	require.NoError(t, err)
+	signedRawTx, err := rlp.EncodeToBytes(signedTx)
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	attempt := txmgr.TxAttempt{TxID: txID, Hash: signedTx.Hash(), SignedRawTx: signedRawTx}
+	attempt.Tx.ID = txID
+	attempt.Tx.FromAddress = from
+	return attempt, from
+}
+
+func canonicalEncoding(t *testing.T, attempt txmgr.TxAttempt) []byte {
+	signedTx, err := txmgr.GetGethSignedTx(attempt.SignedRawTx)
+	require.NoError(t, err)
+	b, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+	return b
+}
+
+func TestEvmTxmClient_PrivateRelay(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Test(t)
+	const fallbackBlocks = 3
+	signingKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	newClient := func(t *testing.T, fallbackBlocks uint32, keys ...common.Address) (txmgr.TxmClient, *mockRelay, *clmocks.Client) {
+		relay, relayURL := newMockRelay(t)
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("IsL2").Return(false).Maybe()
+		cfg := testPrivateRelayConfig{url: relayURL, signingKey: crypto.PubkeyToAddress(signingKey.PublicKey), keys: keys, fallbackBlocks: fallbackBlocks}
+		privateRelay, err := txmgr.NewPrivateRelay(cfg, ethClient, testSigner{key: signingKey}, lggr)
+		require.NoError(t, err)
+		c := txmgr.NewEvmTxmClient(ethClient, nil)
+		c.UsePrivateRelay(privateRelay)
+		return c, relay, ethClient
+	}
+
+	t.Run("sends transactions to the relay until the fallback", func(t *testing.T) {
+		c, relay, ethClient := newClient(t, fallbackBlocks)
+		attempt, from := newSignedAttempt(t, 1, 0)
+
+		ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(100), nil).Twice()
+		for range 2 {
+			code, err := c.SendTransactionReturnCode(tests.Context(t), attempt.Tx, attempt, logger.Sugared(lggr))
+			require.NoError(t, err)
+			assert.Equal(t, commonclient.Successful, code)
+		}
+		txs := relay.received()
+		require.Len(t, txs, 2)
+		assert.Equal(t, attempt.Hash, crypto.Keccak256Hash(txs[0].Tx))
+		assert.Equal(t, hexutil.Uint64(100+fallbackBlocks), txs[0].MaxBlockNumber)
+		assert.Equal(t, []common.Address{crypto.PubkeyToAddress(signingKey.PublicKey), crypto.PubkeyToAddress(signingKey.PublicKey)}, relay.signedBy())
+
+		// the transaction was not included by the relay in time, it is broadcast publicly
+		ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(100+fallbackBlocks), nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Hash() == attempt.Hash
+		}), from).Return(commonclient.Successful, nil).Once()
+		code, err := c.SendTransactionReturnCode(tests.Context(t), attempt.Tx, attempt, logger.Sugared(lggr))
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+		assert.Len(t, relay.received(), 2)
+	})
+
+	t.Run("caps the max block number to the window accepted by relays", func(t *testing.T) {
+		c, relay, ethClient := newClient(t, 100)
+		attempt, from := newSignedAttempt(t, 1, 0)
+
+		ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(100), nil).Once()
+		code, err := c.SendTransactionReturnCode(tests.Context(t), attempt.Tx, attempt, logger.Sugared(lggr))
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+		txs := relay.received()
+		require.Len(t, txs, 1)
+		assert.Equal(t, hexutil.Uint64(125), txs[0].MaxBlockNumber)
+
+		// the relay stopped trying to include the transaction, it is broadcast publicly
+		ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(125), nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, from).Return(commonclient.Successful, nil).Once()
+		code, err = c.SendTransactionReturnCode(tests.Context(t), attempt.Tx, attempt, logger.Sugared(lggr))
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+		assert.Len(t, relay.received(), 1)
+	})
+
+	t.Run("broadcasts the transactions of other keys publicly", func(t *testing.T) {
+		attempt, from := newSignedAttempt(t, 1, 0)
+		c, relay, ethClient := newClient(t, fallbackBlocks, testutils.NewAddress())
+
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, from).Return(commonclient.Successful, nil).Once()
+		code, err := c.SendTransactionReturnCode(tests.Context(t), attempt.Tx, attempt, logger.Sugared(lggr))
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+		assert.Empty(t, relay.received())
+	})
+
+	t.Run("broadcasts publicly if the relay fails", func(t *testing.T) {
+		c, relay, ethClient := newClient(t, fallbackBlocks)
+		relay.err = errors.New("relay unavailable")
+		attempt, from := newSignedAttempt(t, 1, 0)
+
+		ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(100), nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, from).Return(commonclient.Successful, nil).Once()
+		code, err := c.SendTransactionReturnCode(tests.Context(t), attempt.Tx, attempt, logger.Sugared(lggr))
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+	})
+
+	t.Run("batch sends only the transactions not accepted by the relay", func(t *testing.T) {
+		private, privateFrom := newSignedAttempt(t, 1, 0)
+		public, _ := newSignedAttempt(t, 2, 0)
+		c, relay, ethClient := newClient(t, fallbackBlocks, privateFrom)
+
+		ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(100), nil).Once()
+		ethClient.On("BatchCallContextAll", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 && b[0].Method == "eth_sendRawTransaction" && b[0].Args[0] == hexutil.Encode(canonicalEncoding(t, public))
+		})).Return(nil).Once()
+
+		codes, txErrs, _, successfulTxIDs, err := c.BatchSendTransactions(tests.Context(t), []txmgr.TxAttempt{private, public}, 10, logger.Sugared(lggr))
+		require.NoError(t, err)
+		assert.Equal(t, []commonclient.SendTxReturnCode{commonclient.Successful, commonclient.Successful}, codes)
+		assert.Equal(t, []error{nil, nil}, txErrs)
+		assert.ElementsMatch(t, []int64{1, 2}, successfulTxIDs)
+		assert.Len(t, relay.received(), 1)
+	})
+	t.Run("fetches the latest block height once per batch", func(t *testing.T) {
+		first, _ := newSignedAttempt(t, 1, 0)
+		second, _ := newSignedAttempt(t, 2, 0)
+		c, relay, ethClient := newClient(t, fallbackBlocks)
+
+		ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(100), nil).Once()
+		codes, _, _, successfulTxIDs, err := c.BatchSendTransactions(tests.Context(t), []txmgr.TxAttempt{first, second}, 10, logger.Sugared(lggr))
+		require.NoError(t, err)
+		assert.Equal(t, []commonclient.SendTxReturnCode{commonclient.Successful, commonclient.Successful}, codes)
+		assert.ElementsMatch(t, []int64{1, 2}, successfulTxIDs)
+		assert.Len(t, relay.received(), 2)
+	})
+}
@@ -59,7 +59,7 @@ type TestEvmConfig struct {
 }
 
 func (e *TestEvmConfig) Transactions() evmconfig.Transactions {
-	return &transactionsConfig{e: e, autoPurge: &autoPurgeConfig{}}
+	return &transactionsConfig{e: e, autoPurge: &autoPurgeConfig{}, accessList: &accessListConfig{}, privateRelay: &privateRelayConfig{}}
 }
 
 func (e *TestEvmConfig) NonceAutoSync() bool { return true }
@@ -159,17 +159,23 @@ func (b *TestFeeHistoryConfig) CacheTimeout() time.Duration { return 0 * time.Se
 
 type transactionsConfig struct {
 	evmconfig.Transactions
-	e         *TestEvmConfig
-	autoPurge evmconfig.AutoPurgeConfig
+	e            *TestEvmConfig
+	autoPurge    evmconfig.AutoPurgeConfig
+	accessList   evmconfig.AccessListConfig
+	privateRelay evmconfig.PrivateRelayConfig
 }
 
-func (*transactionsConfig) ForwardersEnabled() bool                { return true }
-func (t *transactionsConfig) MaxInFlight() uint32                  { return t.e.MaxInFlight }
-func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.MaxQueued }
-func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
-func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
-func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
-func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (*transactionsConfig) ForwardersEnabled() bool                  { return true }
+func (t *transactionsConfig) MaxInFlight() uint32                    { return t.e.MaxInFlight }
+func (t *transactionsConfig) MaxQueued() uint64                      { return t.e.MaxQueued }
+func (t *transactionsConfig) ReaperInterval() time.Duration          { return t.e.ReaperInterval }
+func (t *transactionsConfig) ReaperThreshold() time.Duration         { return t.e.ReaperThreshold }
+func (t *transactionsConfig) ResendAfterThreshold() time.Duration    { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig   { return t.autoPurge }
+func (t *transactionsConfig) AccessList() evmconfig.AccessListConfig { return t.accessList }
+func (t *transactionsConfig) PrivateRelay() evmconfig.PrivateRelayConfig {
+	return t.privateRelay
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -177,6 +183,18 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type accessListConfig struct {
+	evmconfig.AccessListConfig
+}
+
+func (a *accessListConfig) Enabled() bool { return false }
+
+type privateRelayConfig struct {
+	evmconfig.PrivateRelayConfig
+}
+
+func (p *privateRelayConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig          *TestEvmConfig
 	finalityDepth      uint32
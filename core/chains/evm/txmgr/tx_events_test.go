@@ -0,0 +1,101 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestTxEvents(t *testing.T) {
+	t.Parallel()
+
+	events := txmgrcommon.NewTxEvents[*big.Int, common.Address, common.Hash, common.Hash, types.Nonce, gas.EvmFee](logger.Test(t), testutils.FixtureChainID)
+	tx := txmgr.Tx{ID: 42, FromAddress: testutils.NewAddress(), ToAddress: testutils.NewAddress(), State: txmgrcommon.TxUnstarted}
+
+	t.Run("publishes state changes and fee bumps to every subscriber", func(t *testing.T) {
+		sub1, unsubscribe1 := events.Subscribe()
+		defer unsubscribe1()
+		sub2, unsubscribe2 := events.Subscribe()
+		defer unsubscribe2()
+
+		events.StateChanged(tx, nil)
+		attempt := txmgr.TxAttempt{Hash: testutils.NewHash(), TxFee: gas.EvmFee{GasPrice: assets.GWei(10)}}
+		events.FeeBumped(tx, attempt)
+
+		for _, sub := range []<-chan txmgr.TxEvent{sub1, sub2} {
+			event := <-sub
+			assert.Equal(t, txmgrcommon.TxEventStateChanged, event.Type)
+			assert.Equal(t, testutils.FixtureChainID, event.ChainID)
+			assert.Equal(t, tx.ID, event.TxID)
+			assert.Equal(t, tx.FromAddress, event.FromAddress)
+			assert.Equal(t, txmgrcommon.TxUnstarted, event.State)
+			assert.Nil(t, event.TxHash)
+
+			event = <-sub
+			assert.Equal(t, txmgrcommon.TxEventFeeBumped, event.Type)
+			require.NotNil(t, event.TxHash)
+			assert.Equal(t, attempt.Hash, *event.TxHash)
+			assert.Equal(t, attempt.TxFee.String(), event.Fee)
+		}
+	})
+
+	t.Run("drops events for slow subscribers without blocking", func(t *testing.T) {
+		sub, unsubscribe := events.Subscribe()
+		for i := 0; i < txmgrcommon.TxEventsBufferSize+1; i++ {
+			events.StateChanged(tx, nil)
+		}
+		assert.Len(t, sub, txmgrcommon.TxEventsBufferSize)
+
+		unsubscribe()
+		unsubscribe()
+		events.StateChanged(tx, nil)
+		n := 0
+		for range sub {
+			n++
+		}
+		assert.Equal(t, txmgrcommon.TxEventsBufferSize, n)
+	})
+}
+
+func TestTxEvents_Abandon(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	etx := cltest.MustInsertUnconfirmedEthTx(t, txStore, 0, fromAddress)
+
+	ccfg := evmtest.NewChainScopedConfig(t, configtest.NewGeneralConfig(t, nil))
+	ec := evmtest.NewEthClientMockWithDefaultChain(t)
+	events := txmgr.NewEvmTxEvents(logger.Test(t), ec.ConfiguredChainID())
+	txMgr := txmgr.NewEvmTxm(ec.ConfiguredChainID(), txmgr.NewEvmTxmConfig(ccfg.EVM()), ccfg.EVM().Transactions(), nil, logger.Test(t), nil, nil,
+		nil, txStore, nil, nil, nil, nil, nil, events)
+	sub, unsubscribe := txMgr.SubscribeToTxEvents()
+	defer unsubscribe()
+
+	require.NoError(t, txMgr.XXXTestAbandon(fromAddress))
+
+	require.Len(t, sub, 1)
+	event := <-sub
+	assert.Equal(t, txmgrcommon.TxEventStateChanged, event.Type)
+	assert.Equal(t, etx.ID, event.TxID)
+	assert.Equal(t, txmgrcommon.TxFatalError, event.State)
+	assert.Equal(t, "abandoned", event.Error)
+}
@@ -198,7 +198,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		client = evmclient.NewNullClient(chainID, l)
 	} else if opts.GenEthClient == nil {
 		var err error
-		client, err = evmclient.NewEvmClient(cfg.EVM().NodePool(), cfg.EVM(), cfg.EVM().NodePool().Errors(), l, chainID, nodes, cfg.EVM().ChainType())
+		client, err = evmclient.NewEvmClient(cfg.EVM().NodePool(), cfg.EVM(), cfg.EVM().NodePool().Errors(), l, chainID, nodes, cfg.EVM().ChainType(), evmclient.NewRequestUsageORM(chainID, opts.DS))
 		if err != nil {
 			return nil, err
 		}
@@ -379,6 +379,10 @@ func (c *chain) HealthReport() map[string]error {
 		services.CopyHealth(report, c.balanceMonitor.HealthReport())
 	}
 
+	for name, err := range c.client.NodeThrottling() {
+		report[fmt.Sprintf("%s.RPC.%s.RequestBudget", c.Name(), name)] = err
+	}
+
 	return report
 }
 
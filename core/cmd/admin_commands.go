@@ -95,7 +95,7 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 						},
 						cli.StringFlag{
 							Name:     "role",
-							Usage:    "Permission level of new user. Options: 'admin', 'edit', 'run', 'view'.",
+							Usage:    "Permission level of new user. Options: 'admin', 'edit', 'run', 'view', or a custom role defined in the node configuration.",
 							Required: true,
 						},
 					},
@@ -112,7 +112,7 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 						},
 						cli.StringFlag{
 							Name:     "new-role, newrole",
-							Usage:    "new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view'.",
+							Usage:    "new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view', or a custom role defined in the node configuration.",
 							Required: true,
 						},
 					},
@@ -201,6 +201,7 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("Aptos", NewAptosKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
+				initKeyRotationsSubCmd(s),
 			},
 		},
 		{
@@ -313,6 +314,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "workflows",
+			Usage:       "Commands for managing Workflows",
+			Subcommands: initWorkflowsSubCmds(s),
+		},
 		{
 			Name:  "help-all",
 			Usage: "Shows a list of all commands and sub-commands",
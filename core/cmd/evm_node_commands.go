@@ -1,9 +1,13 @@
 package cmd
 
 import (
+	"strconv"
+
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
+var evmNodeHeaders = []string{"Name", "Chain ID", "State", "Latency P50", "Latency P99", "Error Ratio", "Score", "Config"}
+
 // EVMNodePresenter implements TableRenderer for an EVMNodeResource.
 type EVMNodePresenter struct {
 	presenters.EVMNodeResource
@@ -11,14 +15,22 @@ type EVMNodePresenter struct {
 
 // ToRow presents the EVMNodeResource as a slice of strings.
 func (p *EVMNodePresenter) ToRow() []string {
-	return []string{p.Name, p.ChainID, p.State, p.Config}
+	var p50, p99, errorRatio, score string
+	if s := p.RPCStats; s != nil {
+		p50, p99 = s.LatencyP50, s.LatencyP99
+		errorRatio = strconv.FormatFloat(s.ErrorRatio, 'f', 4, 64)
+		if s.Score != nil {
+			score = *s.Score
+		}
+	}
+	return []string{p.Name, p.ChainID, p.State, p50, p99, errorRatio, score, p.Config}
 }
 
 // RenderTable implements TableRenderer
 func (p EVMNodePresenter) RenderTable(rt RendererTable) error {
 	var rows [][]string
 	rows = append(rows, p.ToRow())
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -34,7 +46,7 @@ func (ps EVMNodePresenters) RenderTable(rt RendererTable) error {
 		rows = append(rows, p.ToRow())
 	}
 
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
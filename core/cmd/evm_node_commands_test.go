@@ -78,17 +78,21 @@ func TestShell_IndexEVMNodes(t *testing.T) {
 	rt := cmd.RendererTable{b}
 	require.NoError(t, nodes.RenderTable(rt))
 	renderLines := strings.Split(b.String(), "\n")
-	assert.Equal(t, 23, len(renderLines))
+	assert.Equal(t, 31, len(renderLines))
 	assert.Contains(t, renderLines[2], "Name")
 	assert.Contains(t, renderLines[2], n1.Name)
 	assert.Contains(t, renderLines[3], "Chain ID")
 	assert.Contains(t, renderLines[3], n1.ChainID)
 	assert.Contains(t, renderLines[4], "State")
 	assert.Contains(t, renderLines[4], n1.State)
-	assert.Contains(t, renderLines[12], "Name")
-	assert.Contains(t, renderLines[12], n2.Name)
-	assert.Contains(t, renderLines[13], "Chain ID")
-	assert.Contains(t, renderLines[13], n2.ChainID)
-	assert.Contains(t, renderLines[14], "State")
-	assert.Contains(t, renderLines[14], n2.State)
+	assert.Contains(t, renderLines[5], "Latency P50")
+	assert.Contains(t, renderLines[6], "Latency P99")
+	assert.Contains(t, renderLines[7], "Error Ratio")
+	assert.Contains(t, renderLines[8], "Score")
+	assert.Contains(t, renderLines[16], "Name")
+	assert.Contains(t, renderLines[16], n2.Name)
+	assert.Contains(t, renderLines[17], "Chain ID")
+	assert.Contains(t, renderLines[17], n2.ChainID)
+	assert.Contains(t, renderLines[18], "State")
+	assert.Contains(t, renderLines[18], n2.State)
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
@@ -62,6 +63,71 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "cancel",
+				Usage:  "Cancel the unconfirmed transaction sent from --address with --nonce by replacing it with a self-send carrying no data",
+				Action: s.CancelTransaction,
+				Flags:  replaceTxFlags(),
+			},
+			{
+				Name:   "speed-up",
+				Usage:  "Send the unconfirmed transaction sent from --address with --nonce again at the given fee",
+				Action: s.SpeedUpTransaction,
+				Flags:  append(replaceTxFlags(), replaceTxFeeFlags()...),
+			},
+			{
+				Name:   "replace",
+				Usage:  "Replace the data of the unconfirmed transaction sent from --address with --nonce, and send it at the given fee",
+				Action: s.ReplaceTransaction,
+				Flags: append(append(replaceTxFlags(), replaceTxFeeFlags()...),
+					cli.StringFlag{
+						Name:  "data",
+						Usage: "hex encoded data of the replacement transaction",
+					},
+					cli.Uint64Flag{
+						Name:  "gasLimit",
+						Usage: "gas limit of the replacement transaction, defaults to the gas limit of the transaction",
+					},
+				),
+			},
+		},
+	}
+}
+
+func replaceTxFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.Int64Flag{
+			Name:  "id",
+			Usage: "chain ID",
+		},
+		cli.StringFlag{
+			Name:  "address",
+			Usage: "address of the node ETH account which sent the transaction",
+		},
+		cli.Int64Flag{
+			Name:  "nonce",
+			Usage: "nonce of the transaction",
+		},
+	}
+}
+
+func replaceTxFeeFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "gasPrice",
+			Usage: "gas price of a legacy transaction, e.g. 30 gwei",
+		},
+		cli.StringFlag{
+			Name:  "maxFeePerGas",
+			Usage: "max fee per gas of an EIP-1559 transaction, e.g. 30 gwei",
+		},
+		cli.StringFlag{
+			Name:  "maxPriorityFeePerGas",
+			Usage: "max priority fee per gas of an EIP-1559 transaction, e.g. 2 gwei",
+		},
+		cli.StringFlag{
+			Name:  "maxFeePerBlobGas",
+			Usage: "max fee per blob gas of an EIP-4844 transaction, e.g. 10 gwei",
 		},
 	}
 }
@@ -211,3 +277,73 @@ func (s *Shell) SendEther(c *cli.Context) (err error) {
 	err = s.renderAPIResponse(resp, &EthTxPresenter{})
 	return err
 }
+
+// CancelTransaction cancels the unconfirmed transaction with the given nonce.
+func (s *Shell) CancelTransaction(c *cli.Context) error {
+	return s.replaceTransaction(c, "/v2/transactions/evm/cancel")
+}
+
+// SpeedUpTransaction sends the unconfirmed transaction with the given nonce at the given fee.
+func (s *Shell) SpeedUpTransaction(c *cli.Context) error {
+	return s.replaceTransaction(c, "/v2/transactions/evm/speed_up")
+}
+
+// ReplaceTransaction replaces the data of the unconfirmed transaction with the given nonce.
+func (s *Shell) ReplaceTransaction(c *cli.Context) error {
+	return s.replaceTransaction(c, "/v2/transactions/evm/replace")
+}
+
+func (s *Shell) replaceTransaction(c *cli.Context, path string) (err error) {
+	if !c.IsSet("address") || !c.IsSet("nonce") {
+		return s.errorOut(errors.New("must pass the --address and the --nonce of the transaction"))
+	}
+	fromAddress, err := utils.ParseEthereumAddress(c.String("address"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("while parsing transaction source address: %w", err))
+	}
+
+	request := models.ReplaceEthTxRequest{
+		FromAddress: fromAddress,
+		Nonce:       c.Int64("nonce"),
+		GasLimit:    c.Uint64("gasLimit"),
+	}
+	if c.IsSet("id") {
+		request.EVMChainID = ubig.NewI(c.Int64("id"))
+	}
+	for flag, fee := range map[string]**assets.Wei{
+		"gasPrice":             &request.GasPrice,
+		"maxFeePerGas":         &request.MaxFeePerGas,
+		"maxPriorityFeePerGas": &request.MaxPriorityFeePerGas,
+		"maxFeePerBlobGas":     &request.MaxFeePerBlobGas,
+	} {
+		if !c.IsSet(flag) {
+			continue
+		}
+		*fee = new(assets.Wei)
+		if err = (*fee).UnmarshalText([]byte(c.String(flag))); err != nil {
+			return s.errorOut(fmt.Errorf("while parsing %s: %w", flag, err))
+		}
+	}
+	if c.IsSet("data") {
+		if request.Data, err = hexutil.Decode(c.String("data")); err != nil {
+			return s.errorOut(fmt.Errorf("while parsing data: %w", err))
+		}
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), path, bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &EthTxPresenter{})
+}
@@ -127,6 +127,30 @@ func TestShell_IndexTxAttempts(t *testing.T) {
 	assert.Equal(t, 0, len(renderedAttempts))
 }
 
+func TestShell_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, from)
+
+	set := flag.NewFlagSet("test cancel transaction", 0)
+	flagSetApplyFromAction(client.CancelTransaction, set, "")
+
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.CancelTransaction(c), "must pass the --address and the --nonce")
+
+	require.NoError(t, set.Set("address", from.Hex()))
+	require.NoError(t, set.Set("nonce", "0"))
+
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.CancelTransaction(c), "confirmed transactions cannot be cancelled")
+}
+
 func TestShell_SendEther_From_Txm(t *testing.T) {
 	t.Parallel()
 
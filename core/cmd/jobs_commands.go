@@ -50,6 +50,18 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:      "simulate",
+			Usage:     "Dry-run the pipeline of a job spec with stubbed tasks, without creating the job",
+			ArgsUsage: "<job TOML or filepath> <simulation TOML or filepath>",
+			Action:    s.SimulateJob,
+		},
+		{
+			Name:      "replay",
+			Usage:     "Re-execute a saved pipeline run against the HTTP and bridge traffic captured for it, without persisting the result",
+			ArgsUsage: "<pipeline run ID>",
+			Action:    s.ReplayPipelineRun,
+		},
 	}
 }
 
@@ -294,6 +306,91 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PipelineSimulationPresenter wraps the JSONAPI Pipeline Run Resource of a simulated or replayed run
+type PipelineSimulationPresenter struct {
+	presenters.PipelineRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		table.Append([]string{tr.DotID, string(tr.Type), joinStrings([]*string{tr.Output}), joinStrings([]*string{tr.Error})})
+	}
+	render("Simulated Task Runs", table)
+
+	table = rt.newTable([]string{"Outputs", "Errors"})
+	table.Append([]string{joinStrings(p.Outputs), joinStrings(p.FatalErrors)})
+	render("Simulated Run", table)
+	return nil
+}
+
+// joinStrings joins the non-nil values, one per line
+func joinStrings(values []*string) string {
+	var out []string
+	for _, v := range values {
+		if v != nil {
+			out = append(out, *v)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// SimulateJob executes the pipeline of a job spec with the tasks stubbed by a simulation
+// Valid input for both arguments is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass in the job and the simulation, as TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(0))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	simString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.SimulateJobRequest{
+		TOML:       tomlString,
+		Simulation: simString,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineSimulationPresenter{}, "Pipeline simulated")
+}
+
+// ReplayPipelineRun re-executes a saved pipeline run against its captured traffic
+func (s *Shell) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the pipeline run to replay"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/runs/"+c.Args().First()+"/replay", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineSimulationPresenter{}, "Pipeline run replayed")
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
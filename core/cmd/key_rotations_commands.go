@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeyRotationsSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "rotations",
+		Usage: "Remote commands for following the rotations of the node's OCR2 and P2P keys",
+		Subcommands: cli.Commands{
+			{
+				Name:   "list",
+				Usage:  format(`List the OCR2 and P2P key rotations, the most recent first`),
+				Action: s.ListKeyRotations,
+			},
+		},
+	}
+}
+
+type KeyRotationPresenter struct {
+	JAID // Include this to overwrite the presenter JAID so it can correctly render the ID in JSON
+	presenters.KeyRotationResource
+}
+
+var keyRotationHeaders = []string{"ID", "Type", "Old key", "New key", "State", "Successor public keys", "Jobs switched"}
+
+// RenderTable implements TableRenderer
+func (p *KeyRotationPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	if _, err := rt.Write([]byte("🔑 Key Rotations\n")); err != nil {
+		return err
+	}
+	renderList(keyRotationHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+func (p *KeyRotationPresenter) ToRow() []string {
+	var publicKeys []string
+	for _, k := range []string{p.OnchainPublicKey, p.OffChainPublicKey, p.ConfigPublicKey, p.PeerID} {
+		if k != "" {
+			publicKeys = append(publicKeys, k)
+		}
+	}
+	var switched int
+	for _, j := range p.Jobs {
+		if j.SwitchedAt != nil {
+			switched++
+		}
+	}
+	return []string{
+		p.ID,
+		p.KeyType,
+		p.OldKeyID,
+		p.NewKeyID,
+		p.State,
+		strings.Join(publicKeys, "\n"),
+		fmt.Sprintf("%d/%d", switched, len(p.Jobs)),
+	}
+}
+
+type KeyRotationPresenters []KeyRotationPresenter
+
+// RenderTable implements TableRenderer
+func (ps KeyRotationPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("🔑 Key Rotations\n")); err != nil {
+		return err
+	}
+	renderList(keyRotationHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListKeyRotations lists the key rotations
+func (s *Shell) ListKeyRotations(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/keys/rotations", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var presenters KeyRotationPresenters
+	return s.renderAPIResponse(resp, &presenters)
+}
+
+// RotateOCR2KeyBundle starts the rotation of an OCR2 key bundle
+func (s *Shell) RotateOCR2KeyBundle(c *cli.Context) error {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the ID of the key bundle to rotate"))
+	}
+	return s.rotateKey(fmt.Sprintf("/v2/keys/ocr2/rotate/%s", c.Args().Get(0)))
+}
+
+// RotateP2PKey starts the rotation of a P2P key
+func (s *Shell) RotateP2PKey(c *cli.Context) error {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the ID of the key to rotate"))
+	}
+	return s.rotateKey(fmt.Sprintf("/v2/keys/p2p/rotate/%s", c.Args().Get(0)))
+}
+
+func (s *Shell) rotateKey(path string) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), path, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var presenter KeyRotationPresenter
+	return s.renderAPIResponse(resp, &presenter, "Started key rotation, set the successor public keys in the onchain config of the jobs")
+}
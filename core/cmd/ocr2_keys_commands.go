@@ -74,6 +74,11 @@ func initOCR2KeysSubCmd(s *Shell) cli.Command {
 				},
 				Action: s.ExportOCR2Key,
 			},
+			{
+				Name:   "rotate",
+				Usage:  format(`Rotates the OCR2 key bundle matching the given ID: creates a successor key bundle, which replaces it in the jobs once their onchain config lists it`),
+				Action: s.RotateOCR2KeyBundle,
+			},
 		},
 	}
 }
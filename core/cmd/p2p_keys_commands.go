@@ -72,6 +72,11 @@ func initP2PKeysSubCmd(s *Shell) cli.Command {
 				},
 				Action: s.ExportP2PKey,
 			},
+			{
+				Name:   "rotate",
+				Usage:  format(`Rotates the P2P key matching the given ID: creates a successor key, which replaces it once the onchain config of all the jobs lists it`),
+				Action: s.RotateP2PKey,
+			},
 		},
 	}
 }
@@ -41,8 +41,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
@@ -217,6 +220,30 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 					Before: s.validateDB,
 					Flags:  []cli.Flag{},
 				},
+				{
+					Name:   "restore",
+					Usage:  "Restore the database from a backup taken by the node. WARNING: This will OVERWRITE the data of the backed up tables in the database referred to by CL_DATABASE_URL env variable or by the Database.URL field in a secrets TOML config.",
+					Action: s.RestoreDatabase,
+					Before: s.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "file, f",
+							Usage: "the backup file to restore, defaults to the latest backup in the backup directory",
+						},
+						cli.StringFlag{
+							Name:  "before",
+							Usage: "restore the latest backup taken at or before the given RFC3339 time, e.g. 2024-01-02T15:04:05Z, changes made after that backup are lost",
+						},
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the keystore password, to restore encrypted backups",
+						},
+						cli.BoolFlag{
+							Name:  "yes, y",
+							Usage: "skip the confirmation prompt",
+						},
+					},
+				},
 				{
 					Name:   "rollback",
 					Usage:  "Roll back the database to a previous <version>. Rolls back a single migration if no version specified.",
@@ -398,6 +425,9 @@ func (s *Shell) runNode(c *cli.Context) error {
 			return fmt.Errorf("error listing legacy evm chains: %w", err2)
 		}
 		for _, ch := range chainList {
+			if err2 := addRemoteSigners(rootCtx, app.GetKeyStore().Eth(), ch); err2 != nil {
+				return err2
+			}
 			if ch.Config().EVM().AutoCreateKey() {
 				lggr.Debugf("AutoCreateKey=true, will ensure EVM key for chain %s", ch.ID())
 				err2 := app.GetKeyStore().Eth().EnsureKeys(rootCtx, ch.ID())
@@ -668,6 +698,10 @@ func (s *Shell) RebroadcastTransactions(c *cli.Context) (err error) {
 		return s.errorOut(errors.Wrap(err, "error authenticating keystore"))
 	}
 
+	if err = addRemoteSigners(ctx, keyStore.Eth(), chain); err != nil {
+		return s.errorOut(err)
+	}
+
 	if err = keyStore.Eth().CheckEnabled(ctx, address, chain.ID()); err != nil {
 		return s.errorOut(err)
 	}
@@ -976,6 +1010,77 @@ func (s *Shell) RollbackDatabase(c *cli.Context) error {
 	return nil
 }
 
+// RestoreDatabase restores the database from a backup, once its schema version is checked against the one of the
+// database. The database is restored to the snapshot taken by the backup, changes made since are lost.
+func (s *Shell) RestoreDatabase(c *cli.Context) error {
+	ctx := s.ctx()
+	cfg := s.Config.Database()
+	parsed := cfg.URL()
+	if parsed.String() == "" {
+		return s.errorOut(errDBURLMissing)
+	}
+	if c.IsSet("file") && c.IsSet("before") {
+		return s.errorOut(errors.New("only one of --file or --before may be set"))
+	}
+
+	var backup periodicbackup.Backup
+	var err error
+	if path := c.String("file"); path != "" {
+		backup.Path = path
+		backup.Manifest, err = periodicbackup.ReadManifest(path)
+	} else {
+		var before time.Time
+		if v := c.String("before"); v != "" {
+			if before, err = time.Parse(time.RFC3339, v); err != nil {
+				return s.errorOut(errors.Wrap(err, "invalid --before time"))
+			}
+		}
+		var dir string
+		if dir, err = periodicbackup.Dir(s.Config.RootDir(), cfg.Backup()); err != nil {
+			return s.errorOut(err)
+		}
+		backup, err = periodicbackup.FindBackup(dir, before)
+	}
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	db, err := newConnection(ctx, cfg)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error connecting to the database"))
+	}
+	latest, err := migrate.Latest(ctx, db.DB)
+	if err != nil {
+		_ = db.Close()
+		return s.errorOut(err)
+	}
+	current, err := migrate.Current(ctx, db.DB)
+	_ = db.Close()
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if err = backup.CheckSchemaVersion(current, latest); err != nil {
+		return s.errorOut(err)
+	}
+
+	s.Logger.Infof("Restoring backup %s, taken at %s by node version %s with schema version %d", backup.Path, backup.CreatedAt.Format(time.RFC3339), backup.NodeVersion, backup.SchemaVersion)
+	if !confirmAction(c) {
+		return nil
+	}
+
+	password := s.Config.Password().Keystore()
+	if c.IsSet("password") {
+		if password, err = utils.PasswordFromFile(c.String("password")); err != nil {
+			return s.errorOut(fmt.Errorf("error reading password: %+v", err))
+		}
+	}
+	if err = periodicbackup.Restore(ctx, parsed, backup.Path, password, s.Logger); err != nil {
+		return s.errorOut(err)
+	}
+	s.Logger.Infof("Database restored to the backup taken at %s with schema version %d, it will be migrated to version %d on the next start of the node or with `chainlink node db migrate`", backup.CreatedAt.Format(time.RFC3339), backup.SchemaVersion, latest)
+	return nil
+}
+
 // VersionDatabase displays the current database version.
 func (s *Shell) VersionDatabase(_ *cli.Context) error {
 	ctx := s.ctx()
@@ -1290,3 +1395,14 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 
 	return nil
 }
+
+// addRemoteSigners registers the remote signers configured for the sending keys of the chain. This must happen before
+// ensuring keys, so that no local key is created for a chain whose sending keys are all held remotely.
+func addRemoteSigners(ctx context.Context, ks keystore.Eth, chain legacyevm.Chain) error {
+	for address, rs := range chain.Config().EVM().RemoteSigners() {
+		if err := ks.AddRemoteSigner(ctx, address, keystore.NewWeb3Signer(rs.URL(), rs.Timeout()), chain.ID()); err != nil {
+			return errors.Wrapf(err, "failed to add remote signer for key %s", address)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "executions",
+			Usage: "Commands for inspecting the history of workflow executions",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the workflow executions, most recent first",
+					Action: s.IndexWorkflowExecutions,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "workflow-id",
+							Usage: "only list the executions of this workflow",
+						},
+						cli.StringFlag{
+							Name:  "status",
+							Usage: "only list the executions with this status, options: [started, errored, timeout, completed, completed_early_exit]",
+						},
+						cli.StringFlag{
+							Name:  "after",
+							Usage: "only list the executions created at or after this RFC3339 timestamp",
+						},
+						cli.StringFlag{
+							Name:  "before",
+							Usage: "only list the executions created before this RFC3339 timestamp",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a workflow execution along with the inputs, outputs and errors of its steps",
+					Action: s.ShowWorkflowExecution,
+				},
+				{
+					Name:      "reexecute",
+					Usage:     "Start a new execution of an errored or timed out execution from a step, reusing the outputs of the steps before it",
+					ArgsUsage: "<execution ID>",
+					Action:    s.ReExecuteWorkflowExecution,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "from-step",
+							Usage:    "ref of the first step to execute again",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:      "pause",
+			Usage:     "Stop a workflow from starting executions on trigger events, its triggers remain registered",
+			ArgsUsage: "<workflow ID>",
+			Action:    s.PauseWorkflow,
+		},
+		{
+			Name:      "resume",
+			Usage:     "Resume a paused workflow",
+			ArgsUsage: "<workflow ID>",
+			Action:    s.ResumeWorkflow,
+		},
+	}
+}
+
+type WorkflowPresenter struct {
+	presenters.WorkflowResource
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Workflow ID", "Paused"})
+	table.Append([]string{p.ID, strconv.FormatBool(p.Paused)})
+	render("Workflow", table)
+	return nil
+}
+
+type WorkflowExecutionPresenter struct {
+	presenters.WorkflowExecutionResource
+}
+
+// ToRow presents the WorkflowExecutionResource as a slice of strings.
+func (p *WorkflowExecutionPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		p.WorkflowID,
+		p.Status,
+		formatOptionalTime(p.CreatedAt),
+		formatOptionalTime(p.FinishedAt),
+	}
+}
+
+var workflowExecutionHeaders = []string{"ID", "Workflow ID", "Status", "Created", "Finished"}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(workflowExecutionHeaders)
+	table.Append(p.ToRow())
+	render("Workflow Execution", table)
+
+	steps := rt.newTable([]string{"Ref", "Status", "Inputs", "Outputs", "Error", "Started", "Updated"})
+	for _, step := range p.Steps {
+		errMsg := ""
+		if step.Error != nil {
+			errMsg = *step.Error
+		}
+		steps.Append([]string{
+			step.Ref,
+			step.Status,
+			formatStepValue(step.Inputs),
+			formatStepValue(step.Outputs),
+			errMsg,
+			formatOptionalTime(step.CreatedAt),
+			formatOptionalTime(step.UpdatedAt),
+		})
+	}
+	render("Steps", steps)
+	return nil
+}
+
+type WorkflowExecutionPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowExecutionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(workflowExecutionHeaders)
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Workflow Executions", table)
+	return nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func formatStepValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+// IndexWorkflowExecutions lists the workflow executions matching the filter flags.
+func (s *Shell) IndexWorkflowExecutions(c *cli.Context) (err error) {
+	q := url.Values{}
+	for flag, param := range map[string]string{
+		"workflow-id": "workflowID",
+		"status":      "status",
+		"after":       "createdAfter",
+		"before":      "createdBefore",
+	} {
+		if v := c.String(flag); v != "" {
+			q.Set(param, v)
+		}
+	}
+	uri := url.URL{Path: "/v2/workflows/executions", RawQuery: q.Encode()}
+	return s.getPage(uri.String(), c.Int("page"), &WorkflowExecutionPresenters{})
+}
+
+// ShowWorkflowExecution shows a workflow execution along with its steps.
+func (s *Shell) ShowWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the workflow execution to be shown"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+// ReExecuteWorkflowExecution re-executes a failed workflow execution from the given step.
+func (s *Shell) ReExecuteWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the workflow execution to be re-executed"))
+	}
+	body, err := json.Marshal(web.ReExecuteRequest{FromStep: c.String("from-step")})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First())+"/reexecute", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{}, "Workflow execution re-executed")
+}
+
+// PauseWorkflow pauses a workflow.
+func (s *Shell) PauseWorkflow(c *cli.Context) (err error) {
+	return s.setWorkflowPaused(c, "pause", "Workflow paused")
+}
+
+// ResumeWorkflow resumes a paused workflow.
+func (s *Shell) ResumeWorkflow(c *cli.Context) (err error) {
+	return s.setWorkflowPaused(c, "resume", "Workflow resumed")
+}
+
+func (s *Shell) setWorkflowPaused(c *cli.Context, action string, headline string) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the workflow to " + action))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflows/"+url.PathEscape(c.Args().First())+"/"+action, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowPresenter{}, headline)
+}
@@ -0,0 +1,73 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		errMsg    = "write failed"
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.WorkflowExecutionPresenter{
+		WorkflowExecutionResource: presenters.WorkflowExecutionResource{
+			JAID:       presenters.NewJAID("execution-id"),
+			WorkflowID: "workflow-id",
+			Status:     "errored",
+			CreatedAt:  &createdAt,
+			Steps: []presenters.WorkflowExecutionStepResource{
+				{Ref: "trigger", Status: "completed", Inputs: map[string]any{"feedID": "0x01"}, CreatedAt: &createdAt},
+				{Ref: "write", Status: "errored", Error: &errMsg},
+			},
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "execution-id")
+	assert.Contains(t, output, "workflow-id")
+	assert.Contains(t, output, "2000-01-01T00:00:00Z")
+	assert.Contains(t, output, `{"feedID":"0x01"}`)
+	assert.Contains(t, output, errMsg)
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.WorkflowExecutionPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "execution-id")
+	assert.Contains(t, output, "errored")
+	assert.NotContains(t, output, errMsg)
+}
+
+func TestWorkflowPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.WorkflowPresenter{WorkflowResource: presenters.NewWorkflowResource("workflow-id", true)}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "workflow-id")
+	assert.Contains(t, output, "true")
+}
@@ -9,9 +9,12 @@ import (
 
 type Backup interface {
 	Dir() string
+	Encrypt() bool
 	Frequency() time.Duration
 	Mode() DatabaseBackupMode
 	OnVersionUpgrade() bool
+	Retention() time.Duration
+	Tables() []string
 	URL() *url.URL
 }
 
@@ -54,7 +54,8 @@ func TestDoc(t *testing.T) {
 		// clean up KeySpecific as a special case
 		require.Equal(t, 1, len(docDefaults.KeySpecific))
 		ks := evmcfg.KeySpecific{Key: new(types.EIP55Address),
-			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)},
+			RemoteSigner: evmcfg.KeySpecificRemoteSigner{URL: new(config.URL), Timeout: new(config.Duration)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
@@ -97,6 +98,10 @@ func TestDoc(t *testing.T) {
 		docDefaults.Transactions.AutoPurge.Threshold = nil
 		docDefaults.Transactions.AutoPurge.MinAttempts = nil
 
+		// Transactions.PrivateRelay.URL is only set if the feature is enabled
+		docDefaults.Transactions.PrivateRelay.URL = nil
+		docDefaults.Transactions.PrivateRelay.SigningKey = nil
+
 		// Fallback DA oracle is not set
 		docDefaults.GasEstimator.DAOracle = evmcfg.DAOracle{}
 
@@ -7,6 +7,7 @@ import (
 )
 
 type JobPipeline interface {
+	CaptureHTTPTraffic() bool
 	DefaultHTTPLimit() int64
 	DefaultHTTPTimeout() commonconfig.Duration
 	MaxRunDuration() time.Duration
@@ -3,6 +3,8 @@ package config
 import (
 	"net/url"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type TelemetryIngress interface {
@@ -13,6 +15,9 @@ type TelemetryIngress interface {
 	SendInterval() time.Duration
 	SendTimeout() time.Duration
 	UseBatchSend() bool
+	// SpoolDir is where unsent telemetry is persisted, empty when the spool is disabled
+	SpoolDir() string
+	SpoolMaxSize() utils.FileSize
 	Endpoints() []TelemetryIngressEndpoint
 }
 
@@ -434,15 +434,21 @@ func (l *DatabaseLock) setFrom(f *DatabaseLock) {
 // Note: url is stored in Secrets.DatabaseBackupURL
 type DatabaseBackup struct {
 	Dir              *string
+	Encrypt          *bool
 	Frequency        *commonconfig.Duration
 	Mode             *config.DatabaseBackupMode
 	OnVersionUpgrade *bool
+	Retention        *commonconfig.Duration
+	Tables           *[]string
 }
 
 func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.Dir; v != nil {
 		d.Dir = v
 	}
+	if v := f.Encrypt; v != nil {
+		d.Encrypt = v
+	}
 	if v := f.Frequency; v != nil {
 		d.Frequency = v
 	}
@@ -452,6 +458,12 @@ func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.OnVersionUpgrade; v != nil {
 		d.OnVersionUpgrade = v
 	}
+	if v := f.Retention; v != nil {
+		d.Retention = v
+	}
+	if v := f.Tables; v != nil {
+		d.Tables = v
+	}
 }
 
 type TelemetryIngress struct {
@@ -462,6 +474,8 @@ type TelemetryIngress struct {
 	SendInterval *commonconfig.Duration
 	SendTimeout  *commonconfig.Duration
 	UseBatchSend *bool
+	SpoolEnabled *bool
+	SpoolMaxSize *utils.FileSize
 	Endpoints    []TelemetryIngressEndpoint `toml:",omitempty"`
 }
 
@@ -494,6 +508,12 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 	if v := f.UseBatchSend; v != nil {
 		t.UseBatchSend = v
 	}
+	if v := f.SpoolEnabled; v != nil {
+		t.SpoolEnabled = v
+	}
+	if v := f.SpoolMaxSize; v != nil {
+		t.SpoolMaxSize = v
+	}
 	if v := f.Endpoints; v != nil {
 		t.Endpoints = v
 	}
@@ -614,6 +634,7 @@ type WebServer struct {
 	MFA       WebServerMFA       `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
+	Roles     []WebServerRole    `toml:",omitempty"`
 }
 
 func (w *WebServer) setFrom(f *WebServer) {
@@ -658,12 +679,17 @@ func (w *WebServer) setFrom(f *WebServer) {
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
+	if v := f.Roles; v != nil {
+		w.Roles = v
+	}
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
+	err = w.validateRoles()
+
 	// Validate LDAP fields when authentication method is LDAPAuth
 	if *w.AuthenticationMethod != string(sessions.LDAPAuth) {
-		return
+		return err
 	}
 
 	// Assert LDAP fields when AuthMethod set to LDAP
@@ -694,6 +720,45 @@ func (w *WebServer) ValidateConfig() (err error) {
 	return err
 }
 
+func (w *WebServer) validateRoles() (err error) {
+	var roles []sessions.Role
+	for i, r := range w.Roles {
+		name := fmt.Sprintf("Roles[%d]", i)
+		if r.Name == nil || *r.Name == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".Name", Msg: "must be provided and non-empty"})
+			continue
+		}
+		if r.Permissions == nil || len(*r.Permissions) == 0 {
+			err = multierr.Append(err, configutils.ErrMissing{Name: name + ".Permissions", Msg: "must grant at least one permission"})
+			continue
+		}
+		role := sessions.Role{Name: sessions.UserRole(*r.Name)}
+		for _, s := range *r.Permissions {
+			p, perr := sessions.ParsePermission(s)
+			if perr != nil {
+				err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".Permissions", Value: s, Msg: perr.Error()})
+				continue
+			}
+			role.Permissions = append(role.Permissions, p)
+		}
+		roles = append(roles, role)
+	}
+	if err != nil {
+		return err
+	}
+	if _, rerr := sessions.NewRoles(roles...); rerr != nil {
+		err = configutils.ErrInvalid{Name: "Roles", Value: len(roles), Msg: rerr.Error()}
+	}
+	return err
+}
+
+// WebServerRole is a custom role, granting the listed permissions to the users assigned to it.
+type WebServerRole struct {
+	Name        *string
+	Permissions *[]string
+	LDAPGroupCN *string
+}
+
 type WebServerMFA struct {
 	RPID     *string
 	RPOrigin *string
@@ -904,6 +969,7 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 type JobPipelineHTTPRequest struct {
 	DefaultTimeout *commonconfig.Duration
 	MaxSize        *utils.FileSize
+	CaptureTraffic *bool
 }
 
 func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
@@ -913,6 +979,9 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	if v := f.MaxSize; v != nil {
 		j.MaxSize = v
 	}
+	if v := f.CaptureTraffic; v != nil {
+		j.CaptureTraffic = v
+	}
 }
 
 type FluxMonitor struct {
@@ -55,6 +55,13 @@ type LDAP interface {
 	UpstreamSyncRateLimit() commonconfig.Duration
 }
 
+// Role is a custom role, see sessions.Permission for the format of permissions.
+type Role interface {
+	Name() string
+	Permissions() []string
+	LDAPGroupCN() string
+}
+
 type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
@@ -74,4 +81,5 @@ type WebServer interface {
 	RateLimit() RateLimit
 	MFA() MFA
 	LDAP() LDAP
+	Roles() []Role
 }
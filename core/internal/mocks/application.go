@@ -19,6 +19,8 @@ import (
 
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 
+	keyrotation "github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 
 	logger "github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -37,6 +39,8 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -45,6 +49,8 @@ import (
 
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
+	workflows "github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+
 	zapcore "go.uber.org/zap/zapcore"
 )
 
@@ -202,6 +208,53 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeHealth provides a mock function with given fields:
+func (_m *Application) BridgeHealth() *bridges.HealthTracker {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealth")
+	}
+
+	var r0 *bridges.HealthTracker
+	if rf, ok := ret.Get(0).(func() *bridges.HealthTracker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.HealthTracker)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealth'
+type Application_BridgeHealth_Call struct {
+	*mock.Call
+}
+
+// BridgeHealth is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeHealth() *Application_BridgeHealth_Call {
+	return &Application_BridgeHealth_Call{Call: _e.mock.On("BridgeHealth")}
+}
+
+func (_c *Application_BridgeHealth_Call) Run(run func()) *Application_BridgeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) Return(_a0 *bridges.HealthTracker) *Application_BridgeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) RunAndReturn(run func() *bridges.HealthTracker) *Application_BridgeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -1153,6 +1206,53 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// KeyRotator provides a mock function with given fields:
+func (_m *Application) KeyRotator() *keyrotation.Rotator {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyRotator")
+	}
+
+	var r0 *keyrotation.Rotator
+	if rf, ok := ret.Get(0).(func() *keyrotation.Rotator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*keyrotation.Rotator)
+		}
+	}
+
+	return r0
+}
+
+// Application_KeyRotator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyRotator'
+type Application_KeyRotator_Call struct {
+	*mock.Call
+}
+
+// KeyRotator is a helper method to define mock.On call
+func (_e *Application_Expecter) KeyRotator() *Application_KeyRotator_Call {
+	return &Application_KeyRotator_Call{Call: _e.mock.On("KeyRotator")}
+}
+
+func (_c *Application_KeyRotator_Call) Run(run func()) *Application_KeyRotator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_KeyRotator_Call) Return(_a0 *keyrotation.Rotator) *Application_KeyRotator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_KeyRotator_Call) RunAndReturn(run func() *keyrotation.Rotator) *Application_KeyRotator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1248,6 +1348,74 @@ func (_c *Application_ReplayFromBlock_Call) RunAndReturn(run func(*big.Int, uint
 	return _c
 }
 
+// ReplayPipelineRun provides a mock function with given fields: ctx, runID
+func (_m *Application) ReplayPipelineRun(ctx context.Context, runID int64) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayPipelineRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *pipeline.Run); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+		r2 = rf(ctx, runID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_ReplayPipelineRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayPipelineRun'
+type Application_ReplayPipelineRun_Call struct {
+	*mock.Call
+}
+
+// ReplayPipelineRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *Application_Expecter) ReplayPipelineRun(ctx interface{}, runID interface{}) *Application_ReplayPipelineRun_Call {
+	return &Application_ReplayPipelineRun_Call{Call: _e.mock.On("ReplayPipelineRun", ctx, runID)}
+}
+
+func (_c *Application_ReplayPipelineRun_Call) Run(run func(ctx context.Context, runID int64)) *Application_ReplayPipelineRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRun_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Application_ReplayPipelineRun_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRun_Call) RunAndReturn(run func(context.Context, int64) (*pipeline.Run, pipeline.TaskRunResults, error)) *Application_ReplayPipelineRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -1296,6 +1464,53 @@ func (_c *Application_ResumeJobV2_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// Roles provides a mock function with given fields:
+func (_m *Application) Roles() *sessions.Roles {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Roles")
+	}
+
+	var r0 *sessions.Roles
+	if rf, ok := ret.Get(0).(func() *sessions.Roles); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sessions.Roles)
+		}
+	}
+
+	return r0
+}
+
+// Application_Roles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Roles'
+type Application_Roles_Call struct {
+	*mock.Call
+}
+
+// Roles is a helper method to define mock.On call
+func (_e *Application_Expecter) Roles() *Application_Roles_Call {
+	return &Application_Roles_Call{Call: _e.mock.On("Roles")}
+}
+
+func (_c *Application_Roles_Call) Run(run func()) *Application_Roles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_Roles_Call) Return(_a0 *sessions.Roles) *Application_Roles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_Roles_Call) RunAndReturn(run func() *sessions.Roles) *Application_Roles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunJobV2 provides a mock function with given fields: ctx, jobID, meta
 func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error) {
 	ret := _m.Called(ctx, jobID, meta)
@@ -1506,6 +1721,75 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, sim
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, sim pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, jb, sim)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, jb, sim)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, pipeline.Simulation) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, sim)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, pipeline.Simulation) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, jb, sim)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, job.Job, pipeline.Simulation) error); ok {
+		r2 = rf(ctx, jb, sim)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb job.Job
+//   - sim pipeline.Simulation
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, jb interface{}, sim interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, jb, sim)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, jb job.Job, sim pipeline.Simulation)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Job), args[2].(pipeline.Simulation))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, job.Job, pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1676,6 +1960,100 @@ func (_c *Application_WakeSessionReaper_Call) RunAndReturn(run func()) *Applicat
 	return _c
 }
 
+// WorkflowEngines provides a mock function with given fields:
+func (_m *Application) WorkflowEngines() *workflows.EngineRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowEngines")
+	}
+
+	var r0 *workflows.EngineRegistry
+	if rf, ok := ret.Get(0).(func() *workflows.EngineRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflows.EngineRegistry)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowEngines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowEngines'
+type Application_WorkflowEngines_Call struct {
+	*mock.Call
+}
+
+// WorkflowEngines is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowEngines() *Application_WorkflowEngines_Call {
+	return &Application_WorkflowEngines_Call{Call: _e.mock.On("WorkflowEngines")}
+}
+
+func (_c *Application_WorkflowEngines_Call) Run(run func()) *Application_WorkflowEngines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowEngines_Call) Return(_a0 *workflows.EngineRegistry) *Application_WorkflowEngines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowEngines_Call) RunAndReturn(run func() *workflows.EngineRegistry) *Application_WorkflowEngines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkflowORM provides a mock function with given fields:
+func (_m *Application) WorkflowORM() store.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowORM")
+	}
+
+	var r0 store.Store
+	if rf, ok := ret.Get(0).(func() store.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Store)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowORM'
+type Application_WorkflowORM_Call struct {
+	*mock.Call
+}
+
+// WorkflowORM is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowORM() *Application_WorkflowORM_Call {
+	return &Application_WorkflowORM_Call{Call: _e.mock.On("WorkflowORM")}
+}
+
+func (_c *Application_WorkflowORM_Call) Run(run func()) *Application_WorkflowORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) Return(_a0 store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) RunAndReturn(run func() store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
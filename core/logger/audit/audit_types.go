@@ -50,8 +50,12 @@ const (
 	KeyImported EventID = "KEY_IMPORTED"
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
+	KeyRotated  EventID = "KEY_ROTATED"
 
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
+	EthTransactionSpedUp     EventID = "ETH_TRANSACTION_SPED_UP"
+	EthTransactionReplaced   EventID = "ETH_TRANSACTION_REPLACED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
@@ -51,6 +51,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/headreporter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
@@ -104,8 +105,16 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	// WorkflowORM holds the history of the workflow executions, along with the inputs and outputs of their steps.
+	WorkflowORM() workflowstore.Store
+	// WorkflowEngines holds the running workflow engines, to pause, resume and re-execute workflows.
+	WorkflowEngines() *workflows.EngineRegistry
+	// BridgeHealth returns the circuit breaker state and latency of the bridges, see bridges.ResiliencePolicy.
+	BridgeHealth() *bridges.HealthTracker
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
+	// Roles returns the built-in roles along with the custom roles defined in the configuration.
+	Roles() *sessions.Roles
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
@@ -113,9 +122,15 @@ type Application interface {
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
+	// SimulateJobV2 dry-runs the pipeline of a job which does not need to exist, see pipeline.Runner.SimulateRun.
+	SimulateJobV2(ctx context.Context, jb job.Job, sim pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error)
+	// ReplayPipelineRun re-executes a saved pipeline run against its captured http and bridge traffic, see pipeline.Runner.ReplayRun.
+	ReplayPipelineRun(ctx context.Context, runID int64) (*pipeline.Run, pipeline.TaskRunResults, error)
 
 	// Feeds
 	GetFeedsService() feeds.Service
+	// KeyRotator rotates the OCR2 key bundles and the P2P keys, see keyrotation.Rotator.
+	KeyRotator() *keyrotation.Rotator
 
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast is
 	// set to true, consumers will reprocess data even if it has already been processed.
@@ -142,10 +157,14 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	workflowORM              workflowstore.Store
+	workflowEngines          *workflows.EngineRegistry
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
+	roles                    *sessions.Roles
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
+	keyRotator               *keyrotation.Rotator
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
 	KeyStore                 keystore.Master
@@ -333,7 +352,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	if backupCfg.Mode() != config.DatabaseBackupModeNone && backupCfg.Frequency() > 0 {
 		globalLogger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", backupCfg.Frequency())
 
-		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, globalLogger)
+		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, cfg.Password().Keystore(), globalLogger)
 		if err != nil {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize database backup")
 		}
@@ -361,9 +380,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	srvcs = append(srvcs, mailMon)
 	srvcs = append(srvcs, relayerChainInterops.Services()...)
 
+	roles, err := newRoles(cfg.WebServer())
+	if err != nil {
+		return nil, errors.Wrap(err, "NewApplication: failed to initialize custom roles")
+	}
+
 	// Initialize Local Users ORM and Authentication Provider specified in config
 	// BasicAdminUsersORM is initialized and required regardless of separate Authentication Provider
-	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
+	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger, roles)
 
 	// Initialize Sessions ORM based on environment configured authenticator
 	// localDB auth or remote LDAP auth
@@ -375,30 +399,31 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	case sessions.LDAPAuth:
 		var err error
 		authenticationProvider, err = ldapauth.NewLDAPAuthenticator(
-			opts.DS, cfg.WebServer().LDAP(), cfg.Insecure().DevWebServer(), globalLogger, auditLogger,
+			opts.DS, cfg.WebServer().LDAP(), roles, cfg.Insecure().DevWebServer(), globalLogger, auditLogger,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize LDAP Authentication module")
 		}
-		syncer := ldapauth.NewLDAPServerStateSyncer(opts.DS, cfg.WebServer().LDAP(), globalLogger)
+		syncer := ldapauth.NewLDAPServerStateSyncer(opts.DS, cfg.WebServer().LDAP(), roles, globalLogger)
 		srvcs = append(srvcs, syncer)
 		sessionReaper = utils.NewSleeperTaskCtx(syncer)
 	case sessions.LocalAuth:
-		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
+		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger, roles)
 		sessionReaper = localauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	default:
 		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
 	}
 
 	var (
-		pipelineORM    = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
-		bridgeORM      = bridges.NewORM(opts.DS)
-		mercuryORM     = mercury.NewORM(opts.DS)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
-		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
-		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
-		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
-		workflowORM    = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+		pipelineORM     = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
+		bridgeORM       = bridges.NewORM(opts.DS)
+		mercuryORM      = mercury.NewORM(opts.DS)
+		pipelineRunner  = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		jobORM          = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
+		txmORM          = txmgr.NewTxStore(opts.DS, globalLogger)
+		streamRegistry  = streams.NewRegistry(globalLogger, pipelineRunner)
+		workflowORM     = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+		workflowEngines = workflows.NewEngineRegistry()
 	)
 	srvcs = append(srvcs, workflowORM)
 
@@ -462,6 +487,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				keyStore.Eth()),
 			job.Gateway: gateway.NewDelegate(
 				legacyEVMChains,
+				relayerChainInterops,
 				keyStore.Eth(),
 				opts.DS,
 				globalLogger),
@@ -480,6 +506,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		opts.CapabilitiesRegistry,
 		workflowRegistrySyncer,
 		workflowORM,
+		workflowEngines,
 	)
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
@@ -635,6 +662,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		feedsService = &feeds.NullService{}
 	}
 
+	var rotatorPeer keyrotation.Peer
+	if peerWrapper != nil {
+		rotatorPeer = peerWrapper
+	}
+	keyRotator := keyrotation.NewRotator(keyrotation.NewORM(opts.DS), keyStore, jobSpawner, feedsService, rotatorPeer, cfg.Password().Keystore(), globalLogger)
+	srvcs = append(srvcs, keyRotator)
+
 	for _, s := range srvcs {
 		if s == nil {
 			panic("service unexpectedly nil")
@@ -651,10 +685,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		workflowORM:              workflowORM,
+		workflowEngines:          workflowEngines,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
+		roles:                    roles,
 		txmStorageService:        txmORM,
 		FeedsService:             feedsService,
+		keyRotator:               keyRotator,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
 		KeyStore:                 keyStore,
@@ -831,6 +869,10 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) BridgeHealth() *bridges.HealthTracker {
+	return app.pipelineRunner.BridgeHealth()
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -839,6 +881,27 @@ func (app *ChainlinkApplication) AuthenticationProvider() sessions.Authenticatio
 	return app.authenticationProvider
 }
 
+func (app *ChainlinkApplication) Roles() *sessions.Roles {
+	return app.roles
+}
+
+// newRoles validates the custom roles of the configuration.
+func newRoles(cfg config.WebServer) (*sessions.Roles, error) {
+	var custom []sessions.Role
+	for _, r := range cfg.Roles() {
+		role := sessions.Role{Name: sessions.UserRole(r.Name()), LDAPGroupCN: r.LDAPGroupCN()}
+		for _, s := range r.Permissions() {
+			p, err := sessions.ParsePermission(s)
+			if err != nil {
+				return nil, errors.Wrapf(err, "role %s", r.Name())
+			}
+			role.Permissions = append(role.Permissions, p)
+		}
+		custom = append(custom, role)
+	}
+	return sessions.NewRoles(custom...)
+}
+
 // TODO BCF-2516 remove this all together remove EVM specifics
 func (app *ChainlinkApplication) EVMORM() evmtypes.Configs {
 	return app.GetRelayers().LegacyEVMChains().ChainNodeConfigs()
@@ -848,6 +911,14 @@ func (app *ChainlinkApplication) PipelineORM() pipeline.ORM {
 	return app.pipelineORM
 }
 
+func (app *ChainlinkApplication) WorkflowORM() workflowstore.Store {
+	return app.workflowORM
+}
+
+func (app *ChainlinkApplication) WorkflowEngines() *workflows.EngineRegistry {
+	return app.workflowEngines
+}
+
 func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
@@ -952,6 +1023,42 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	sim pipeline.Simulation,
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	if jb.Pipeline.Source == "" {
+		return nil, nil, errors.Errorf("%v jobs do not have a pipeline to simulate", jb.Type)
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return app.pipelineRunner.SimulateRun(ctx, spec, sim)
+}
+
+func (app *ChainlinkApplication) ReplayPipelineRun(
+	ctx context.Context,
+	runID int64,
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	run, err := app.pipelineORM.FindRun(ctx, runID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "pipeline run ID %v", runID)
+	}
+	captures, err := app.pipelineORM.FindHTTPCaptures(ctx, runID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return app.pipelineRunner.ReplayRun(ctx, run, captures)
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -964,6 +1071,10 @@ func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+func (app *ChainlinkApplication) KeyRotator() *keyrotation.Rotator {
+	return app.keyRotator
+}
+
 // ReplayFromBlock implements the Application interface.
 func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
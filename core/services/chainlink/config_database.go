@@ -18,6 +18,10 @@ func (b *backupConfig) Dir() string {
 	return *b.c.Dir
 }
 
+func (b *backupConfig) Encrypt() bool {
+	return *b.c.Encrypt
+}
+
 func (b *backupConfig) Frequency() time.Duration {
 	return b.c.Frequency.Duration()
 }
@@ -30,6 +34,17 @@ func (b *backupConfig) OnVersionUpgrade() bool {
 	return *b.c.OnVersionUpgrade
 }
 
+func (b *backupConfig) Retention() time.Duration {
+	return b.c.Retention.Duration()
+}
+
+func (b *backupConfig) Tables() []string {
+	if b.c.Tables == nil {
+		return nil
+	}
+	return *b.c.Tables
+}
+
 func (b *backupConfig) URL() *url.URL {
 	return b.s.BackupURL.URL()
 }
@@ -489,7 +489,8 @@ func (g *generalConfig) RootDir() string {
 
 func (g *generalConfig) TelemetryIngress() coreconfig.TelemetryIngress {
 	return &telemetryIngressConfig{
-		c: g.c.TelemetryIngress,
+		c:       g.c.TelemetryIngress,
+		rootDir: g.RootDir(),
 	}
 }
 
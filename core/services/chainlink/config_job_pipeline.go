@@ -14,6 +14,10 @@ type jobPipelineConfig struct {
 	c toml.JobPipeline
 }
 
+func (j *jobPipelineConfig) CaptureHTTPTraffic() bool {
+	return *j.c.HTTPRequest.CaptureTraffic
+}
+
 func (j *jobPipelineConfig) DefaultHTTPLimit() int64 {
 	return int64(*j.c.HTTPRequest.MaxSize)
 }
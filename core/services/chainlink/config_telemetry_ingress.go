@@ -2,16 +2,19 @@ package chainlink
 
 import (
 	"net/url"
+	"path/filepath"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ config.TelemetryIngress = (*telemetryIngressConfig)(nil)
 
 type telemetryIngressConfig struct {
-	c toml.TelemetryIngress
+	c       toml.TelemetryIngress
+	rootDir string
 }
 
 type telemetryIngressEndpointConfig struct {
@@ -46,6 +49,17 @@ func (t *telemetryIngressConfig) UseBatchSend() bool {
 	return *t.c.UseBatchSend
 }
 
+func (t *telemetryIngressConfig) SpoolDir() string {
+	if !*t.c.SpoolEnabled {
+		return ""
+	}
+	return filepath.Join(t.rootDir, "telemetry_spool")
+}
+
+func (t *telemetryIngressConfig) SpoolMaxSize() utils.FileSize {
+	return *t.c.SpoolMaxSize
+}
+
 func (t *telemetryIngressConfig) Endpoints() []config.TelemetryIngressEndpoint {
 	var endpoints []config.TelemetryIngressEndpoint
 	for _, e := range t.c.Endpoints {
@@ -1,11 +1,14 @@
 package chainlink
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestTelemetryIngressConfig(t *testing.T) {
@@ -23,6 +26,8 @@ func TestTelemetryIngressConfig(t *testing.T) {
 	assert.Equal(t, time.Minute, ticfg.SendInterval())
 	assert.Equal(t, 5*time.Second, ticfg.SendTimeout())
 	assert.True(t, ticfg.UseBatchSend())
+	assert.Equal(t, filepath.Join(cfg.RootDir(), "telemetry_spool"), ticfg.SpoolDir())
+	assert.Equal(t, utils.FileSize(10*utils.MB), ticfg.SpoolMaxSize())
 
 	tec := cfg.TelemetryIngress().Endpoints()
 
@@ -326,9 +326,12 @@ func TestConfig_Marshal(t *testing.T) {
 		},
 		Backup: toml.DatabaseBackup{
 			Dir:              ptr("test/backup/dir"),
+			Encrypt:          ptr(true),
 			Frequency:        &hour,
 			Mode:             &legacy.DatabaseBackupModeFull,
 			OnVersionUpgrade: ptr(true),
+			Retention:        commoncfg.MustNewDuration(24 * time.Hour),
+			Tables:           &[]string{"encrypted_key_rings", "jobs", "evm.txes"},
 		},
 	}
 	full.TelemetryIngress = toml.TelemetryIngress{
@@ -339,6 +342,8 @@ func TestConfig_Marshal(t *testing.T) {
 		SendInterval: commoncfg.MustNewDuration(time.Minute),
 		SendTimeout:  commoncfg.MustNewDuration(5 * time.Second),
 		UseBatchSend: ptr(true),
+		SpoolEnabled: ptr(true),
+		SpoolMaxSize: ptr[utils.FileSize](10 * utils.MB),
 		Endpoints: []toml.TelemetryIngressEndpoint{{
 			Network:      ptr("EVM"),
 			ChainID:      ptr("1"),
@@ -408,6 +413,11 @@ func TestConfig_Marshal(t *testing.T) {
 			ForceRedirect: ptr(true),
 			ListenIP:      mustIP("192.158.1.38"),
 		},
+		Roles: []toml.WebServerRole{{
+			Name:        ptr("bridge-manager"),
+			Permissions: &[]string{"*:view", "bridges:edit"},
+			LDAPGroupCN: ptr("NodeBridgeManagers"),
+		}},
 	}
 	full.JobPipeline = toml.JobPipeline{
 		ExternalInitiatorsEnabled: ptr(true),
@@ -420,6 +430,7 @@ func TestConfig_Marshal(t *testing.T) {
 		HTTPRequest: toml.JobPipelineHTTPRequest{
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
+			CaptureTraffic: ptr(true),
 		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
@@ -629,6 +640,10 @@ func TestConfig_Marshal(t *testing.T) {
 						GasEstimator: evmcfg.KeySpecificGasEstimator{
 							PriceMax: assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFFFFFFFFFFFFFF")),
 						},
+						RemoteSigner: evmcfg.KeySpecificRemoteSigner{
+							URL:     mustURL("http://signer.test:9000"),
+							Timeout: commoncfg.MustNewDuration(5 * time.Second),
+						},
 					},
 				},
 
@@ -658,6 +673,17 @@ func TestConfig_Marshal(t *testing.T) {
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					AccessList: evmcfg.AccessListConfig{
+						Enabled:  ptr(true),
+						CacheTTL: &minute,
+					},
+					PrivateRelay: evmcfg.PrivateRelayConfig{
+						Enabled:        ptr(true),
+						URL:            mustURL("https://relay.example.com"),
+						SigningKey:     ptr(types.MustEIP55Address("0x0A1bE5C4B8fC9b1e9f4E7aB6B5d1Fc0a5B9e3c41")),
+						Keys:           []types.EIP55Address{types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")},
+						FallbackBlocks: ptr[uint32](25),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -697,6 +723,24 @@ func TestConfig_Marshal(t *testing.T) {
 						ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 						TooManyResults:                    ptr[string]("(: |^)too many results"),
 					},
+					LatencyWeighted: evmcfg.LatencyWeighted{
+						LatencyP50Weight: mustDecimal("2"),
+						LatencyP99Weight: mustDecimal("0.25"),
+						ErrorRatePenalty: commoncfg.MustNewDuration(10 * time.Second),
+						Hysteresis:       mustDecimal("0.1"),
+					},
+					ReadQuorum: evmcfg.ReadQuorum{
+						Enabled:           ptr(true),
+						Methods:           []string{"CallContract", "HeaderByNumber"},
+						Nodes:             ptr[uint32](5),
+						Agreement:         ptr[uint32](3),
+						DemotionThreshold: ptr[uint32](7),
+						DemotionCooldown:  commoncfg.MustNewDuration(10 * time.Minute),
+					},
+					RequestBudget: evmcfg.RequestBudget{
+						NearExhaustionThreshold: mustDecimal("0.8"),
+						MethodWeights:           map[string]uint32{"eth_getLogs": 75},
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -717,9 +761,12 @@ func TestConfig_Marshal(t *testing.T) {
 			},
 			Nodes: []*evmcfg.Node{
 				{
-					Name:    ptr("foo"),
-					HTTPURL: mustURL("https://foo.web"),
-					WSURL:   mustURL("wss://web.socket/test/foo"),
+					Name:          ptr("foo"),
+					HTTPURL:       mustURL("https://foo.web"),
+					WSURL:         mustURL("wss://web.socket/test/foo"),
+					RateLimit:     ptr[uint32](100),
+					DailyBudget:   ptr[uint64](1000000),
+					MonthlyBudget: ptr[uint64](25000000),
 				},
 				{
 					Name:    ptr("bar"),
@@ -894,9 +941,12 @@ MigrateOnStartup = true
 
 [Database.Backup]
 Dir = 'test/backup/dir'
+Encrypt = true
 Frequency = '1h0m0s'
 Mode = 'full'
 OnVersionUpgrade = true
+Retention = '24h0m0s'
+Tables = ['encrypted_key_rings', 'jobs', 'evm.txes']
 
 [Database.Listener]
 MaxReconnectDuration = '1m0s'
@@ -916,6 +966,8 @@ MaxBatchSize = 4321
 SendInterval = '1m0s'
 SendTimeout = '5s'
 UseBatchSend = true
+SpoolEnabled = true
+SpoolMaxSize = '10.00mb'
 
 [[TelemetryIngress.Endpoints]]
 Network = 'EVM'
@@ -985,6 +1037,11 @@ Host = 'tls-host'
 HTTPSPort = 6789
 KeyPath = 'tls/key/path'
 ListenIP = '192.158.1.38'
+
+[[WebServer.Roles]]
+Name = 'bridge-manager'
+Permissions = ['*:view', 'bridges:edit']
+LDAPGroupCN = 'NodeBridgeManagers'
 `},
 		{"FluxMonitor", Config{Core: toml.Core{FluxMonitor: full.FluxMonitor}}, `[FluxMonitor]
 DefaultTransactionQueueDepth = 100
@@ -1002,6 +1059,7 @@ VerboseLogging = false
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+CaptureTraffic = true
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -1122,6 +1180,17 @@ ResendAfterThreshold = '1h0m0s'
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.AccessList]
+Enabled = true
+CacheTTL = '1m0s'
+
+[EVM.Transactions.PrivateRelay]
+Enabled = true
+URL = 'https://relay.example.com'
+SigningKey = '0x0A1bE5C4B8fC9b1e9f4E7aB6B5d1Fc0a5B9e3c41'
+Keys = ['0x2a3e23c6f242F5345320814aC8a1b4E58707D292']
+FallbackBlocks = 25
+
 [EVM.BalanceMonitor]
 Enabled = true
 
@@ -1177,6 +1246,10 @@ Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
 [EVM.KeySpecific.GasEstimator]
 PriceMax = '79.228162514264337593543950335 gether'
 
+[EVM.KeySpecific.RemoteSigner]
+URL = 'http://signer.test:9000'
+Timeout = '5s'
+
 [EVM.NodePool]
 PollFailureThreshold = 5
 PollInterval = '1m0s'
@@ -1206,6 +1279,26 @@ Fatal = '(: |^)fatal'
 ServiceUnavailable = '(: |^)service unavailable'
 TooManyResults = '(: |^)too many results'
 
+[EVM.NodePool.LatencyWeighted]
+LatencyP50Weight = '2'
+LatencyP99Weight = '0.25'
+ErrorRatePenalty = '10s'
+Hysteresis = '0.1'
+
+[EVM.NodePool.ReadQuorum]
+Enabled = true
+Methods = ['CallContract', 'HeaderByNumber']
+Nodes = 5
+Agreement = 3
+DemotionThreshold = 7
+DemotionCooldown = '10m0s'
+
+[EVM.NodePool.RequestBudget]
+NearExhaustionThreshold = '0.8'
+
+[EVM.NodePool.RequestBudget.MethodWeights]
+eth_getLogs = 75
+
 [EVM.OCR]
 ContractConfirmations = 11
 ContractTransmitterTransmitTimeout = '1m0s'
@@ -1225,6 +1318,9 @@ GasLimitDefault = 400000
 Name = 'foo'
 WSURL = 'wss://web.socket/test/foo'
 HTTPURL = 'https://foo.web'
+RateLimit = 100
+DailyBudget = 1000000
+MonthlyBudget = 25000000
 
 [[EVM.Nodes]]
 Name = 'bar'
@@ -1394,6 +1490,15 @@ func TestConfig_full(t *testing.T) {
 			if got.EVM[c].Nodes[n].Order == nil {
 				got.EVM[c].Nodes[n].Order = ptr(int32(100))
 			}
+			if got.EVM[c].Nodes[n].RateLimit == nil {
+				got.EVM[c].Nodes[n].RateLimit = ptr(uint32(0))
+			}
+			if got.EVM[c].Nodes[n].DailyBudget == nil {
+				got.EVM[c].Nodes[n].DailyBudget = ptr(uint64(0))
+			}
+			if got.EVM[c].Nodes[n].MonthlyBudget == nil {
+				got.EVM[c].Nodes[n].MonthlyBudget = ptr(uint64(0))
+			}
 		}
 		if got.EVM[c].Transactions.AutoPurge.Threshold == nil {
 			got.EVM[c].Transactions.AutoPurge.Threshold = ptr(uint32(0))
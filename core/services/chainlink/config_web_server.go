@@ -96,6 +96,25 @@ func (m *mfaConfig) RPOrigin() string {
 	return *m.c.RPOrigin
 }
 
+type roleConfig struct {
+	c toml.WebServerRole
+}
+
+func (r *roleConfig) Name() string {
+	return *r.c.Name
+}
+
+func (r *roleConfig) Permissions() []string {
+	return *r.c.Permissions
+}
+
+func (r *roleConfig) LDAPGroupCN() string {
+	if r.c.LDAPGroupCN == nil {
+		return ""
+	}
+	return *r.c.LDAPGroupCN
+}
+
 type webServerConfig struct {
 	c       toml.WebServer
 	s       toml.WebServerSecrets
@@ -118,6 +137,14 @@ func (w *webServerConfig) LDAP() config.LDAP {
 	return &ldapConfig{c: w.c.LDAP, s: w.s.LDAP}
 }
 
+func (w *webServerConfig) Roles() []config.Role {
+	var roles []config.Role
+	for _, r := range w.c.Roles {
+		roles = append(roles, &roleConfig{c: r})
+	}
+	return roles
+}
+
 func (w *webServerConfig) AuthenticationMethod() string {
 	return *w.c.AuthenticationMethod
 }
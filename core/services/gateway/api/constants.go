@@ -10,6 +10,8 @@ const (
 	RequestTimeoutError
 	NodeReponseEncodingError
 	FatalError
+	UnauthorizedError
+	QuotaExceededError
 )
 
 func (e ErrorCode) String() string {
@@ -28,6 +30,10 @@ func (e ErrorCode) String() string {
 		return "NodeReponseEncodingError"
 	case FatalError:
 		return "FatalError"
+	case UnauthorizedError:
+		return "UnauthorizedError"
+	case QuotaExceededError:
+		return "QuotaExceededError"
 	default:
 		return "UnknownError"
 	}
@@ -43,6 +49,8 @@ func ToJsonRPCErrorCode(errorCode ErrorCode) int {
 		RequestTimeoutError:      -32000, // Server Error
 		NodeReponseEncodingError: -32603, // Internal Error
 		FatalError:               -32000, // Server Error
+		UnauthorizedError:        -32001, // Server Error
+		QuotaExceededError:       -32005, // Server Error (limit exceeded)
 	}
 
 	code, ok := gatewayErrorToJsonRPCError[errorCode]
@@ -62,6 +70,8 @@ func ToHttpErrorCode(errorCode ErrorCode) int {
 		RequestTimeoutError:      504, // Gateway Timeout
 		NodeReponseEncodingError: 500, // Internal Server Error
 		FatalError:               500, // Internal Server Error
+		UnauthorizedError:        401, // Unauthorized
+		QuotaExceededError:       429, // Too Many Requests
 	}
 
 	code, ok := gatewayErrorToHttpError[errorCode]
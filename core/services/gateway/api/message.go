@@ -54,6 +54,23 @@ func (m *Message) Validate() error {
 	if len(m.Signature) != MessageSignatureHexEncodedLen {
 		return errors.New("invalid hex-encoded signature length")
 	}
+	if err := m.ValidateBody(); err != nil {
+		return err
+	}
+	signerBytes, err := m.ExtractSigner()
+	if err != nil {
+		return err
+	}
+	m.Body.Sender = utils.StringToHex(string(signerBytes))
+	return nil
+}
+
+// ValidateBody validates all fields except the signature, for messages authenticated by other means.
+// Sender is not set.
+func (m *Message) ValidateBody() error {
+	if m == nil {
+		return errors.New("nil message")
+	}
 	if len(m.Body.MessageId) == 0 || len(m.Body.MessageId) > MessageIdMaxLen {
 		return errors.New("invalid message ID length")
 	}
@@ -75,11 +92,6 @@ func (m *Message) Validate() error {
 	if len(m.Body.Receiver) != 0 && len(m.Body.Receiver) != MessageReceiverLen {
 		return errors.New("invalid Receiver length")
 	}
-	signerBytes, err := m.ExtractSigner()
-	if err != nil {
-		return err
-	}
-	m.Body.Sender = utils.StringToHex(string(signerBytes))
 	return nil
 }
 
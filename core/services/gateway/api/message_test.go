@@ -69,6 +69,25 @@ func TestMessage_Validate(t *testing.T) {
 	require.Error(t, msg.Validate())
 }
 
+func TestMessage_ValidateBody(t *testing.T) {
+	msg := &api.Message{
+		Body: api.MessageBody{
+			MessageId: "abcd",
+			Method:    "request",
+			DonId:     "donA",
+			Payload:   []byte("datadata"),
+		},
+	}
+
+	// unsigned
+	require.NoError(t, msg.ValidateBody())
+	require.Error(t, msg.Validate())
+	require.Empty(t, msg.Body.Sender)
+
+	msg.Body.MessageId = ""
+	require.Error(t, msg.ValidateBody())
+}
+
 func TestMessage_MessageSignAndValidateSignature(t *testing.T) {
 	t.Parallel()
 
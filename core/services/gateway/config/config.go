@@ -13,6 +13,53 @@ type GatewayConfig struct {
 	// HTTPClientConfig is configuration for outbound HTTP calls to external endpoints
 	HTTPClientConfig gw_net.HTTPClientConfig
 	Dons             []DONConfig
+	// SharedStateConfig configures where pending requests and node connections are tracked
+	SharedStateConfig SharedStateConfig
+	// CapabilitiesRegistry is optional. When set, Members and F of DONs with a non-zero
+	// RegistryDonId are derived from the onchain capabilities registry and kept up to date.
+	CapabilitiesRegistry *CapabilitiesRegistryConfig
+	// Quotas limit requests of each sender over a rolling window. Usage is counted in the
+	// SharedStateConfig backend, so it persists across restarts with the postgres backend.
+	Quotas QuotaConfig
+	// APIKeys authenticate clients of the user server through the X-Api-Key header
+	APIKeys APIKeysConfig
+}
+
+type QuotaConfig struct {
+	// WindowSec is the length of the rolling window, a day when zero
+	WindowSec uint32
+	// PerSender limits all requests of a sender
+	PerSender QuotaLimits
+	// PerMethod additionally limits requests of a sender to a method, keyed by method name
+	PerMethod map[string]QuotaLimits
+}
+
+// QuotaLimits are counted over the rolling window, zero disables a limit.
+type QuotaLimits struct {
+	MaxRequests     uint64
+	MaxPayloadBytes uint64
+}
+
+type APIKeysConfig struct {
+	// Required rejects requests without a valid API key
+	Required bool
+	Keys     []APIKeyConfig
+}
+
+type APIKeyConfig struct {
+	Name string
+	// KeyHash is the hex-encoded SHA-256 hash of the key
+	KeyHash string
+	// Sender is the address unsigned requests authenticated with the key are signed with, so that
+	// nodes accept them. It has to be an Eth key in the keystore of the gateway node.
+	// Signed requests are sent as their signer.
+	Sender string
+}
+
+type CapabilitiesRegistryConfig struct {
+	Address   string
+	NetworkID string
+	ChainID   string
 }
 
 type ConnectionManagerConfig struct {
@@ -22,12 +69,28 @@ type ConnectionManagerConfig struct {
 	HeartbeatIntervalSec      uint32
 }
 
+type SharedStateConfig struct {
+	// Backend is either "memory" (default) or "postgres". The postgres backend lets multiple
+	// gateway replicas share pending requests and route messages to nodes connected to other replicas.
+	Backend string
+	// ReplicaId identifies this gateway replica; a random ID is used when empty
+	ReplicaId          string
+	PollIntervalMillis uint32
+	NodeLeaseSec       uint32
+}
+
 type DONConfig struct {
 	DonId         string
 	HandlerName   string
 	HandlerConfig json.RawMessage
 	Members       []NodeConfig
 	F             int
+	// RegistryDonId is the ID of the DON in the capabilities registry. The registry doesn't hold
+	// the Eth keys nodes authenticate to the gateway with, so their addresses are looked up in NodeAddresses.
+	RegistryDonId uint32
+	// NodeAddresses maps P2P peer IDs of registry nodes to the addresses of their gateway connector
+	// keys (NodeAddress in the connector config). Required when RegistryDonId is set.
+	NodeAddresses map[string]string
 }
 
 type NodeConfig struct {
@@ -6,8 +6,10 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -24,9 +26,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
+const claimTimeout = 5 * time.Second
+
 var promKeepalivesSent = promauto.NewGaugeVec(prometheus.GaugeOpts{
 	Name: "gateway_keepalives_sent",
 	Help: "Metric to track the number of successful keepalive ping messages per DON",
@@ -57,9 +62,11 @@ type connectionManager struct {
 func (m *connectionManager) HealthReport() map[string]error {
 	hr := map[string]error{m.Name(): m.Healthy()}
 	for _, d := range m.dons {
+		d.mu.RLock()
 		for _, n := range d.nodes {
 			services.CopyHealth(hr, n.conn.HealthReport())
 		}
+		d.mu.RUnlock()
 	}
 	return hr
 }
@@ -68,21 +75,33 @@ func (m *connectionManager) Name() string { return m.lggr.Name() }
 
 type donConnectionManager struct {
 	donConfig  *config.DONConfig
-	nodes      map[string]*nodeState
 	handler    handlers.Handler
 	codec      api.Codec
 	closeWait  sync.WaitGroup
 	shutdownCh services.StopChan
 	lggr       logger.Logger
+	// replica routes messages to nodes connected to other gateway replicas, nil when not shared
+	replica *sharedstate.Replica
+	// wrapperLggr is passed to connection wrappers of nodes added by UpdateMembers
+	wrapperLggr logger.Logger
+
+	mu      sync.RWMutex // guards nodes, running, closed and donConfig membership
+	nodes   map[string]*nodeState
+	running bool
+	closed  bool
 }
 
 type nodeState struct {
-	name string
-	conn network.WSConnectionWrapper
+	name   string
+	conn   network.WSConnectionWrapper
+	stopCh services.StopChan // closed when the node is removed from the DON
+	// connGen is incremented on every new connection, so that only the latest one releases the node claim
+	connGen atomic.Uint64
 }
 
 // immutable
 type connAttempt struct {
+	donConnMgr  *donConnectionManager
 	nodeState   *nodeState
 	nodeAddress string
 	challenge   network.ChallengeElems
@@ -90,6 +109,12 @@ type connAttempt struct {
 }
 
 func NewConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock, lggr logger.Logger) (ConnectionManager, error) {
+	return newConnectionManager(gwConfig, clock, nil, lggr)
+}
+
+// newConnectionManager creates a connection manager which claims connected nodes in the shared state
+// of the replica, and forwards messages for nodes connected to other replicas.
+func newConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock, replica *sharedstate.Replica, lggr logger.Logger) (ConnectionManager, error) {
 	codec := &api.JsonRPCCodec{}
 	dons := make(map[string]*donConnectionManager)
 	for _, donConfig := range gwConfig.Dons {
@@ -108,21 +133,20 @@ func NewConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock,
 			if ok {
 				return nil, fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, donConfig.DonId)
 			}
-			connWrapper := network.NewWSConnectionWrapper(lggr)
-			if connWrapper == nil {
-				return nil, fmt.Errorf("error creating WSConnectionWrapper for node %s", nodeAddress)
-			}
-			nodes[nodeAddress] = &nodeState{
-				name: nodeConfig.Name,
-				conn: connWrapper,
+			nodeSt, err := newNodeState(nodeConfig.Name, lggr)
+			if err != nil {
+				return nil, fmt.Errorf("error creating WSConnectionWrapper for node %s: %w", nodeAddress, err)
 			}
+			nodes[nodeAddress] = nodeSt
 		}
 		dons[donConfig.DonId] = &donConnectionManager{
-			donConfig:  &donConfig,
-			codec:      codec,
-			nodes:      nodes,
-			shutdownCh: make(chan struct{}),
-			lggr:       lggr.Named("DONConnectionManager." + donConfig.DonId),
+			donConfig:   &donConfig,
+			codec:       codec,
+			nodes:       nodes,
+			shutdownCh:  make(chan struct{}),
+			lggr:        lggr.Named("DONConnectionManager." + donConfig.DonId),
+			replica:     replica,
+			wrapperLggr: lggr,
 		}
 	}
 	connMgr := &connectionManager{
@@ -134,9 +158,30 @@ func NewConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock,
 	}
 	wsServer := network.NewWebSocketServer(&gwConfig.NodeServerConfig, connMgr, lggr)
 	connMgr.wsServer = wsServer
+	if replica != nil {
+		replica.SetNodeForwarder(func(ctx context.Context, donID string, nodeAddress string, msg *api.Message) error {
+			donConnMgr, ok := dons[donID]
+			if !ok {
+				return fmt.Errorf("connection manager ID %s not found", donID)
+			}
+			return donConnMgr.writeToNode(ctx, nodeAddress, msg)
+		})
+	}
 	return connMgr, nil
 }
 
+func newNodeState(name string, lggr logger.Logger) (*nodeState, error) {
+	connWrapper := network.NewWSConnectionWrapper(lggr)
+	if connWrapper == nil {
+		return nil, errors.New("nil connection wrapper")
+	}
+	return &nodeState{
+		name:   name,
+		conn:   connWrapper,
+		stopCh: make(chan struct{}),
+	}, nil
+}
+
 func (m *connectionManager) DONConnectionManager(donId string) *donConnectionManager {
 	return m.dons[donId]
 }
@@ -145,12 +190,8 @@ func (m *connectionManager) Start(ctx context.Context) error {
 	return m.StartOnce("ConnectionManager", func() error {
 		m.lggr.Info("starting connection manager")
 		for _, donConnMgr := range m.dons {
-			donConnMgr.closeWait.Add(len(donConnMgr.nodes))
-			for nodeAddress, nodeState := range donConnMgr.nodes {
-				if err := nodeState.conn.Start(ctx); err != nil {
-					return err
-				}
-				go donConnMgr.readLoop(nodeAddress, nodeState)
+			if err := donConnMgr.start(ctx); err != nil {
+				return err
 			}
 			donConnMgr.closeWait.Add(1)
 			go donConnMgr.keepaliveLoop(m.config.HeartbeatIntervalSec)
@@ -164,10 +205,7 @@ func (m *connectionManager) Close() error {
 		m.lggr.Info("closing connection manager")
 		err = multierr.Combine(err, m.wsServer.Close())
 		for _, donConnMgr := range m.dons {
-			close(donConnMgr.shutdownCh)
-			for _, nodeState := range donConnMgr.nodes {
-				nodeState.conn.Close()
-			}
+			donConnMgr.close()
 		}
 		for _, donConnMgr := range m.dons {
 			donConnMgr.closeWait.Wait()
@@ -187,7 +225,9 @@ func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string,
 	if !ok {
 		return "", nil, network.ErrAuthInvalidDonId
 	}
+	donConnMgr.mu.RLock()
 	nodeState, ok := donConnMgr.nodes[nodeAddress]
+	donConnMgr.mu.RUnlock()
 	if !ok {
 		return "", nil, network.ErrAuthInvalidNode
 	}
@@ -199,14 +239,14 @@ func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string,
 	if ts < nowTs-m.config.AuthTimestampToleranceSec || nowTs+m.config.AuthTimestampToleranceSec < ts {
 		return "", nil, network.ErrAuthInvalidTimestamp
 	}
-	attemptId, challenge, err = m.newAttempt(nodeState, nodeAddress, ts)
+	attemptId, challenge, err = m.newAttempt(donConnMgr, nodeState, nodeAddress, ts)
 	if err != nil {
 		return "", nil, err
 	}
 	return attemptId, challenge, nil
 }
 
-func (m *connectionManager) newAttempt(nodeSt *nodeState, nodeAddress string, timestamp uint32) (string, []byte, error) {
+func (m *connectionManager) newAttempt(donConnMgr *donConnectionManager, nodeSt *nodeState, nodeAddress string, timestamp uint32) (string, []byte, error) {
 	challengeBytes := make([]byte, m.config.AuthChallengeLen)
 	_, err := rand.Read(challengeBytes)
 	if err != nil {
@@ -217,7 +257,7 @@ func (m *connectionManager) newAttempt(nodeSt *nodeState, nodeAddress string, ti
 	defer m.connAttemptsMu.Unlock()
 	m.connAttemptCounter++
 	newId := fmt.Sprintf("%s_%d", nodeAddress, m.connAttemptCounter)
-	m.connAttempts[newId] = &connAttempt{nodeState: nodeSt, nodeAddress: nodeAddress, challenge: challenge, timestamp: timestamp}
+	m.connAttempts[newId] = &connAttempt{donConnMgr: donConnMgr, nodeState: nodeSt, nodeAddress: nodeAddress, challenge: challenge, timestamp: timestamp}
 	return newId, network.PackChallenge(&challenge), nil
 }
 
@@ -240,8 +280,19 @@ func (m *connectionManager) FinalizeHandshake(attemptId string, response []byte,
 			return nil
 		})
 	}
-	attempt.nodeState.conn.Reset(conn)
+	// The node could have been removed from the DON since the handshake started.
+	attempt.donConnMgr.mu.RLock()
+	if attempt.donConnMgr.nodes[attempt.nodeAddress] != attempt.nodeState {
+		attempt.donConnMgr.mu.RUnlock()
+		return network.ErrAuthInvalidNode
+	}
+	gen := attempt.nodeState.connGen.Add(1)
+	closeCh := attempt.nodeState.conn.Reset(conn)
+	attempt.donConnMgr.mu.RUnlock()
 	m.lggr.Infof("node %s connected", attempt.nodeAddress)
+	if closeCh != nil {
+		attempt.donConnMgr.claimNode(attempt.nodeAddress, attempt.nodeState, gen, closeCh)
+	}
 	return nil
 }
 
@@ -260,7 +311,21 @@ func (m *donConnectionManager) SetHandler(handler handlers.Handler) {
 	m.handler = handler
 }
 
+// SendToNode writes the message to the node, or forwards it to the gateway replica the node is connected to.
 func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress string, msg *api.Message) error {
+	err := m.writeToNode(ctx, nodeAddress, msg)
+	if !errors.Is(err, network.ErrNoActiveConnection) || m.replica == nil {
+		return err
+	}
+	owner, ownerErr := m.replica.NodeOwner(ctx, m.donConfig.DonId, nodeAddress)
+	if ownerErr != nil || owner == m.replica.ID() {
+		return err
+	}
+	return m.replica.ForwardToNode(ctx, owner, m.donConfig.DonId, nodeAddress, msg)
+}
+
+// writeToNode writes the message to a node connected to this gateway.
+func (m *donConnectionManager) writeToNode(ctx context.Context, nodeAddress string, msg *api.Message) error {
 	if msg == nil {
 		return errors.New("nil message")
 	}
@@ -268,13 +333,135 @@ func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress strin
 	if err != nil {
 		return fmt.Errorf("error encoding request for node %s: %v", nodeAddress, err)
 	}
+	m.mu.RLock()
 	nodeState := m.nodes[nodeAddress]
+	m.mu.RUnlock()
 	if nodeState == nil {
 		return fmt.Errorf("node %s not found", nodeAddress)
 	}
 	return nodeState.conn.Write(ctx, websocket.BinaryMessage, data)
 }
 
+// start starts connection wrappers and read loops of all current nodes. Nodes added
+// later by UpdateMembers are started right away.
+func (m *donConnectionManager) start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for nodeAddress, nodeState := range m.nodes {
+		if err := m.startNode(ctx, nodeAddress, nodeState); err != nil {
+			return err
+		}
+	}
+	m.running = true
+	return nil
+}
+
+func (m *donConnectionManager) startNode(ctx context.Context, nodeAddress string, nodeState *nodeState) error {
+	if err := nodeState.conn.Start(ctx); err != nil {
+		return err
+	}
+	m.closeWait.Add(1)
+	go m.readLoop(nodeAddress, nodeState)
+	return nil
+}
+
+// claimNode records the node connection in the shared state and releases it once the connection
+// is closed, unless the node reconnected in the meantime.
+func (m *donConnectionManager) claimNode(nodeAddress string, nodeState *nodeState, gen uint64, closeCh <-chan error) {
+	if m.replica == nil {
+		return
+	}
+	ctx, cancel := m.shutdownCh.CtxWithTimeout(claimTimeout)
+	defer cancel()
+	if err := m.replica.ClaimNode(ctx, m.donConfig.DonId, nodeAddress); err != nil {
+		m.lggr.Errorw("failed to claim node connection", "nodeAddress", nodeAddress, "err", err)
+	}
+	m.closeWait.Add(1)
+	go func() {
+		defer m.closeWait.Done()
+		select {
+		case <-m.shutdownCh:
+			// claims are released by the replica when it is closed
+			return
+		case <-nodeState.stopCh:
+		case <-closeCh:
+		}
+		if nodeState.connGen.Load() != gen {
+			return
+		}
+		ctx, cancel := m.shutdownCh.CtxWithTimeout(claimTimeout)
+		defer cancel()
+		if err := m.replica.ReleaseNode(ctx, m.donConfig.DonId, nodeAddress); err != nil {
+			m.lggr.Errorw("failed to release node connection", "nodeAddress", nodeAddress, "err", err)
+		}
+	}()
+}
+
+func (m *donConnectionManager) close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.running = false
+	m.closed = true
+	close(m.shutdownCh)
+	for _, nodeState := range m.nodes {
+		nodeState.conn.Close()
+	}
+}
+
+// UpdateMembers replaces the set of nodes in the DON. Connections of nodes that remain
+// members are kept intact, new nodes are able to connect right away and removed nodes
+// are disconnected.
+func (m *donConnectionManager) UpdateMembers(members []config.NodeConfig, f int) error {
+	newMembers := make([]config.NodeConfig, 0, len(members))
+	seen := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		nodeAddress := strings.ToLower(member.Address)
+		if _, ok := seen[nodeAddress]; ok {
+			return fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, m.donConfig.DonId)
+		}
+		seen[nodeAddress] = struct{}{}
+		newMembers = append(newMembers, config.NodeConfig{Name: member.Name, Address: nodeAddress})
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return fmt.Errorf("connection manager for DON %s is closed", m.donConfig.DonId)
+	}
+	ctx, cancel := m.shutdownCh.NewCtx()
+	defer cancel()
+	nodes := make(map[string]*nodeState, len(newMembers))
+	for _, member := range newMembers {
+		if existing, ok := m.nodes[member.Address]; ok {
+			nodes[member.Address] = existing
+			continue
+		}
+		nodeSt, err := newNodeState(member.Name, m.wrapperLggr)
+		if err != nil {
+			return fmt.Errorf("error creating WSConnectionWrapper for node %s: %w", member.Address, err)
+		}
+		if m.running {
+			if err := m.startNode(ctx, member.Address, nodeSt); err != nil {
+				return err
+			}
+		}
+		nodes[member.Address] = nodeSt
+		m.lggr.Infow("node added to DON", "nodeAddress", member.Address, "name", member.Name)
+	}
+	for nodeAddress, nodeState := range m.nodes {
+		if _, ok := nodes[nodeAddress]; ok {
+			continue
+		}
+		close(nodeState.stopCh)
+		nodeState.conn.Close()
+		m.lggr.Infow("node removed from DON", "nodeAddress", nodeAddress, "name", nodeState.name)
+	}
+	m.nodes = nodes
+	m.donConfig.Members = newMembers
+	m.donConfig.F = f
+	return nil
+}
+
 func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState) {
 	ctx, _ := m.shutdownCh.NewCtx()
 	for {
@@ -282,6 +469,9 @@ func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState
 		case <-m.shutdownCh:
 			m.closeWait.Done()
 			return
+		case <-nodeState.stopCh:
+			m.closeWait.Done()
+			return
 		case item := <-nodeState.conn.ReadChannel():
 			msg, err := m.codec.DecodeResponse(item.Data)
 			if err != nil {
@@ -323,14 +513,17 @@ func (m *donConnectionManager) keepaliveLoop(intervalSec uint32) {
 			return
 		case <-keepaliveTicker.C:
 			errorCount := 0
-			for nodeAddress, nodeState := range m.nodes {
+			m.mu.RLock()
+			nodes := maps.Clone(m.nodes)
+			m.mu.RUnlock()
+			for nodeAddress, nodeState := range nodes {
 				err := nodeState.conn.Write(ctx, websocket.PingMessage, []byte{})
 				if err != nil {
 					m.lggr.Debugw("unable to send keepalive ping to node", "nodeAddress", nodeAddress, "name", nodeState.name, "donID", m.donConfig.DonId, "err", err)
 					errorCount++
 				}
 			}
-			promKeepalivesSent.WithLabelValues(m.donConfig.DonId).Set(float64(len(m.nodes) - errorCount))
+			promKeepalivesSent.WithLabelValues(m.donConfig.DonId).Set(float64(len(nodes) - errorCount))
 			m.lggr.Infow("sent keepalive pings to nodes", "donID", m.donConfig.DonId, "errCount", errorCount)
 		}
 	}
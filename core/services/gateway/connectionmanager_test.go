@@ -3,6 +3,7 @@ package gateway_test
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/jonboulle/clockwork"
@@ -242,3 +243,52 @@ func TestConnectionManager_CleanStartClose(t *testing.T) {
 	err = mgr.Close()
 	require.NoError(t, err)
 }
+
+func TestConnectionManager_UpdateMembers(t *testing.T) {
+	t.Parallel()
+
+	gwConfig, nodes := newTestConfig(t, 2)
+	newNode := gc.NewTestNodes(t, 1)[0]
+	clock := clockwork.NewFakeClock()
+	mgr, err := gateway.NewConnectionManager(gwConfig, clock, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, mgr.Start(testutils.Context(t)))
+
+	authHeaderElems := network.AuthHeaderElems{
+		Timestamp: uint32(clock.Now().Unix()),
+		DonId:     "my_don_1",
+		GatewayId: "my_gateway_no_3",
+	}
+	_, _, err = mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, newNode.PrivateKey))
+	require.ErrorIs(t, err, network.ErrAuthInvalidNode)
+	// handshake started by a node that gets removed before it finishes
+	attemptId, challenge, err := mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[1].PrivateKey))
+	require.NoError(t, err)
+
+	donMgr := mgr.DONConnectionManager("my_don_1")
+	require.Error(t, donMgr.UpdateMembers([]config.NodeConfig{
+		{Name: "node_0", Address: nodes[0].Address},
+		{Name: "node_0_dup", Address: strings.ToUpper(nodes[0].Address)},
+	}, 0))
+	require.NoError(t, donMgr.UpdateMembers([]config.NodeConfig{
+		{Name: "node_0", Address: nodes[0].Address},
+		{Name: "node_new", Address: newNode.Address},
+	}, 0))
+
+	response, err := gc.SignData(nodes[1].PrivateKey, challenge)
+	require.NoError(t, err)
+	require.ErrorIs(t, mgr.FinalizeHandshake(attemptId, response, nil), network.ErrAuthInvalidNode)
+	_, _, err = mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[1].PrivateKey))
+	require.ErrorIs(t, err, network.ErrAuthInvalidNode)
+
+	for _, node := range []gc.TestNode{nodes[0], newNode} {
+		attemptId, challenge, err = mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, node.PrivateKey))
+		require.NoError(t, err)
+		response, err = gc.SignData(node.PrivateKey, challenge)
+		require.NoError(t, err)
+		require.NoError(t, mgr.FinalizeHandshake(attemptId, response, nil))
+	}
+
+	require.NoError(t, mgr.Close())
+	require.Error(t, donMgr.UpdateMembers(nil, 0))
+}
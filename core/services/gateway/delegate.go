@@ -8,17 +8,25 @@ import (
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 )
 
+type RelayGetter interface {
+	Get(id types.RelayID) (loop.Relayer, error)
+}
+
 type Delegate struct {
 	legacyChains legacyevm.LegacyChainContainer
+	relayers     RelayGetter
 	ks           keystore.Eth
 	ds           sqlutil.DataSource
 	lggr         logger.Logger
@@ -26,9 +34,10 @@ type Delegate struct {
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(legacyChains legacyevm.LegacyChainContainer, ks keystore.Eth, ds sqlutil.DataSource, lggr logger.Logger) *Delegate {
+func NewDelegate(legacyChains legacyevm.LegacyChainContainer, relayers RelayGetter, ks keystore.Eth, ds sqlutil.DataSource, lggr logger.Logger) *Delegate {
 	return &Delegate{
 		legacyChains: legacyChains,
+		relayers:     relayers,
 		ks:           ks,
 		ds:           ds,
 		lggr:         lggr,
@@ -59,13 +68,27 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 	if err != nil {
 		return nil, err
 	}
+	store, err := sharedstate.NewStore(gatewayConfig.SharedStateConfig.Backend, d.ds)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not configure shared state")
+	}
 	handlerFactory := NewHandlerFactory(d.legacyChains, d.ds, httpClient, d.lggr)
-	gateway, err := NewGatewayFromConfig(&gatewayConfig, handlerFactory, d.lggr)
+	gateway, err := NewGatewayFromConfig(&gatewayConfig, handlerFactory, store, d.ks, d.lggr)
 	if err != nil {
 		return nil, err
 	}
-
-	return []job.ServiceCtx{gateway}, nil
+	services = []job.ServiceCtx{gateway}
+
+	if gatewayConfig.CapabilitiesRegistry != nil {
+		registrySyncer, err := newRegistrySyncer(gatewayConfig.CapabilitiesRegistry, d.relayers, d.lggr)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not configure registry syncer")
+		}
+		registrySyncer.AddLauncher(gateway)
+		// the gateway has to be started before it receives registry updates
+		services = append(services, registrySyncer)
+	}
+	return services, nil
 }
 
 func ValidatedGatewaySpec(tomlString string) (job.Job, error) {
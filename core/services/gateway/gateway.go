@@ -3,8 +3,11 @@ package gateway
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"go.uber.org/multierr"
 
@@ -20,7 +23,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/quota"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
 )
 
 var promRequest = promauto.NewCounterVec(prometheus.CounterOpts{
@@ -31,6 +37,9 @@ var promRequest = promauto.NewCounterVec(prometheus.CounterOpts{
 type Gateway interface {
 	job.ServiceCtx
 	gw_net.HTTPRequestHandler
+	gw_net.StreamingRequestHandler
+	// Launch updates DONs linked to the capabilities registry
+	registrysyncer.Launcher
 
 	GetUserPort() int
 	GetNodePort() int
@@ -39,7 +48,7 @@ type Gateway interface {
 type HandlerType = string
 
 type HandlerFactory interface {
-	NewHandler(handlerType HandlerType, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error)
+	NewHandler(handlerType HandlerType, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, replica *sharedstate.Replica) (handlers.Handler, error)
 }
 
 type gateway struct {
@@ -49,19 +58,39 @@ type gateway struct {
 	httpServer gw_net.HttpServer
 	handlers   map[string]handlers.Handler
 	connMgr    ConnectionManager
-	lggr       logger.Logger
+	replica    *sharedstate.Replica
+	auth       *apiKeyAuth
+	// limiter is nil when no quotas are configured
+	limiter *quota.Limiter
+	lggr    logger.Logger
+
+	// registryDons holds the current config of DONs whose members are derived from the capabilities registry
+	registryDons map[string]*config.DONConfig
+	registryMu   sync.Mutex
 }
 
-func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFactory, lggr logger.Logger) (Gateway, error) {
+// NewGatewayFromConfig creates a gateway keeping pending requests in store, which can be shared
+// by multiple gateway replicas. A nil store keeps them in memory. signer signs requests authenticated
+// with API keys and may be nil when none are configured.
+func NewGatewayFromConfig(gwConfig *config.GatewayConfig, handlerFactory HandlerFactory, store sharedstate.Store, signer MessageSigner, lggr logger.Logger) (Gateway, error) {
 	codec := &api.JsonRPCCodec{}
-	httpServer := gw_net.NewHttpServer(&config.UserServerConfig, lggr)
-	connMgr, err := NewConnectionManager(config, clockwork.NewRealClock(), lggr)
+	httpServer := gw_net.NewHttpServer(&gwConfig.UserServerConfig, lggr)
+	if store == nil {
+		store = sharedstate.NewMemoryStore()
+	}
+	replica := sharedstate.NewReplica(store, gwConfig.SharedStateConfig, lggr)
+	connMgr, err := newConnectionManager(gwConfig, clockwork.NewRealClock(), replica, lggr)
 	if err != nil {
 		return nil, err
 	}
 
+	if gwConfig.CapabilitiesRegistry != nil && !common.IsHexAddress(gwConfig.CapabilitiesRegistry.Address) {
+		return nil, fmt.Errorf("invalid capabilities registry address %s", gwConfig.CapabilitiesRegistry.Address)
+	}
+
 	handlerMap := make(map[string]handlers.Handler)
-	for _, donConfig := range config.Dons {
+	registryDons := make(map[string]*config.DONConfig)
+	for _, donConfig := range gwConfig.Dons {
 		donConfig := donConfig
 		_, ok := handlerMap[donConfig.DonId]
 		if ok {
@@ -77,22 +106,50 @@ func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFa
 				return nil, fmt.Errorf("invalid node address %s", nodeConfig.Address)
 			}
 		}
-		handler, err := handlerFactory.NewHandler(donConfig.HandlerName, donConfig.HandlerConfig, &donConfig, donConnMgr)
+		handler, err := handlerFactory.NewHandler(donConfig.HandlerName, donConfig.HandlerConfig, &donConfig, donConnMgr, replica)
 		if err != nil {
 			return nil, err
 		}
+		if donConfig.RegistryDonId != 0 {
+			if gwConfig.CapabilitiesRegistry == nil {
+				return nil, fmt.Errorf("DON %s has RegistryDonId set but capabilities registry is not configured", donConfig.DonId)
+			}
+			if _, ok := handler.(handlers.DONConfigUpdater); !ok {
+				return nil, fmt.Errorf("handler %s of DON %s does not support membership updates", donConfig.HandlerName, donConfig.DonId)
+			}
+			if err := normalizeNodeAddresses(&donConfig); err != nil {
+				return nil, err
+			}
+			registryDons[donConfig.DonId] = &donConfig
+		}
 		handlerMap[donConfig.DonId] = handler
 		donConnMgr.SetHandler(handler)
 	}
-	return NewGateway(codec, httpServer, handlerMap, connMgr, lggr), nil
+	auth, err := newAPIKeyAuth(gwConfig.APIKeys, signer)
+	if err != nil {
+		return nil, err
+	}
+	gw := newGateway(codec, httpServer, handlerMap, connMgr, lggr)
+	gw.replica = replica
+	gw.auth = auth
+	if quota.Enabled(gwConfig.Quotas) {
+		gw.limiter = quota.NewLimiter(gwConfig.Quotas, store, clockwork.NewRealClock())
+	}
+	gw.registryDons = registryDons
+	return gw, nil
 }
 
 func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, lggr logger.Logger) Gateway {
+	return newGateway(codec, httpServer, handlers, connMgr, lggr)
+}
+
+func newGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, lggr logger.Logger) *gateway {
 	gw := &gateway{
 		codec:      codec,
 		httpServer: httpServer,
 		handlers:   handlers,
 		connMgr:    connMgr,
+		auth:       &apiKeyAuth{},
 		lggr:       lggr.Named("Gateway"),
 	}
 	httpServer.SetHTTPRequestHandler(gw)
@@ -102,6 +159,11 @@ func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[stri
 func (g *gateway) Start(ctx context.Context) error {
 	return g.StartOnce("Gateway", func() error {
 		g.lggr.Info("starting gateway")
+		if g.replica != nil {
+			if err := g.replica.Start(ctx); err != nil {
+				return err
+			}
+		}
 		for _, handler := range g.handlers {
 			if err := handler.Start(ctx); err != nil {
 				return err
@@ -122,31 +184,22 @@ func (g *gateway) Close() error {
 		for _, handler := range g.handlers {
 			err = multierr.Combine(err, handler.Close())
 		}
+		if g.replica != nil {
+			err = multierr.Combine(err, g.replica.Close())
+		}
 		return
 	})
 }
 
 // Called by the server
 func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int) {
-	// decode
-	msg, err := g.codec.DecodeRequest(rawRequest)
-	if err != nil {
-		return newError(g.codec, "", api.UserMessageParseError, err.Error())
-	}
-	if msg == nil {
-		return newError(g.codec, "", api.UserMessageParseError, "nil message")
-	}
-	if err = msg.Validate(); err != nil {
-		return newError(g.codec, msg.Body.MessageId, api.UserMessageParseError, err.Error())
-	}
-	// find correct handler
-	handler, ok := g.handlers[msg.Body.DonId]
-	if !ok {
-		return newError(g.codec, msg.Body.MessageId, api.UnsupportedDONIdError, "unsupported DON ID")
+	msg, handler, userErr := g.decodeUserRequest(ctx, rawRequest)
+	if userErr != nil {
+		return userErr.encode(g.codec)
 	}
 	// send to the handler
 	responseCh := make(chan handlers.UserCallbackPayload, 1)
-	err = handler.HandleUserMessage(ctx, msg, responseCh)
+	err := handler.HandleUserMessage(ctx, msg, responseCh)
 	if err != nil {
 		return newError(g.codec, msg.Body.MessageId, api.HandlerError, err.Error())
 	}
@@ -170,8 +223,169 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	return rawResponse, api.ToHttpErrorCode(api.NoError)
 }
 
+// streamBufferSize is the number of intermediate updates buffered for a streaming request,
+// further updates are dropped until the user catches up.
+const streamBufferSize = 100
+
+const (
+	StreamEventStatus       = "status"
+	StreamEventNodeResponse = "node_response"
+	StreamEventResult       = "result"
+	StreamEventError        = "error"
+)
+
+type streamStatus struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Node   string `json:"node,omitempty"`
+}
+
+func (g *gateway) ProcessStreamRequest(ctx context.Context, rawRequest []byte, send func(event gw_net.StreamEvent) error) {
+	msg, handler, userErr := g.decodeUserRequest(ctx, rawRequest)
+	if userErr != nil {
+		rawError, _ := userErr.encode(g.codec)
+		_ = send(errorEvent(rawError))
+		return
+	}
+	streamingHandler, ok := handler.(handlers.StreamingHandler)
+	if !ok {
+		_ = send(newErrorEvent(g.codec, msg.Body.MessageId, api.HandlerError, "streaming is not supported"))
+		return
+	}
+	// send to the handler
+	responseCh := make(chan handlers.UserCallbackPayload, 1)
+	updatesCh := make(chan handlers.UserStreamUpdate, streamBufferSize)
+	err := streamingHandler.HandleUserStreamMessage(ctx, msg, responseCh, updatesCh)
+	if err != nil {
+		_ = send(newErrorEvent(g.codec, msg.Body.MessageId, api.HandlerError, err.Error()))
+		return
+	}
+	if err = send(g.statusEvent(msg.Body.MessageId, "accepted", "")); err != nil {
+		return
+	}
+	// forward updates until the final response
+	for {
+		select {
+		case <-ctx.Done():
+			_ = send(newErrorEvent(g.codec, msg.Body.MessageId, api.RequestTimeoutError, "handler timeout"))
+			return
+		case update := <-updatesCh:
+			if err = send(g.updateEvent(msg.Body.MessageId, update)); err != nil {
+				return
+			}
+		case response := <-responseCh:
+			// updates published before the response are sent first
+			for len(updatesCh) > 0 {
+				if err = send(g.updateEvent(msg.Body.MessageId, <-updatesCh)); err != nil {
+					return
+				}
+			}
+			_ = send(g.resultEvent(msg.Body.MessageId, response))
+			return
+		}
+	}
+}
+
+func (g *gateway) statusEvent(id string, status string, node string) gw_net.StreamEvent {
+	data, err := json.Marshal(streamStatus{ID: id, Status: status, Node: node})
+	if err != nil {
+		g.lggr.Errorw("failed to encode stream status", "err", err)
+	}
+	return gw_net.StreamEvent{Type: StreamEventStatus, Data: data}
+}
+
+func (g *gateway) updateEvent(id string, update handlers.UserStreamUpdate) gw_net.StreamEvent {
+	if update.Type != handlers.UserStreamNodeResponse || update.Msg == nil {
+		return g.statusEvent(id, update.Status, update.NodeAddress)
+	}
+	data, err := g.codec.EncodeResponse(update.Msg)
+	if err != nil {
+		g.lggr.Debugw("failed to encode node response", "id", id, "node", update.NodeAddress, "err", err)
+		return g.statusEvent(id, "invalid node response", update.NodeAddress)
+	}
+	return gw_net.StreamEvent{Type: StreamEventNodeResponse, Data: data}
+}
+
+func (g *gateway) resultEvent(id string, response handlers.UserCallbackPayload) gw_net.StreamEvent {
+	if response.ErrCode != api.NoError {
+		return newErrorEvent(g.codec, id, response.ErrCode, response.ErrMsg)
+	}
+	data, err := g.codec.EncodeResponse(response.Msg)
+	if err != nil {
+		return newErrorEvent(g.codec, id, api.NodeReponseEncodingError, "")
+	}
+	promRequest.WithLabelValues(api.NoError.String()).Inc()
+	return gw_net.StreamEvent{Type: StreamEventResult, Data: data}
+}
+
+func newErrorEvent(codec api.Codec, id string, errCode api.ErrorCode, errMsg string) gw_net.StreamEvent {
+	rawError, _ := newError(codec, id, errCode, errMsg)
+	return errorEvent(rawError)
+}
+
+func errorEvent(rawError []byte) gw_net.StreamEvent {
+	if !json.Valid(rawError) {
+		rawError, _ = json.Marshal(string(rawError))
+	}
+	return gw_net.StreamEvent{Type: StreamEventError, Data: rawError}
+}
+
+type userRequestError struct {
+	id   string
+	code api.ErrorCode
+	msg  string
+	data []byte
+}
+
+func (e *userRequestError) encode(codec api.Codec) ([]byte, int) {
+	return newErrorWithData(codec, e.id, e.code, e.msg, e.data)
+}
+
+// quotaErrorData is sent in the JSON-RPC error of requests exceeding a quota.
+type quotaErrorData struct {
+	RetryAfterSec int64 `json:"retry_after_sec"`
+}
+
+// decodeUserRequest decodes and authenticates a user request, finds the handler of its DON
+// and counts the request in the sender's quotas.
+func (g *gateway) decodeUserRequest(ctx context.Context, rawRequest []byte) (*api.Message, handlers.Handler, *userRequestError) {
+	msg, err := g.codec.DecodeRequest(rawRequest)
+	if err != nil {
+		return nil, nil, &userRequestError{id: "", code: api.UserMessageParseError, msg: err.Error()}
+	}
+	if msg == nil {
+		return nil, nil, &userRequestError{id: "", code: api.UserMessageParseError, msg: "nil message"}
+	}
+	if errCode, err := g.auth.authenticate(ctx, msg); err != nil {
+		return nil, nil, &userRequestError{id: msg.Body.MessageId, code: errCode, msg: err.Error()}
+	}
+	handler, ok := g.handlers[msg.Body.DonId]
+	if !ok {
+		return nil, nil, &userRequestError{id: msg.Body.MessageId, code: api.UnsupportedDONIdError, msg: "unsupported DON ID"}
+	}
+	if g.limiter != nil {
+		err = g.limiter.Allow(ctx, msg.Body.Sender, msg.Body.Method, len(msg.Body.Payload))
+		var exceeded *quota.ExceededError
+		if errors.As(err, &exceeded) {
+			// rounded up, retrying earlier would fail
+			retryAfterSec := int64((exceeded.RetryAfter + time.Second - 1) / time.Second)
+			data, _ := json.Marshal(quotaErrorData{RetryAfterSec: retryAfterSec})
+			return nil, nil, &userRequestError{id: msg.Body.MessageId, code: api.QuotaExceededError, msg: err.Error(), data: data}
+		}
+		if err != nil {
+			g.lggr.Errorw("failed to check quota", "sender", msg.Body.Sender, "err", err)
+			return nil, nil, &userRequestError{id: msg.Body.MessageId, code: api.FatalError, msg: "failed to check quota"}
+		}
+	}
+	return msg, handler, nil
+}
+
 func newError(codec api.Codec, id string, errCode api.ErrorCode, errMsg string) ([]byte, int) {
-	rawResponse, err := codec.EncodeNewErrorResponse(id, api.ToJsonRPCErrorCode(errCode), errMsg, nil)
+	return newErrorWithData(codec, id, errCode, errMsg, nil)
+}
+
+func newErrorWithData(codec api.Codec, id string, errCode api.ErrorCode, errMsg string, data []byte) ([]byte, int) {
+	rawResponse, err := codec.EncodeNewErrorResponse(id, api.ToJsonRPCErrorCode(errCode), errMsg, data)
 	if err != nil {
 		// we're not even able to encode a valid JSON response
 		promRequest.WithLabelValues(api.FatalError.String()).Inc()
@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	handler_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 	net_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network/mocks"
 )
 
@@ -57,7 +58,7 @@ Address = "0x0001020304050607080900010203040506070809"
 `)
 
 	lggr := logger.TestLogger(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), nil, nil, lggr)
 	require.NoError(t, err)
 }
 
@@ -75,7 +76,7 @@ HandlerName = "dummy"
 `)
 
 	lggr := logger.TestLogger(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), nil, nil, lggr)
 	require.Error(t, err)
 }
 
@@ -89,7 +90,7 @@ HandlerName = "no_such_handler"
 `)
 
 	lggr := logger.TestLogger(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), nil, nil, lggr)
 	require.Error(t, err)
 }
 
@@ -103,7 +104,7 @@ SomeOtherField = "abcd"
 `)
 
 	lggr := logger.TestLogger(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), nil, nil, lggr)
 	require.Error(t, err)
 }
 
@@ -121,7 +122,7 @@ Address = "0xnot_an_address"
 `)
 
 	lggr := logger.TestLogger(t)
-	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), gateway.NewHandlerFactory(nil, nil, nil, lggr), nil, nil, lggr)
 	require.Error(t, err)
 }
 
@@ -129,7 +130,7 @@ func TestGateway_CleanStartAndClose(t *testing.T) {
 	t.Parallel()
 
 	lggr := logger.TestLogger(t)
-	gateway, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig("")), gateway.NewHandlerFactory(nil, nil, nil, lggr), lggr)
+	gateway, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig("")), gateway.NewHandlerFactory(nil, nil, nil, lggr), nil, nil, lggr)
 	require.NoError(t, err)
 	servicetest.Run(t, gateway)
 }
@@ -245,3 +246,105 @@ func TestGateway_ProcessRequest_HandlerError(t *testing.T) {
 	requireJsonRPCError(t, response, "abcd", -32600, "failure")
 	require.Equal(t, 400, statusCode)
 }
+
+// streamingHandler adds HandleUserStreamMessage to a mock handler.
+type streamingHandler struct {
+	*handler_mocks.Handler
+	handleStream func(msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate) error
+}
+
+func (h *streamingHandler) HandleUserStreamMessage(_ context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate) error {
+	return h.handleStream(msg, callbackCh, updatesCh)
+}
+
+func newGatewayWithStreamingHandler(t *testing.T, handleStream func(msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate) error) gateway.Gateway {
+	httpServer := net_mocks.NewHttpServer(t)
+	httpServer.On("SetHTTPRequestHandler", mock.Anything).Return(nil)
+	handlers := map[string]handlers.Handler{
+		"testDON": &streamingHandler{Handler: handler_mocks.NewHandler(t), handleStream: handleStream},
+	}
+	return gateway.NewGateway(&api.JsonRPCCodec{}, httpServer, handlers, nil, logger.TestLogger(t))
+}
+
+func collectStreamEvents(ctx context.Context, gw gateway.Gateway, req []byte) []gw_net.StreamEvent {
+	var events []gw_net.StreamEvent
+	gw.ProcessStreamRequest(ctx, req, func(event gw_net.StreamEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	return events
+}
+
+func TestGateway_ProcessStreamRequest_UpdatesAndResult(t *testing.T) {
+	t.Parallel()
+
+	gw := newGatewayWithStreamingHandler(t, func(msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate) error {
+		nodeMsg := *msg
+		nodeMsg.Body.Payload = []byte(`{"partial":true}`)
+		nodeMsg.Signature = ""
+		updatesCh <- handlers.UserStreamUpdate{Type: handlers.UserStreamStatus, Status: "running"}
+		updatesCh <- handlers.UserStreamUpdate{Type: handlers.UserStreamNodeResponse, NodeAddress: "0xbb", Msg: &nodeMsg}
+		msg.Body.Payload = []byte(`{"result":"OK"}`)
+		msg.Signature = ""
+		callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError}
+		return nil
+	})
+
+	req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+	events := collectStreamEvents(testutils.Context(t), gw, req)
+	require.Len(t, events, 4)
+	require.Equal(t, gateway.StreamEventStatus, events[0].Type)
+	require.JSONEq(t, `{"id":"abcd","status":"accepted"}`, string(events[0].Data))
+	require.Equal(t, gateway.StreamEventStatus, events[1].Type)
+	require.JSONEq(t, `{"id":"abcd","status":"running"}`, string(events[1].Data))
+	require.Equal(t, gateway.StreamEventNodeResponse, events[2].Type)
+	requireJsonRPCResult(t, events[2].Data, "abcd",
+		`{"signature":"","body":{"message_id":"abcd","method":"request","don_id":"testDON","receiver":"","payload":{"partial":true}}}`)
+	require.Equal(t, gateway.StreamEventResult, events[3].Type)
+	requireJsonRPCResult(t, events[3].Data, "abcd",
+		`{"signature":"","body":{"message_id":"abcd","method":"request","don_id":"testDON","receiver":"","payload":{"result":"OK"}}}`)
+}
+
+func TestGateway_ProcessStreamRequest_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parse error", func(t *testing.T) {
+		gw := newGatewayWithStreamingHandler(t, nil)
+		events := collectStreamEvents(testutils.Context(t), gw, []byte("{{}"))
+		require.Len(t, events, 1)
+		require.Equal(t, gateway.StreamEventError, events[0].Type)
+		requireJsonRPCError(t, events[0].Data, "", -32700, "invalid character '{' looking for beginning of object key string")
+	})
+
+	t.Run("streaming not supported", func(t *testing.T) {
+		gw, _ := newGatewayWithMockHandler(t)
+		req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+		events := collectStreamEvents(testutils.Context(t), gw, req)
+		require.Len(t, events, 1)
+		requireJsonRPCError(t, events[0].Data, "abcd", -32600, "streaming is not supported")
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		gw := newGatewayWithStreamingHandler(t, func(*api.Message, chan<- handlers.UserCallbackPayload, chan<- handlers.UserStreamUpdate) error {
+			return errors.New("failure")
+		})
+		req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+		events := collectStreamEvents(testutils.Context(t), gw, req)
+		require.Len(t, events, 1)
+		requireJsonRPCError(t, events[0].Data, "abcd", -32600, "failure")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		gw := newGatewayWithStreamingHandler(t, func(*api.Message, chan<- handlers.UserCallbackPayload, chan<- handlers.UserStreamUpdate) error {
+			return nil
+		})
+		timeoutCtx, cancel := context.WithTimeout(testutils.Context(t), time.Millisecond*10)
+		defer cancel()
+		req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+		events := collectStreamEvents(timeoutCtx, gw, req)
+		require.Len(t, events, 2)
+		require.Equal(t, gateway.StreamEventStatus, events[0].Type)
+		require.Equal(t, gateway.StreamEventError, events[1].Type)
+		requireJsonRPCError(t, events[1].Data, "abcd", -32000, "handler timeout")
+	})
+}
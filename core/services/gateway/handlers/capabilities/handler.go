@@ -3,8 +3,10 @@ package capabilities
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/multierr"
@@ -16,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
 )
 
 const (
@@ -23,14 +26,16 @@ const (
 	MethodWebAPITarget  = "web_api_target"
 	MethodWebAPITrigger = "web_api_trigger"
 	MethodComputeAction = "compute_action"
+
+	// callbackTimeout bounds how long a trigger request waits for the first node response.
+	callbackTimeout = 5 * time.Minute
 )
 
 type handler struct {
 	config          HandlerConfig
 	don             handlers.DON
-	donConfig       *config.DONConfig
-	savedCallbacks  map[string]*savedCallback
-	mu              sync.Mutex
+	donConfig       atomic.Pointer[config.DONConfig]
+	replica         *sharedstate.Replica
 	lggr            logger.Logger
 	httpClient      network.HTTPClient
 	nodeRateLimiter *common.RateLimiter
@@ -42,14 +47,11 @@ type HandlerConfig struct {
 	MaxAllowedMessageAgeSec uint                     `json:"maxAllowedMessageAgeSec"`
 }
 
-type savedCallback struct {
-	id         string
-	callbackCh chan<- handlers.UserCallbackPayload
-}
-
 var _ handlers.Handler = (*handler)(nil)
+var _ handlers.DONConfigUpdater = (*handler)(nil)
+var _ handlers.StreamingHandler = (*handler)(nil)
 
-func NewHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, httpClient network.HTTPClient, lggr logger.Logger) (*handler, error) {
+func NewHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, replica *sharedstate.Replica, httpClient network.HTTPClient, lggr logger.Logger) (*handler, error) {
 	var cfg HandlerConfig
 	err := json.Unmarshal(handlerConfig, &cfg)
 	if err != nil {
@@ -60,16 +62,21 @@ func NewHandler(handlerConfig json.RawMessage, donConfig *config.DONConfig, don
 		return nil, err
 	}
 
-	return &handler{
+	h := &handler{
 		config:          cfg,
 		don:             don,
-		donConfig:       donConfig,
+		replica:         replica,
 		lggr:            lggr.Named("WebAPIHandler." + donConfig.DonId),
 		httpClient:      httpClient,
 		nodeRateLimiter: nodeRateLimiter,
 		wg:              sync.WaitGroup{},
-		savedCallbacks:  make(map[string]*savedCallback),
-	}, nil
+	}
+	h.donConfig.Store(donConfig)
+	return h, nil
+}
+
+func (h *handler) UpdateDONConfig(donConfig *config.DONConfig) {
+	h.donConfig.Store(donConfig)
 }
 
 // sendHTTPMessageToClient is an outgoing message from the gateway to external endpoints
@@ -102,19 +109,30 @@ func (h *handler) sendHTTPMessageToClient(ctx context.Context, req network.HTTPR
 }
 
 func (h *handler) handleWebAPITriggerMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
-	h.mu.Lock()
-	savedCb, found := h.savedCallbacks[msg.Body.MessageId]
-	delete(h.savedCallbacks, msg.Body.MessageId)
-	h.mu.Unlock()
-
-	if found {
-		// Send first response from a node back to the user, ignore any other ones.
-		// TODO: in practice, we should wait for at least 2F+1 nodes to respond and then return an aggregated response
-		// back to the user.
-		savedCb.callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError, ErrMsg: ""}
-		close(savedCb.callbackCh)
+	key := h.callbackKey(msg)
+	var payload TriggerResponsePayload
+	if err := json.Unmarshal(msg.Body.Payload, &payload); err == nil && payload.Status == TriggerStatusPending {
+		// progress of a long-running workflow execution, only visible to streaming requests
+		return h.replica.Publish(ctx, key, handlers.UserStreamUpdate{Type: handlers.UserStreamStatus, NodeAddress: nodeAddr, Status: payload.Status, Msg: msg})
 	}
-	return nil
+	if err := h.replica.Publish(ctx, key, handlers.UserStreamUpdate{Type: handlers.UserStreamNodeResponse, NodeAddress: nodeAddr, Msg: msg}); err != nil {
+		h.lggr.Debugw("failed to publish node response", "messageId", msg.Body.MessageId, "nodeAddr", nodeAddr, "err", err)
+	}
+	// Send first response from a node back to the user, ignore any other ones.
+	// TODO: in practice, we should wait for at least 2F+1 nodes to respond and then return an aggregated response
+	// back to the user.
+	err := h.replica.ProcessResponse(ctx, key, func([]byte) (*handlers.UserCallbackPayload, []byte) {
+		return &handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError, ErrMsg: ""}, nil
+	})
+	if errors.Is(err, sharedstate.ErrRequestNotFound) {
+		return nil
+	}
+	return err
+}
+
+// callbackKey identifies a trigger request by its message ID only, as node responses don't carry the user's address.
+func (h *handler) callbackKey(msg *api.Message) sharedstate.RequestKey {
+	return sharedstate.RequestKey{DonID: h.donConfig.Load().DonId, MessageID: msg.Body.MessageId}
 }
 
 func (h *handler) handleWebAPIOutgoingMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
@@ -203,10 +221,16 @@ func (h *handler) Close() error {
 }
 
 func (h *handler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
-	h.mu.Lock()
-	h.savedCallbacks[msg.Body.MessageId] = &savedCallback{msg.Body.MessageId, callbackCh}
-	don := h.don
-	h.mu.Unlock()
+	return h.handleUserMessage(ctx, msg, callbackCh, nil)
+}
+
+// HandleUserStreamMessage additionally streams responses of all nodes and status updates of
+// workflow executions, reported by nodes before the first final response.
+func (h *handler) HandleUserStreamMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate) error {
+	return h.handleUserMessage(ctx, msg, callbackCh, updatesCh)
+}
+
+func (h *handler) handleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate) error {
 	body := msg.Body
 	var payload webapicap.TriggerRequestPayload
 	err := json.Unmarshal(body.Payload, &payload)
@@ -237,9 +261,13 @@ func (h *handler) HandleUserMessage(ctx context.Context, msg *api.Message, callb
 		return nil
 	}
 
+	if err = h.replica.NewRequest(ctx, h.callbackKey(msg), msg, callbackCh, updatesCh, nil, callbackTimeout, 0); err != nil {
+		return err
+	}
+
 	// Send to all nodes.
-	for _, member := range h.donConfig.Members {
-		err = multierr.Combine(err, don.SendToNode(ctx, member.Address, msg))
+	for _, member := range h.donConfig.Load().Members {
+		err = multierr.Combine(err, h.don.SendToNode(ctx, member.Address, msg))
 	}
 	return err
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -25,6 +26,7 @@ import (
 	handlermocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
 )
 
 const (
@@ -67,7 +69,9 @@ func setupHandler(t *testing.T) (*handler, *mocks.HTTPClient, *handlermocks.DON,
 			Address: n.Address,
 		})
 	}
-	handler, err := NewHandler(json.RawMessage(cfgBytes), donConfig, don, httpClient, lggr)
+	replica := sharedstate.NewReplica(sharedstate.NewMemoryStore(), config.SharedStateConfig{}, lggr)
+	servicetest.Run(t, replica)
+	handler, err := NewHandler(json.RawMessage(cfgBytes), donConfig, don, replica, httpClient, lggr)
 	require.NoError(t, err)
 	return handler, httpClient, don, nodes
 }
@@ -309,6 +313,30 @@ func TestHandlerReceiveHTTPMessageFromClient(t *testing.T) {
 		_, open := <-ch
 		require.Equal(t, open, false)
 	})
+
+	t.Run("streaming with pending status", func(t *testing.T) {
+		ch := make(chan handlers.UserCallbackPayload, defaultSendChannelBufferSize)
+		updatesCh := make(chan handlers.UserStreamUpdate, defaultSendChannelBufferSize)
+		don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+
+		require.NoError(t, handler.HandleUserStreamMessage(ctx, msg, ch, updatesCh))
+
+		pendingMsg := &api.Message{Body: api.MessageBody{MessageId: msg.Body.MessageId, Method: MethodWebAPITrigger, Payload: []byte(`{"status":"PENDING"}`)}}
+		require.NoError(t, handler.HandleNodeMessage(ctx, pendingMsg, "0xbb"))
+		update := <-updatesCh
+		require.Equal(t, handlers.UserStreamStatus, update.Type)
+		require.Equal(t, TriggerStatusPending, update.Status)
+		require.Equal(t, "0xbb", update.NodeAddress)
+		requireNoChanMsg(t, ch)
+
+		completedMsg := &api.Message{Body: api.MessageBody{MessageId: msg.Body.MessageId, Method: MethodWebAPITrigger, Payload: []byte(`{"status":"COMPLETED"}`)}}
+		require.NoError(t, handler.HandleNodeMessage(ctx, completedMsg, "0xbb"))
+		update = <-updatesCh
+		require.Equal(t, handlers.UserStreamNodeResponse, update.Type)
+		require.Equal(t, completedMsg, update.Msg)
+		resp := <-ch
+		require.Equal(t, handlers.UserCallbackPayload{Msg: completedMsg, ErrCode: api.NoError, ErrMsg: ""}, resp)
+	})
 	// TODO: Validate Senders and rate limit chck, pending question in trigger about where senders and rate limits are validated
 }
 
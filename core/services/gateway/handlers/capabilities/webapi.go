@@ -16,6 +16,10 @@ type Response struct {
 	Body           []byte            `json:"body,omitempty"`         // HTTP response body
 }
 
+// TriggerStatusPending is reported by nodes while a workflow execution is in progress.
+// Unlike other statuses it doesn't complete the user request.
+const TriggerStatusPending = "PENDING"
+
 type TriggerResponsePayload struct {
 	ErrorMessage string `json:"error_message,omitempty"`
 	// ERROR, ACCEPTED, PENDING, COMPLETED
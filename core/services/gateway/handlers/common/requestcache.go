@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -15,8 +16,13 @@ import (
 // Additionally, each request has a timeout, after which the netry will be removed from the cache and an error sent to the callback channel.
 // All methods are thread-safe.
 type RequestCache[T any] interface {
-	NewRequest(request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error
-	ProcessResponse(response *api.Message, process ResponseProcessor[T]) error
+	NewRequest(ctx context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error
+	ProcessResponse(ctx context.Context, response *api.Message, process ResponseProcessor[T]) error
+	// NewStreamingRequest works like NewRequest, additionally sending updates published for the request to updatesCh.
+	NewStreamingRequest(ctx context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate, responseData *T) error
+	// PublishUpdate sends an intermediate update to the streaming request a node response belongs to.
+	// Updates of requests which are not streamed, or no longer pending, are dropped.
+	PublishUpdate(ctx context.Context, response *api.Message, update handlers.UserStreamUpdate) error
 }
 
 // If aggregated != nil then the aggregated response is ready and the entry will be deleted from RequestCache.
@@ -37,6 +43,7 @@ type globalId struct {
 
 type pendingRequest[T any] struct {
 	callbackCh   chan<- handlers.UserCallbackPayload
+	updatesCh    chan<- handlers.UserStreamUpdate
 	responseData *T
 	timeoutTimer *time.Timer
 	mu           sync.Mutex
@@ -46,7 +53,11 @@ func NewRequestCache[T any](timeout time.Duration, maxCacheSize uint32) RequestC
 	return &requestCache[T]{cache: make(map[globalId]*pendingRequest[T]), timeout: timeout, maxCacheSize: maxCacheSize}
 }
 
-func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error {
+func (c *requestCache[T]) NewRequest(ctx context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error {
+	return c.NewStreamingRequest(ctx, request, callbackCh, nil, responseData)
+}
+
+func (c *requestCache[T]) NewStreamingRequest(_ context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate, responseData *T) error {
 	if request == nil {
 		return errors.New("request is nil")
 	}
@@ -66,7 +77,20 @@ func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- han
 	timer := time.AfterFunc(c.timeout, func() {
 		c.deleteAndSendOnce(key, handlers.UserCallbackPayload{Msg: request, ErrMsg: "timeout", ErrCode: api.RequestTimeoutError})
 	})
-	c.cache[key] = &pendingRequest[T]{callbackCh: callbackCh, responseData: responseData, timeoutTimer: timer}
+	c.cache[key] = &pendingRequest[T]{callbackCh: callbackCh, updatesCh: updatesCh, responseData: responseData, timeoutTimer: timer}
+	return nil
+}
+
+func (c *requestCache[T]) PublishUpdate(_ context.Context, response *api.Message, update handlers.UserStreamUpdate) error {
+	if response == nil {
+		return errors.New("response is nil")
+	}
+	c.mu.Lock()
+	entry, ok := c.cache[globalId{response.Body.Receiver, response.Body.MessageId}]
+	c.mu.Unlock()
+	if ok && entry.updatesCh != nil {
+		handlers.TrySendUpdate(entry.updatesCh, update)
+	}
 	return nil
 }
 
@@ -75,7 +99,7 @@ func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- han
 //
 //	(a) remove request from cache and send aggregated response to the user
 //	(b) update request's responseData and keep it in cache, awaiting more responses from nodes
-func (c *requestCache[T]) ProcessResponse(response *api.Message, process ResponseProcessor[T]) error {
+func (c *requestCache[T]) ProcessResponse(_ context.Context, response *api.Message, process ResponseProcessor[T]) error {
 	if response == nil {
 		return errors.New("response is nil")
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
@@ -25,11 +26,11 @@ func TestRequestCache_Simple(t *testing.T) {
 
 	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
 	initialState := &requestState{}
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	nodeResp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
 	go func() {
-		require.NoError(t, cache.ProcessResponse(nodeResp, func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
+		require.NoError(t, cache.ProcessResponse(testutils.Context(t), nodeResp, func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
 			// ready after first response
 			return &handlers.UserCallbackPayload{Msg: response}, nil, nil
 		}))
@@ -38,6 +39,30 @@ func TestRequestCache_Simple(t *testing.T) {
 	require.Equal(t, "aa", finalResp.Msg.Body.MessageId)
 }
 
+func TestRequestCache_PublishUpdate(t *testing.T) {
+	t.Parallel()
+
+	cache := common.NewRequestCache[requestState](time.Hour, 1000)
+	ctx := testutils.Context(t)
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	updatesCh := make(chan handlers.UserStreamUpdate, 1)
+
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	require.NoError(t, cache.NewStreamingRequest(ctx, req, callbackCh, updatesCh, &requestState{}))
+
+	nodeResp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
+	require.NoError(t, cache.PublishUpdate(ctx, nodeResp, handlers.UserStreamUpdate{Type: handlers.UserStreamNodeResponse, NodeAddress: "0xbb", Msg: nodeResp}))
+	// dropped when the reader doesn't keep up
+	require.NoError(t, cache.PublishUpdate(ctx, nodeResp, handlers.UserStreamUpdate{Type: handlers.UserStreamStatus}))
+	update := <-updatesCh
+	require.Equal(t, "0xbb", update.NodeAddress)
+	require.Empty(t, updatesCh)
+
+	// updates of unknown requests are ignored
+	unknownResp := &api.Message{Body: api.MessageBody{MessageId: "bb", Receiver: "0x1234"}}
+	require.NoError(t, cache.PublishUpdate(ctx, unknownResp, handlers.UserStreamUpdate{Type: handlers.UserStreamStatus}))
+}
+
 func TestRequestCache_MultiResponse(t *testing.T) {
 	t.Parallel()
 
@@ -52,7 +77,7 @@ func TestRequestCache_MultiResponse(t *testing.T) {
 		chans[i] = make(chan handlers.UserCallbackPayload)
 		reqs[i] = &api.Message{Body: api.MessageBody{MessageId: "abcd", Sender: fmt.Sprintf("sender_%d", i)}}
 		initialState := &requestState{counter: 0}
-		require.NoError(t, cache.NewRequest(reqs[i], chans[i], initialState))
+		require.NoError(t, cache.NewRequest(testutils.Context(t), reqs[i], chans[i], initialState))
 	}
 
 	for i := 0; i < nRequests; i++ {
@@ -62,7 +87,7 @@ func TestRequestCache_MultiResponse(t *testing.T) {
 			go func() {
 				n := rand.Intn(maxDelayMillis) + 1
 				time.Sleep(time.Duration(n) * time.Millisecond)
-				require.NoError(t, cache.ProcessResponse(resp, func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
+				require.NoError(t, cache.ProcessResponse(testutils.Context(t), resp, func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
 					responseData.counter++
 					if responseData.counter == nResponsesPerRequest {
 						return &handlers.UserCallbackPayload{Msg: response}, nil, nil
@@ -88,7 +113,7 @@ func TestRequestCache_Timeout(t *testing.T) {
 
 	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
 	initialState := &requestState{}
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	finalResp := <-callbackCh
 	require.Equal(t, "aa", finalResp.Msg.Body.MessageId)
@@ -103,11 +128,11 @@ func TestRequestCache_MaxSize(t *testing.T) {
 	initialState := &requestState{}
 
 	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	req.Body.MessageId = "bb"
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	req.Body.MessageId = "cc"
-	require.Error(t, cache.NewRequest(req, callbackCh, initialState))
+	require.Error(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 }
@@ -0,0 +1,94 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
+)
+
+// sharedRequestCache is a RequestCache keeping responseData in a store shared by all gateway replicas,
+// so that node responses can be aggregated by any replica. T must be JSON-serializable.
+type sharedRequestCache[T any] struct {
+	replica      *sharedstate.Replica
+	donID        string
+	timeout      time.Duration
+	maxCacheSize uint32
+}
+
+func NewSharedRequestCache[T any](replica *sharedstate.Replica, donID string, timeout time.Duration, maxCacheSize uint32) RequestCache[T] {
+	return &sharedRequestCache[T]{replica: replica, donID: donID, timeout: timeout, maxCacheSize: maxCacheSize}
+}
+
+func (c *sharedRequestCache[T]) NewRequest(ctx context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error {
+	return c.NewStreamingRequest(ctx, request, callbackCh, nil, responseData)
+}
+
+func (c *sharedRequestCache[T]) NewStreamingRequest(ctx context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate, responseData *T) error {
+	if request == nil {
+		return errors.New("request is nil")
+	}
+	if responseData == nil {
+		return errors.New("responseData is nil")
+	}
+	state, err := json.Marshal(responseData)
+	if err != nil {
+		return err
+	}
+	key := sharedstate.RequestKey{DonID: c.donID, Sender: request.Body.Sender, MessageID: request.Body.MessageId}
+	if c.maxCacheSize == 0 {
+		// a limit of 0 disables the replica limit, while the local cache rejects all requests
+		return sharedstate.ErrRequestCacheFull
+	}
+	return c.replica.NewRequest(ctx, key, request, callbackCh, updatesCh, state, c.timeout, c.maxCacheSize)
+}
+
+func (c *sharedRequestCache[T]) PublishUpdate(ctx context.Context, response *api.Message, update handlers.UserStreamUpdate) error {
+	if response == nil {
+		return errors.New("response is nil")
+	}
+	return c.replica.Publish(ctx, c.responseKey(response), update)
+}
+
+func (c *sharedRequestCache[T]) ProcessResponse(ctx context.Context, response *api.Message, process ResponseProcessor[T]) error {
+	if response == nil {
+		return errors.New("response is nil")
+	}
+	key := c.responseKey(response)
+	var processErr error
+	err := c.replica.ProcessResponse(ctx, key, func(state []byte) (*handlers.UserCallbackPayload, []byte) {
+		processErr = nil
+		var responseData T
+		if err := json.Unmarshal(state, &responseData); err != nil {
+			processErr = err
+			return nil, nil
+		}
+		aggregated, newResponseData, err := process(response, &responseData)
+		processErr = err
+		var newState []byte
+		if newResponseData != nil {
+			newState, err = json.Marshal(newResponseData)
+			if err != nil {
+				processErr = errors.Join(processErr, err)
+				return nil, nil
+			}
+		}
+		if processErr != nil {
+			return nil, newState
+		}
+		return aggregated, newState
+	})
+	if err != nil {
+		return err
+	}
+	return processErr
+}
+
+// responseKey identifies the request a node response belongs to.
+func (c *sharedRequestCache[T]) responseKey(response *api.Message) sharedstate.RequestKey {
+	return sharedstate.RequestKey{DonID: c.donID, Sender: response.Body.Receiver, MessageID: response.Body.MessageId}
+}
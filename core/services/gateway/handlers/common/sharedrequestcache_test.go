@@ -0,0 +1,120 @@
+package common_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
+)
+
+type sharedRequestState struct {
+	Counter int
+}
+
+func newSharedRequestCaches(t *testing.T, n int, timeout time.Duration, maxCacheSize uint32) []common.RequestCache[sharedRequestState] {
+	store := sharedstate.NewMemoryStore()
+	caches := make([]common.RequestCache[sharedRequestState], n)
+	for i := range caches {
+		replica := sharedstate.NewReplica(store, config.SharedStateConfig{PollIntervalMillis: 10}, logger.TestLogger(t))
+		servicetest.Run(t, replica)
+		caches[i] = common.NewSharedRequestCache[sharedRequestState](replica, "don", timeout, maxCacheSize)
+	}
+	return caches
+}
+
+func TestSharedRequestCache_ResponsesOnAnotherReplica(t *testing.T) {
+	t.Parallel()
+
+	caches := newSharedRequestCaches(t, 2, time.Hour, 1000)
+	ctx := testutils.Context(t)
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	require.NoError(t, caches[0].NewRequest(ctx, req, callbackCh, &sharedRequestState{}))
+	require.Error(t, caches[1].NewRequest(ctx, req, callbackCh, &sharedRequestState{}))
+
+	nodeResp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
+	process := func(response *api.Message, responseData *sharedRequestState) (*handlers.UserCallbackPayload, *sharedRequestState, error) {
+		responseData.Counter++
+		if responseData.Counter == 3 {
+			return &handlers.UserCallbackPayload{Msg: response}, nil, nil
+		}
+		return nil, responseData, nil
+	}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, caches[(i+1)%2].ProcessResponse(ctx, nodeResp, process))
+	}
+
+	finalResp := <-callbackCh
+	require.Equal(t, "aa", finalResp.Msg.Body.MessageId)
+	require.Error(t, caches[1].ProcessResponse(ctx, nodeResp, process))
+}
+
+func TestSharedRequestCache_ProcessorError(t *testing.T) {
+	t.Parallel()
+
+	cache := newSharedRequestCaches(t, 1, time.Hour, 1000)[0]
+	ctx := testutils.Context(t)
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	require.NoError(t, cache.NewRequest(ctx, req, callbackCh, &sharedRequestState{}))
+
+	// state updated alongside an error is kept, but the response is not aggregated
+	nodeResp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
+	errInvalid := errors.New("invalid response")
+	require.ErrorIs(t, cache.ProcessResponse(ctx, nodeResp, func(response *api.Message, responseData *sharedRequestState) (*handlers.UserCallbackPayload, *sharedRequestState, error) {
+		responseData.Counter++
+		return &handlers.UserCallbackPayload{Msg: response}, responseData, errInvalid
+	}), errInvalid)
+	require.Empty(t, callbackCh)
+
+	require.NoError(t, cache.ProcessResponse(ctx, nodeResp, func(response *api.Message, responseData *sharedRequestState) (*handlers.UserCallbackPayload, *sharedRequestState, error) {
+		require.Equal(t, 1, responseData.Counter)
+		return &handlers.UserCallbackPayload{Msg: response}, nil, nil
+	}))
+	finalResp := <-callbackCh
+	require.Equal(t, "aa", finalResp.Msg.Body.MessageId)
+}
+
+func TestSharedRequestCache_Timeout(t *testing.T) {
+	t.Parallel()
+
+	cache := newSharedRequestCaches(t, 1, time.Millisecond*10, 1000)[0]
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, &sharedRequestState{}))
+
+	finalResp := <-callbackCh
+	require.Equal(t, "aa", finalResp.Msg.Body.MessageId)
+	require.Equal(t, api.RequestTimeoutError, finalResp.ErrCode)
+}
+
+func TestSharedRequestCache_MaxSize(t *testing.T) {
+	t.Parallel()
+
+	cache := newSharedRequestCaches(t, 1, time.Hour, 2)[0]
+	ctx := testutils.Context(t)
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	require.NoError(t, cache.NewRequest(ctx, req, callbackCh, &sharedRequestState{}))
+	req.Body.MessageId = "bb"
+	require.NoError(t, cache.NewRequest(ctx, req, callbackCh, &sharedRequestState{}))
+	req.Body.MessageId = "cc"
+	require.Error(t, cache.NewRequest(ctx, req, callbackCh, &sharedRequestState{}))
+
+	require.Error(t, newSharedRequestCaches(t, 1, time.Hour, 0)[0].NewRequest(ctx, req, callbackCh, &sharedRequestState{}))
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -25,6 +26,7 @@ import (
 	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
 	fallow "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/allowlist"
 	fsub "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/subscriptions"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
 )
 
 var (
@@ -77,7 +79,7 @@ type functionsHandler struct {
 	services.StateMachine
 
 	handlerConfig              FunctionsHandlerConfig
-	donConfig                  *config.DONConfig
+	donConfig                  atomic.Pointer[config.DONConfig]
 	don                        handlers.DON
 	pendingRequests            hc.RequestCache[PendingRequest]
 	allowlist                  fallow.OnchainAllowlist
@@ -97,9 +99,47 @@ type PendingRequest struct {
 	errors     []*api.Message
 }
 
+// pendingRequestJSON is the serialized form of PendingRequest, stored by the shared request cache.
+// Sender is not part of the serialized api.Message so it is kept separately.
+type pendingRequestJSON struct {
+	Request       *api.Message            `json:"request"`
+	RequestSender string                  `json:"requestSender"`
+	Responses     map[string]*api.Message `json:"responses"`
+	Successful    []*api.Message          `json:"successful"`
+	Errors        []*api.Message          `json:"errors"`
+}
+
+func (p *PendingRequest) MarshalJSON() ([]byte, error) {
+	wire := pendingRequestJSON{Request: p.request, Responses: p.responses, Successful: p.successful, Errors: p.errors}
+	if p.request != nil {
+		wire.RequestSender = p.request.Body.Sender
+	}
+	return json.Marshal(wire)
+}
+
+func (p *PendingRequest) UnmarshalJSON(data []byte) error {
+	var wire pendingRequestJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	if wire.Request != nil {
+		wire.Request.Body.Sender = wire.RequestSender
+	}
+	for sender, response := range wire.Responses {
+		response.Body.Sender = sender
+	}
+	if wire.Responses == nil {
+		wire.Responses = make(map[string]*api.Message)
+	}
+	*p = PendingRequest{request: wire.Request, responses: wire.Responses, successful: wire.Successful, errors: wire.Errors}
+	return nil
+}
+
 var _ handlers.Handler = (*functionsHandler)(nil)
+var _ handlers.DONConfigUpdater = (*functionsHandler)(nil)
+var _ handlers.StreamingHandler = (*functionsHandler)(nil)
 
-func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, legacyChains legacyevm.LegacyChainContainer, ds sqlutil.DataSource, lggr logger.Logger) (handlers.Handler, error) {
+func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, replica *sharedstate.Replica, legacyChains legacyevm.LegacyChainContainer, ds sqlutil.DataSource, lggr logger.Logger) (handlers.Handler, error) {
 	var cfg FunctionsHandlerConfig
 	err := json.Unmarshal(handlerConfig, &cfg)
 	if err != nil {
@@ -156,7 +196,7 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 	for _, initiator := range cfg.AllowedHeartbeatInitiators {
 		allowedHeartbeatInitiators[strings.ToLower(initiator)] = struct{}{}
 	}
-	pendingRequestsCache := hc.NewRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
+	pendingRequestsCache := hc.NewSharedRequestCache[PendingRequest](replica, donConfig.DonId, time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
 	return NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, cfg.MinimumSubscriptionBalance, userRateLimiter, nodeRateLimiter, allowedHeartbeatInitiators, lggr), nil
 }
 
@@ -172,9 +212,8 @@ func NewFunctionsHandler(
 	nodeRateLimiter *hc.RateLimiter,
 	allowedHeartbeatInitiators map[string]struct{},
 	lggr logger.Logger) handlers.Handler {
-	return &functionsHandler{
+	h := &functionsHandler{
 		handlerConfig:              cfg,
-		donConfig:                  donConfig,
 		don:                        don,
 		pendingRequests:            pendingRequestsCache,
 		allowlist:                  allowlist,
@@ -186,18 +225,33 @@ func NewFunctionsHandler(
 		chStop:                     make(services.StopChan),
 		lggr:                       lggr,
 	}
+	h.donConfig.Store(donConfig)
+	return h
+}
+
+func (h *functionsHandler) UpdateDONConfig(donConfig *config.DONConfig) {
+	h.donConfig.Store(donConfig)
 }
 
 func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	return h.handleUserMessage(ctx, msg, callbackCh, nil)
+}
+
+// HandleUserStreamMessage additionally streams the response of each node as it arrives.
+func (h *functionsHandler) HandleUserStreamMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate) error {
+	return h.handleUserMessage(ctx, msg, callbackCh, updatesCh)
+}
+
+func (h *functionsHandler) handleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate) error {
 	sender := common.HexToAddress(msg.Body.Sender)
 	if h.allowlist != nil && !h.allowlist.Allow(sender) {
 		h.lggr.Debugw("received a message from a non-allowlisted address", "sender", msg.Body.Sender)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+		promHandlerError.WithLabelValues(h.donConfig.Load().DonId, ErrNotAllowlisted.Error()).Inc()
 		return ErrNotAllowlisted
 	}
 	if h.userRateLimiter != nil && !h.userRateLimiter.Allow(msg.Body.Sender) {
 		h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
+		promHandlerError.WithLabelValues(h.donConfig.Load().DonId, ErrRateLimited.Error()).Inc()
 		return ErrRateLimited
 	}
 	if msg.Body.Method == MethodSecretsSet && h.subscriptions != nil && h.minimumBalance != nil {
@@ -215,31 +269,31 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 	}
 	switch msg.Body.Method {
 	case MethodSecretsSet, MethodSecretsList:
-		return h.handleRequest(ctx, msg, callbackCh)
+		return h.handleRequest(ctx, msg, callbackCh, updatesCh)
 	case MethodHeartbeat:
 		if _, ok := h.allowedHeartbeatInitiators[msg.Body.Sender]; !ok {
 			h.lggr.Debugw("received heartbeat request from a non-allowed sender", "sender", msg.Body.Sender)
-			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+			promHandlerError.WithLabelValues(h.donConfig.Load().DonId, ErrNotAllowlisted.Error()).Inc()
 			return ErrUnsupportedMethod
 		}
-		return h.handleRequest(ctx, msg, callbackCh)
+		return h.handleRequest(ctx, msg, callbackCh, updatesCh)
 	default:
 		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrUnsupportedMethod.Error()).Inc()
+		promHandlerError.WithLabelValues(h.donConfig.Load().DonId, ErrUnsupportedMethod.Error()).Inc()
 		return ErrUnsupportedMethod
 	}
 }
 
-func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate) error {
 	h.lggr.Debugw("handleRequest: processing message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId)
-	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
+	err := h.pendingRequests.NewStreamingRequest(ctx, msg, callbackCh, updatesCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
 	if err != nil {
 		h.lggr.Warnw("handleRequest: error adding new request", "sender", msg.Body.Sender, "err", err)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
+		promHandlerError.WithLabelValues(h.donConfig.Load().DonId, err.Error()).Inc()
 		return err
 	}
 	// Send to all nodes.
-	for _, member := range h.donConfig.Members {
+	for _, member := range h.donConfig.Load().Members {
 		err := h.don.SendToNode(ctx, member.Address, msg)
 		if err != nil {
 			h.lggr.Debugw("handleRequest: failed to send to a node", "node", member.Address, "err", err)
@@ -256,15 +310,25 @@ func (h *functionsHandler) HandleNodeMessage(ctx context.Context, msg *api.Messa
 	}
 	switch msg.Body.Method {
 	case MethodSecretsSet, MethodSecretsList:
-		return h.pendingRequests.ProcessResponse(msg, h.processSecretsResponse)
+		h.publishNodeResponse(ctx, msg, nodeAddr)
+		return h.pendingRequests.ProcessResponse(ctx, msg, h.processSecretsResponse)
 	case MethodHeartbeat:
-		return h.pendingRequests.ProcessResponse(msg, h.processHeartbeatResponse)
+		h.publishNodeResponse(ctx, msg, nodeAddr)
+		return h.pendingRequests.ProcessResponse(ctx, msg, h.processHeartbeatResponse)
 	default:
 		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
 		return ErrUnsupportedMethod
 	}
 }
 
+// publishNodeResponse streams a node response before it is aggregated.
+func (h *functionsHandler) publishNodeResponse(ctx context.Context, msg *api.Message, nodeAddr string) {
+	update := handlers.UserStreamUpdate{Type: handlers.UserStreamNodeResponse, NodeAddress: nodeAddr, Msg: msg}
+	if err := h.pendingRequests.PublishUpdate(ctx, msg, update); err != nil {
+		h.lggr.Debugw("failed to publish node response", "nodeAddr", nodeAddr, "id", msg.Body.MessageId, "err", err)
+	}
+}
+
 // Conforms to ResponseProcessor[*PendingRequest]
 func (h *functionsHandler) processSecretsResponse(response *api.Message, responseData *PendingRequest) (*handlers.UserCallbackPayload, *PendingRequest, error) {
 	if _, exists := responseData.responses[response.Body.Sender]; exists {
@@ -274,6 +338,7 @@ func (h *functionsHandler) processSecretsResponse(response *api.Message, respons
 		return nil, responseData, errors.New("invalid method")
 	}
 	responseData.responses[response.Body.Sender] = response
+	donConfig := h.donConfig.Load()
 	var responsePayload ResponseBase
 	err := json.Unmarshal(response.Body.Payload, &responsePayload)
 	if err != nil {
@@ -283,14 +348,14 @@ func (h *functionsHandler) processSecretsResponse(response *api.Message, respons
 	// user response is ready with either F+1 successes or N-F failures
 	if responsePayload.Success {
 		responseData.successful = append(responseData.successful, response)
-		if len(responseData.successful) >= h.donConfig.F+1 {
+		if len(responseData.successful) >= donConfig.F+1 {
 			// return success to the user
 			callbackPayload, err := newSecretsResponse(responseData.request, true, responseData.successful)
 			return callbackPayload, responseData, err
 		}
 	} else {
 		responseData.errors = append(responseData.errors, response)
-		if len(responseData.errors) >= len(h.donConfig.Members)-h.donConfig.F {
+		if len(responseData.errors) >= len(donConfig.Members)-donConfig.F {
 			// return error to the user
 			callbackPayload, err := newSecretsResponse(responseData.request, false, responseData.errors)
 			return callbackPayload, responseData, err
@@ -338,7 +403,7 @@ func (h *functionsHandler) processHeartbeatResponse(response *api.Message, respo
 	responseData.responses[response.Body.Sender] = response
 
 	// user response is ready with F+1 node responses
-	if len(responseData.responses) >= h.donConfig.F+1 {
+	if len(responseData.responses) >= h.donConfig.Load().F+1 {
 		var responseList []*api.Message
 		for _, response := range responseData.responses {
 			responseList = append(responseList, response)
@@ -25,9 +25,15 @@ import (
 	allowlist_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/allowlist/mocks"
 	subscriptions_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/subscriptions/mocks"
 	handlers_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
 )
 
 func newFunctionsHandlerForATestDON(t *testing.T, nodes []gc.TestNode, requestTimeout time.Duration, heartbeatSender string) (handlers.Handler, *handlers_mocks.DON, *allowlist_mocks.OnchainAllowlist, *subscriptions_mocks.OnchainSubscriptions) {
+	pendingRequestsCache := hc.NewRequestCache[functions.PendingRequest](requestTimeout, 1000)
+	return newFunctionsHandlerWithCache(t, nodes, pendingRequestsCache, heartbeatSender)
+}
+
+func newFunctionsHandlerWithCache(t *testing.T, nodes []gc.TestNode, pendingRequestsCache hc.RequestCache[functions.PendingRequest], heartbeatSender string) (handlers.Handler, *handlers_mocks.DON, *allowlist_mocks.OnchainAllowlist, *subscriptions_mocks.OnchainSubscriptions) {
 	cfg := functions.FunctionsHandlerConfig{}
 	donConfig := &config.DONConfig{
 		Members: []config.NodeConfig{},
@@ -49,7 +55,6 @@ func newFunctionsHandlerForATestDON(t *testing.T, nodes []gc.TestNode, requestTi
 	require.NoError(t, err)
 	nodeRateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100.0, GlobalBurst: 100, PerSenderRPS: 100.0, PerSenderBurst: 100})
 	require.NoError(t, err)
-	pendingRequestsCache := hc.NewRequestCache[functions.PendingRequest](requestTimeout, 1000)
 	allowedHeartbeatInititors := map[string]struct{}{heartbeatSender: {}}
 	handler := functions.NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, minBalance, userRateLimiter, nodeRateLimiter, allowedHeartbeatInititors, logger.TestLogger(t))
 	return handler, don, allowlist, subscriptions
@@ -84,7 +89,7 @@ func sendNodeReponses(t *testing.T, handler handlers.Handler, userRequestMsg api
 func TestFunctionsHandler_Minimal(t *testing.T) {
 	t.Parallel()
 
-	handler, err := functions.NewFunctionsHandlerFromConfig(json.RawMessage("{}"), &config.DONConfig{}, nil, nil, nil, logger.TestLogger(t))
+	handler, err := functions.NewFunctionsHandlerFromConfig(json.RawMessage("{}"), &config.DONConfig{}, nil, nil, nil, nil, logger.TestLogger(t))
 	require.NoError(t, err)
 
 	// empty message should always error out
@@ -96,7 +101,7 @@ func TestFunctionsHandler_Minimal(t *testing.T) {
 func TestFunctionsHandler_CleanStartAndClose(t *testing.T) {
 	t.Parallel()
 
-	handler, err := functions.NewFunctionsHandlerFromConfig(json.RawMessage("{}"), &config.DONConfig{}, nil, nil, nil, logger.TestLogger(t))
+	handler, err := functions.NewFunctionsHandlerFromConfig(json.RawMessage("{}"), &config.DONConfig{}, nil, nil, nil, nil, logger.TestLogger(t))
 	require.NoError(t, err)
 
 	servicetest.Run(t, handler)
@@ -146,6 +151,71 @@ func TestFunctionsHandler_HandleUserMessage_SecretsSet(t *testing.T) {
 	}
 }
 
+func TestFunctionsHandler_HandleUserMessage_SecretsSet_SharedState(t *testing.T) {
+	t.Parallel()
+
+	// responses are aggregated by a handler of another gateway replica
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	store := sharedstate.NewMemoryStore()
+	var replicaHandlers [2]handlers.Handler
+	for i := range replicaHandlers {
+		replica := sharedstate.NewReplica(store, config.SharedStateConfig{PollIntervalMillis: 10}, logger.TestLogger(t))
+		servicetest.Run(t, replica)
+		cache := hc.NewSharedRequestCache[functions.PendingRequest](replica, "don_id", time.Hour, 1000)
+		handler, don, allowlist, subscriptions := newFunctionsHandlerWithCache(t, nodes, cache, user.Address)
+		allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil).Maybe()
+		subscriptions.On("GetMaxUserBalance", common.HexToAddress(user.Address)).Return(big.NewInt(1000), nil).Maybe()
+		don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+		replicaHandlers[i] = handler
+	}
+	userRequestMsg := newSignedMessage(t, "1234", "secrets_set", "don_id", user.PrivateKey)
+
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, replicaHandlers[0].HandleUserMessage(testutils.Context(t), &userRequestMsg, callbackCh))
+	sendNodeReponses(t, replicaHandlers[1], userRequestMsg, nodes, []bool{false, true, true})
+
+	response := <-callbackCh
+	require.Equal(t, api.NoError, response.ErrCode)
+	require.Equal(t, userRequestMsg.Body.MessageId, response.Msg.Body.MessageId)
+	require.Equal(t, user.Address, response.Msg.Body.Receiver)
+	var payload functions.CombinedResponse
+	require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &payload))
+	require.True(t, payload.Success)
+	require.Len(t, payload.NodeResponses, 2)
+}
+
+func TestFunctionsHandler_HandleUserStreamMessage_SecretsSet(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don, allowlist, subscriptions := newFunctionsHandlerForATestDON(t, nodes, time.Hour*24, user.Address)
+	userRequestMsg := newSignedMessage(t, "1234", "secrets_set", "don_id", user.PrivateKey)
+
+	allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+	subscriptions.On("GetMaxUserBalance", common.HexToAddress(user.Address)).Return(big.NewInt(1000), nil)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	updatesCh := make(chan handlers.UserStreamUpdate, 10)
+	streamingHandler, ok := handler.(handlers.StreamingHandler)
+	require.True(t, ok)
+	require.NoError(t, streamingHandler.HandleUserStreamMessage(testutils.Context(t), &userRequestMsg, callbackCh, updatesCh))
+	sendNodeReponses(t, handler, userRequestMsg, nodes, []bool{true, true})
+
+	// each node response is streamed before the aggregated response
+	for id := 0; id < 2; id++ {
+		update := <-updatesCh
+		require.Equal(t, handlers.UserStreamNodeResponse, update.Type)
+		require.Equal(t, nodes[id].Address, update.NodeAddress)
+		require.Equal(t, userRequestMsg.Body.MessageId, update.Msg.Body.MessageId)
+	}
+	response := <-callbackCh
+	require.Equal(t, api.NoError, response.ErrCode)
+	var payload functions.CombinedResponse
+	require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &payload))
+	require.True(t, payload.Success)
+	require.Len(t, payload.NodeResponses, 2)
+}
+
 func TestFunctionsHandler_HandleUserMessage_Heartbeat(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"go.uber.org/multierr"
 
@@ -13,7 +14,7 @@ import (
 
 // DummyHandler forwards each request/response without doing any checks.
 type dummyHandler struct {
-	donConfig      *config.DONConfig
+	donConfig      atomic.Pointer[config.DONConfig]
 	don            DON
 	savedCallbacks map[string]*savedCallback
 	mu             sync.Mutex
@@ -26,14 +27,20 @@ type savedCallback struct {
 }
 
 var _ Handler = (*dummyHandler)(nil)
+var _ DONConfigUpdater = (*dummyHandler)(nil)
 
 func NewDummyHandler(donConfig *config.DONConfig, don DON, lggr logger.Logger) (Handler, error) {
-	return &dummyHandler{
-		donConfig:      donConfig,
+	d := &dummyHandler{
 		don:            don,
 		savedCallbacks: make(map[string]*savedCallback),
 		lggr:           lggr.Named("DummyHandler." + donConfig.DonId),
-	}, nil
+	}
+	d.donConfig.Store(donConfig)
+	return d, nil
+}
+
+func (d *dummyHandler) UpdateDONConfig(donConfig *config.DONConfig) {
+	d.donConfig.Store(donConfig)
 }
 
 func (d *dummyHandler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- UserCallbackPayload) error {
@@ -44,7 +51,7 @@ func (d *dummyHandler) HandleUserMessage(ctx context.Context, msg *api.Message,
 
 	var err error
 	// Send to all nodes.
-	for _, member := range d.donConfig.Members {
+	for _, member := range d.donConfig.Load().Members {
 		err = multierr.Combine(err, don.SendToNode(ctx, member.Address, msg))
 	}
 	return err
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
@@ -15,6 +16,36 @@ type UserCallbackPayload struct {
 	ErrMsg  string
 }
 
+// UserStreamUpdateType identifies the kind of an intermediate update of a user request.
+type UserStreamUpdateType string
+
+const (
+	// UserStreamStatus reports progress of a request, e.g. of a long-running workflow execution.
+	UserStreamStatus UserStreamUpdateType = "status"
+	// UserStreamNodeResponse carries a response of a single node, before responses are aggregated.
+	UserStreamNodeResponse UserStreamUpdateType = "node_response"
+)
+
+// UserStreamUpdate is an intermediate update of a user request sent to HandleUserStreamMessage(),
+// delivered before the final UserCallbackPayload.
+type UserStreamUpdate struct {
+	Type        UserStreamUpdateType
+	NodeAddress string
+	Status      string
+	Msg         *api.Message
+}
+
+// TrySendUpdate sends an update without blocking. Updates are dropped when the reader can't keep up,
+// the final response is always delivered on the callback channel.
+func TrySendUpdate(updatesCh chan<- UserStreamUpdate, update UserStreamUpdate) bool {
+	select {
+	case updatesCh <- update:
+		return true
+	default:
+		return false
+	}
+}
+
 // Handler implements service-specific logic for managing messages from users and nodes.
 // There is one Handler object created for each DON.
 //
@@ -36,6 +67,21 @@ type Handler interface {
 	HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error
 }
 
+// StreamingHandler is optionally implemented by Handlers able to report partial results of user requests.
+type StreamingHandler interface {
+	// HandleUserStreamMessage works like HandleUserMessage and additionally sends intermediate updates
+	// to updatesCh until the final response is sent to callbackCh. updatesCh is never closed and
+	// sends to it must not block (see TrySendUpdate).
+	HandleUserStreamMessage(ctx context.Context, msg *api.Message, callbackCh chan<- UserCallbackPayload, updatesCh chan<- UserStreamUpdate) error
+}
+
+// DONConfigUpdater is optionally implemented by Handlers that support changes of DON
+// membership at runtime, e.g. when members are derived from the capabilities registry.
+type DONConfigUpdater interface {
+	// Thread-safe. The passed config must not be modified afterwards.
+	UpdateDONConfig(donConfig *config.DONConfig)
+}
+
 // Representation of a DON from a Handler's perspective.
 type DON interface {
 	// Thread-safe
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +15,8 @@ import (
 	"testing"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/jonboulle/clockwork"
 	"github.com/onsi/gomega"
 	"github.com/pelletier/go-toml/v2"
@@ -26,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 const gatewayConfigTemplate = `
@@ -100,10 +106,13 @@ type client struct {
 	privateKey *ecdsa.PrivateKey
 	connector  connector.GatewayConnector
 	done       atomic.Bool
+	// lastSender is the sender of the last message accepted by the connector
+	lastSender atomic.Value
 }
 
 func (c *client) HandleGatewayMessage(ctx context.Context, gatewayId string, msg *api.Message) {
 	c.done.Store(true)
+	c.lastSender.Store(msg.Body.Sender)
 	// send back user's message without re-signing - should be ignored by the Gateway
 	_ = c.connector.SendToGateway(ctx, gatewayId, msg)
 	// send back a correct response
@@ -150,7 +159,7 @@ func TestIntegration_Gateway_NoFullNodes_BasicConnectionAndMessage(t *testing.T)
 		MaxResponseBytes: 1000,
 	}, lggr)
 	require.NoError(t, err)
-	gateway, err := gateway.NewGatewayFromConfig(parseGatewayConfig(t, gatewayConfig), gateway.NewHandlerFactory(nil, nil, c, lggr), lggr)
+	gateway, err := gateway.NewGatewayFromConfig(parseGatewayConfig(t, gatewayConfig), gateway.NewHandlerFactory(nil, nil, c, lggr), nil, nil, lggr)
 	require.NoError(t, err)
 	servicetest.Run(t, gateway)
 	userPort, nodePort := gateway.GetUserPort(), gateway.GetNodePort()
@@ -192,6 +201,86 @@ func TestIntegration_Gateway_NoFullNodes_BasicConnectionAndMessage(t *testing.T)
 	require.Equal(t, nodeResponsePayload, string(respMsg.Body.Payload))
 }
 
+// keySigner signs messages like keystore.Eth would with a single key
+type keySigner struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+func (s keySigner) SignMessage(_ context.Context, address gethcommon.Address, data []byte) ([]byte, error) {
+	if address != crypto.PubkeyToAddress(s.privateKey.PublicKey) {
+		return nil, errors.New("key not found")
+	}
+	return utils.GenerateEthSignature(s.privateKey, data)
+}
+
+func TestIntegration_Gateway_APIKey_UnsignedRequestAcceptedByNode(t *testing.T) {
+	t.Parallel()
+
+	testWallets := common.NewTestNodes(t, 2)
+	nodeKeys := testWallets[0]
+	apiKeySender := testWallets[1]
+	apiKey := "secret-key"
+	apiKeyHash := sha256.Sum256([]byte(apiKey))
+
+	// Launch Gateway with an API key sent as a key from its keystore
+	lggr := logger.TestLogger(t)
+	gatewayConfig := fmt.Sprintf(gatewayConfigTemplate, nodeKeys.Address) + fmt.Sprintf(`
+[APIKeys]
+Required = true
+
+[[APIKeys.Keys]]
+Name = "test"
+KeyHash = "%s"
+Sender = "%s"
+`, hex.EncodeToString(apiKeyHash[:]), apiKeySender.Address)
+	c, err := network.NewHTTPClient(network.HTTPClientConfig{
+		DefaultTimeout:   5 * time.Second,
+		MaxResponseBytes: 1000,
+	}, lggr)
+	require.NoError(t, err)
+	gateway, err := gateway.NewGatewayFromConfig(parseGatewayConfig(t, gatewayConfig), gateway.NewHandlerFactory(nil, nil, c, lggr), nil, keySigner{apiKeySender.PrivateKey}, lggr)
+	require.NoError(t, err)
+	servicetest.Run(t, gateway)
+	userUrl := fmt.Sprintf("http://localhost:%d/user", gateway.GetUserPort())
+	nodeUrl := fmt.Sprintf("ws://localhost:%d/node", gateway.GetNodePort())
+
+	// Launch Connector, which only passes messages with a valid signature to its handlers
+	client := &client{privateKey: nodeKeys.PrivateKey}
+	connector, err := connector.NewGatewayConnector(parseConnectorConfig(t, nodeConfigTemplate, nodeKeys.Address, nodeUrl), client, clockwork.NewRealClock(), lggr)
+	require.NoError(t, err)
+	require.NoError(t, connector.AddHandler([]string{"test"}, client))
+	client.connector = connector
+	servicetest.Run(t, connector)
+
+	newUnsignedRequest := func(messageId string) *http.Request {
+		msg := &api.Message{Body: api.MessageBody{MessageId: messageId, Method: "test", DonId: "test_don"}}
+		rawMsg, err2 := (&api.JsonRPCCodec{}).EncodeRequest(msg)
+		require.NoError(t, err2)
+		req, err2 := http.NewRequestWithContext(testutils.Context(t), "POST", userUrl, bytes.NewBuffer(rawMsg))
+		require.NoError(t, err2)
+		req.Header.Set(network.APIKeyHeader, apiKey)
+		return req
+	}
+
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		_, _ = (&http.Client{}).Do(newUnsignedRequest(messageId1))
+		return client.done.Load()
+	}, testutils.WaitTimeout(t), testutils.TestInterval).Should(gomega.Equal(true))
+	require.Equal(t, apiKeySender.Address, client.lastSender.Load())
+
+	resp, err := (&http.Client{}).Do(newUnsignedRequest(messageId2))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	rawResp, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	respMsg, err := (&api.JsonRPCCodec{}).DecodeResponse(rawResp)
+	require.NoError(t, err)
+	require.NoError(t, respMsg.Validate())
+	require.Equal(t, strings.ToLower(nodeKeys.Address), respMsg.Body.Sender)
+	require.Equal(t, messageId2, respMsg.Body.MessageId)
+	require.Equal(t, nodeResponsePayload, string(respMsg.Body.Payload))
+}
+
 func newHttpRequestObject(t *testing.T, messageId string, userUrl string, signerKey *ecdsa.PrivateKey) *http.Request {
 	msg := &api.Message{Body: api.MessageBody{MessageId: messageId, Method: "test", DonId: "test_don"}}
 	require.NoError(t, msg.Sign(signerKey))
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/gorilla/websocket"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -39,6 +41,11 @@ type HTTPServerConfig struct {
 	WriteTimeoutMillis   uint32
 	RequestTimeoutMillis uint32
 	MaxRequestBytes      int64
+	// StreamPath serves requests streaming partial responses over SSE or WebSocket, if the
+	// request handler supports it. Empty disables streaming.
+	StreamPath string
+	// StreamTimeoutMillis limits the duration of streaming requests. Defaults to RequestTimeoutMillis.
+	StreamTimeoutMillis uint32
 }
 
 type httpServer struct {
@@ -47,6 +54,7 @@ type httpServer struct {
 	listener          net.Listener
 	server            *http.Server
 	handler           HTTPRequestHandler
+	upgrader          *websocket.Upgrader
 	doneCh            chan struct{}
 	cancelBaseContext context.CancelFunc
 	lggr              logger.Logger
@@ -55,8 +63,30 @@ type httpServer struct {
 const (
 	HealthCheckPath     = "/health"
 	HealthCheckResponse = "OK"
+	// APIKeyHeader carries the optional API key of a user request, see APIKeyFromContext()
+	APIKeyHeader = "X-Api-Key"
 )
 
+type apiKeyCtxKey struct{}
+
+// APIKeyFromContext returns the API key sent with the request processed by an HTTPRequestHandler, if any.
+func APIKeyFromContext(ctx context.Context) string {
+	apiKey, _ := ctx.Value(apiKeyCtxKey{}).(string)
+	return apiKey
+}
+
+// ContextWithAPIKey returns a copy of ctx carrying the API key of a request.
+func ContextWithAPIKey(ctx context.Context, apiKey string) context.Context {
+	return context.WithValue(ctx, apiKeyCtxKey{}, apiKey)
+}
+
+func requestContext(r *http.Request) context.Context {
+	if apiKey := r.Header.Get(APIKeyHeader); apiKey != "" {
+		return ContextWithAPIKey(r.Context(), apiKey)
+	}
+	return r.Context()
+}
+
 func NewHttpServer(config *HTTPServerConfig, lggr logger.Logger) HttpServer {
 	baseCtx, cancelBaseCtx := context.WithCancel(context.Background())
 	server := &httpServer{
@@ -67,6 +97,12 @@ func NewHttpServer(config *HTTPServerConfig, lggr logger.Logger) HttpServer {
 	}
 	mux := http.NewServeMux()
 	mux.Handle(config.Path, http.HandlerFunc(server.handleRequest))
+	if config.StreamPath != "" {
+		server.upgrader = &websocket.Upgrader{
+			HandshakeTimeout: time.Duration(config.ReadTimeoutMillis) * time.Millisecond,
+		}
+		mux.Handle(config.StreamPath, http.HandlerFunc(server.handleStreamRequest))
+	}
 	mux.Handle(HealthCheckPath, http.HandlerFunc(server.handleHealthCheck))
 	server.server = &http.Server{
 		Addr:              fmt.Sprintf("%s:%d", config.Host, config.Port),
@@ -96,7 +132,7 @@ func (s *httpServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	requestCtx := r.Context()
+	requestCtx := requestContext(r)
 	if s.config.RequestTimeoutMillis > 0 {
 		var cancel context.CancelFunc
 		requestCtx, cancel = context.WithTimeout(requestCtx, time.Duration(s.config.RequestTimeoutMillis)*time.Millisecond)
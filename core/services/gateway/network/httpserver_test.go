@@ -2,6 +2,7 @@ package network_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -69,6 +70,24 @@ func TestHTTPServer_HandleRequest_Correct(t *testing.T) {
 	require.Equal(t, []byte("response"), respBytes)
 }
 
+func TestHTTPServer_HandleRequest_APIKey(t *testing.T) {
+	t.Parallel()
+	server, handler, url := startNewServer(t, 100_000, 100_000)
+	defer server.Close()
+
+	handler.On("ProcessRequest", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		require.Equal(t, "secret", network.APIKeyFromContext(args.Get(0).(context.Context)))
+	}).Return([]byte("response"), 200)
+
+	req, err := http.NewRequestWithContext(testutils.Context(t), "POST", url, bytes.NewBuffer([]byte("0123456789")))
+	require.NoError(t, err)
+	req.Header.Set(network.APIKeyHeader, "secret")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func TestHTTPServer_HandleRequest_RequestBodyTooBig(t *testing.T) {
 	t.Parallel()
 	server, _, url := startNewServer(t, 5, 100_000)
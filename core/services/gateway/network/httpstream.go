@@ -0,0 +1,149 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// StreamEvent is sent to the user for each update of a streaming request.
+type StreamEvent struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// StreamingRequestHandler is optionally implemented by HTTPRequestHandlers able to stream partial responses.
+type StreamingRequestHandler interface {
+	// ProcessStreamRequest calls send for each event, in order, until the request is complete or ctx is done.
+	// Returning an error from send aborts the request.
+	ProcessStreamRequest(ctx context.Context, rawRequest []byte, send func(event StreamEvent) error)
+}
+
+// handleStreamRequest serves a streaming request over WebSocket, if the client requests an upgrade,
+// or Server-Sent Events otherwise. With WebSocket the request is the first message sent by the client,
+// with SSE it is the POST body.
+func (s *httpServer) handleStreamRequest(w http.ResponseWriter, r *http.Request) {
+	handler, ok := s.handler.(StreamingRequestHandler)
+	if !ok {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+	if websocket.IsWebSocketUpgrade(r) {
+		s.handleWebSocketStream(w, r, handler)
+		return
+	}
+	s.handleSSEStream(w, r, handler)
+}
+
+func (s *httpServer) streamContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeoutMillis := s.config.StreamTimeoutMillis
+	if timeoutMillis == 0 {
+		timeoutMillis = s.config.RequestTimeoutMillis
+	}
+	if timeoutMillis == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Duration(timeoutMillis)*time.Millisecond)
+}
+
+func (s *httpServer) handleSSEStream(w http.ResponseWriter, r *http.Request, handler StreamingRequestHandler) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	source := http.MaxBytesReader(nil, r.Body, s.config.MaxRequestBytes)
+	rawMessage, err := io.ReadAll(source)
+	if err != nil {
+		s.lggr.Error("error reading stream request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	// the write timeout of the server applies to each event instead of the whole stream
+	controller := http.NewResponseController(w)
+	writeTimeout := time.Duration(s.config.WriteTimeoutMillis) * time.Millisecond
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	requestCtx, cancel := s.streamContext(requestContext(r))
+	defer cancel()
+	handler.ProcessStreamRequest(requestCtx, rawMessage, func(event StreamEvent) error {
+		if writeTimeout > 0 {
+			if err := controller.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, event.Data); err != nil {
+			s.lggr.Debugw("error when writing stream event", "err", err)
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+}
+
+func (s *httpServer) handleWebSocketStream(w http.ResponseWriter, r *http.Request, handler StreamingRequestHandler) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already responded with an error
+		s.lggr.Debugw("failed websocket upgrade of stream request", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	if s.config.MaxRequestBytes > 0 {
+		conn.SetReadLimit(s.config.MaxRequestBytes)
+	}
+	if s.config.ReadTimeoutMillis > 0 {
+		if err = conn.SetReadDeadline(time.Now().Add(time.Duration(s.config.ReadTimeoutMillis) * time.Millisecond)); err != nil {
+			return
+		}
+	}
+	_, rawMessage, err := conn.ReadMessage()
+	if err != nil {
+		s.lggr.Debugw("error reading stream request", "err", err)
+		return
+	}
+	writeTimeout := time.Duration(s.config.WriteTimeoutMillis) * time.Millisecond
+
+	requestCtx, cancel := s.streamContext(requestContext(r))
+	defer cancel()
+	// hijacked connections don't cancel the request context, the client closing the connection does
+	if err = conn.SetReadDeadline(time.Time{}); err != nil {
+		return
+	}
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+	handler.ProcessStreamRequest(requestCtx, rawMessage, func(event StreamEvent) error {
+		if writeTimeout > 0 {
+			if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+				return err
+			}
+		}
+		if err := conn.WriteJSON(event); err != nil {
+			s.lggr.Debugw("error when writing stream event", "err", err)
+			return err
+		}
+		return nil
+	})
+	closeMsg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	_ = conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(time.Second))
+}
@@ -0,0 +1,116 @@
+package network_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network/mocks"
+)
+
+const HTTPTestStreamPath = "/test_stream_path"
+
+// echoStreamHandler streams the request back, followed by a "done" event.
+type echoStreamHandler struct {
+	*mocks.HTTPRequestHandler
+}
+
+func (h *echoStreamHandler) ProcessStreamRequest(_ context.Context, rawRequest []byte, send func(event network.StreamEvent) error) {
+	data, _ := json.Marshal(string(rawRequest))
+	if send(network.StreamEvent{Type: "echo", Data: data}) != nil {
+		return
+	}
+	_ = send(network.StreamEvent{Type: "done", Data: json.RawMessage(`{}`)})
+}
+
+func startNewStreamServer(t *testing.T, handler network.HTTPRequestHandler) (httpURL string, wsURL string) {
+	config := &network.HTTPServerConfig{
+		Host:                 HTTPTestHost,
+		Port:                 0,
+		Path:                 HTTPTestPath,
+		StreamPath:           HTTPTestStreamPath,
+		ContentTypeHeader:    "application/jsonrpc",
+		ReadTimeoutMillis:    10_000,
+		WriteTimeoutMillis:   10_000,
+		RequestTimeoutMillis: 10_000,
+		MaxRequestBytes:      100_000,
+	}
+	server := network.NewHttpServer(config, logger.TestLogger(t))
+	server.SetHTTPRequestHandler(handler)
+	require.NoError(t, server.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, server.Close()) })
+
+	address := fmt.Sprintf("%s:%d%s", HTTPTestHost, server.GetPort(), HTTPTestStreamPath)
+	return "http://" + address, "ws://" + address
+}
+
+func TestHTTPServer_StreamSSE(t *testing.T) {
+	t.Parallel()
+	url, _ := startNewStreamServer(t, &echoStreamHandler{mocks.NewHTTPRequestHandler(t)})
+
+	resp := sendRequest(t, url, []byte("0123456789"))
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	var lines []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []string{"event: echo", `data: "0123456789"`, "", "event: done", "data: {}", ""}, lines)
+}
+
+func TestHTTPServer_StreamSSE_MethodNotAllowed(t *testing.T) {
+	t.Parallel()
+	url, _ := startNewStreamServer(t, &echoStreamHandler{mocks.NewHTTPRequestHandler(t)})
+
+	req, err := http.NewRequestWithContext(testutils.Context(t), http.MethodGet, url, nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestHTTPServer_StreamWebSocket(t *testing.T) {
+	t.Parallel()
+	_, url := startNewStreamServer(t, &echoStreamHandler{mocks.NewHTTPRequestHandler(t)})
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(testutils.Context(t), url, nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	defer conn.Close()
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("0123456789")))
+
+	var event network.StreamEvent
+	require.NoError(t, conn.ReadJSON(&event))
+	require.Equal(t, "echo", event.Type)
+	require.Equal(t, `"0123456789"`, string(event.Data))
+	require.NoError(t, conn.ReadJSON(&event))
+	require.Equal(t, "done", event.Type)
+
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+}
+
+func TestHTTPServer_Stream_NotSupported(t *testing.T) {
+	t.Parallel()
+	url, _ := startNewStreamServer(t, mocks.NewHTTPRequestHandler(t))
+
+	resp := sendRequest(t, url, []byte("0123456789"))
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+	require.False(t, strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream"))
+}
@@ -0,0 +1,144 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
+)
+
+const (
+	defaultWindow = 24 * time.Hour
+	// bucketsPerWindow sets the granularity of the rolling window
+	bucketsPerWindow = 24
+)
+
+// ExceededError is returned for requests exceeding a quota.
+type ExceededError struct {
+	Quota string
+	// RetryAfter is the earliest time after which the request fits in the quota
+	RetryAfter time.Duration
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("%s quota exceeded", e.Quota)
+}
+
+// Limiter enforces per-sender and per-method quotas over a rolling window. Usage is counted in
+// buckets of a sharedstate.Store, which lets gateway replicas sharing a store enforce the same quotas.
+// Usage is checked and counted atomically per Limiter only, so concurrent requests handled by
+// different replicas may slightly exceed a quota.
+type Limiter struct {
+	config config.QuotaConfig
+	store  sharedstate.Store
+	clock  clockwork.Clock
+	window time.Duration
+	bucket time.Duration
+	mu     sync.Mutex
+}
+
+func NewLimiter(cfg config.QuotaConfig, store sharedstate.Store, clock clockwork.Clock) *Limiter {
+	window := time.Duration(cfg.WindowSec) * time.Second
+	if window == 0 {
+		window = defaultWindow
+	}
+	bucket := max(window/bucketsPerWindow, time.Second)
+	return &Limiter{config: cfg, store: store, clock: clock, window: window, bucket: bucket}
+}
+
+// Enabled reports whether any quota is configured.
+func Enabled(cfg config.QuotaConfig) bool {
+	if enabled(cfg.PerSender) {
+		return true
+	}
+	for _, limits := range cfg.PerMethod {
+		if enabled(limits) {
+			return true
+		}
+	}
+	return false
+}
+
+type quotaCheck struct {
+	name   string
+	key    string
+	limits config.QuotaLimits
+}
+
+// Allow counts a request of sender to method if it fits in all quotas, or returns an *ExceededError.
+// Rejected requests are not counted.
+func (l *Limiter) Allow(ctx context.Context, sender string, method string, payloadBytes int) error {
+	var checks []quotaCheck
+	if enabled(l.config.PerSender) {
+		checks = append(checks, quotaCheck{name: "sender", key: "sender/" + sender, limits: l.config.PerSender})
+	}
+	if limits, ok := l.config.PerMethod[method]; ok && enabled(limits) {
+		checks = append(checks, quotaCheck{name: "method " + method, key: "method/" + method + "/" + sender, limits: limits})
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+	usage := sharedstate.UsageBucket{Requests: 1, PayloadBytes: uint64(payloadBytes)}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock.Now()
+	for _, check := range checks {
+		buckets, err := l.store.Usage(ctx, check.key, now)
+		if err != nil {
+			return err
+		}
+		if retryAfter, exceeded := l.retryAfter(buckets, check.limits, usage, now); exceeded {
+			return &ExceededError{Quota: check.name, RetryAfter: retryAfter}
+		}
+	}
+	usage.Start = now.Truncate(l.bucket)
+	usage.ExpiresAt = usage.Start.Add(l.window)
+	for _, check := range checks {
+		if err := l.store.AddUsage(ctx, check.key, usage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retryAfter reports whether usage exceeds the limits on top of the buckets and if so, how long it takes
+// for enough buckets to expire. Requests larger than the limits never fit and are retried after a window.
+func (l *Limiter) retryAfter(buckets []sharedstate.UsageBucket, limits config.QuotaLimits, usage sharedstate.UsageBucket, now time.Time) (time.Duration, bool) {
+	var total sharedstate.UsageBucket
+	for _, bucket := range buckets {
+		total.Requests += bucket.Requests
+		total.PayloadBytes += bucket.PayloadBytes
+	}
+	if fits(total, usage, limits) {
+		return 0, false
+	}
+	// buckets are ordered oldest first
+	for _, bucket := range buckets {
+		total.Requests -= bucket.Requests
+		total.PayloadBytes -= bucket.PayloadBytes
+		if fits(total, usage, limits) {
+			return bucket.ExpiresAt.Sub(now), true
+		}
+	}
+	return l.window, true
+}
+
+func fits(total sharedstate.UsageBucket, usage sharedstate.UsageBucket, limits config.QuotaLimits) bool {
+	if limits.MaxRequests != 0 && total.Requests+usage.Requests > limits.MaxRequests {
+		return false
+	}
+	if limits.MaxPayloadBytes != 0 && total.PayloadBytes+usage.PayloadBytes > limits.MaxPayloadBytes {
+		return false
+	}
+	return true
+}
+
+func enabled(limits config.QuotaLimits) bool {
+	return limits.MaxRequests != 0 || limits.MaxPayloadBytes != 0
+}
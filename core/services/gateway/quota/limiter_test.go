@@ -0,0 +1,94 @@
+package quota_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/quota"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
+)
+
+const sender = "0xaa"
+
+func requireExceeded(t *testing.T, err error, expectedQuota string, expectedRetryAfter time.Duration) {
+	var exceeded *quota.ExceededError
+	require.True(t, errors.As(err, &exceeded), err)
+	require.Equal(t, expectedQuota, exceeded.Quota)
+	require.Equal(t, expectedRetryAfter, exceeded.RetryAfter)
+}
+
+func TestLimiter_RollingWindow(t *testing.T) {
+	t.Parallel()
+
+	// one hour buckets
+	clock := clockwork.NewFakeClockAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cfg := config.QuotaConfig{PerSender: config.QuotaLimits{MaxRequests: 3}}
+	limiter := quota.NewLimiter(cfg, sharedstate.NewMemoryStore(), clock)
+	ctx := testutils.Context(t)
+
+	require.NoError(t, limiter.Allow(ctx, sender, "method", 0))
+	clock.Advance(2 * time.Hour)
+	require.NoError(t, limiter.Allow(ctx, sender, "method", 0))
+	require.NoError(t, limiter.Allow(ctx, sender, "method", 0))
+	requireExceeded(t, limiter.Allow(ctx, sender, "method", 0), "sender", 22*time.Hour)
+	// other senders have their own quota
+	require.NoError(t, limiter.Allow(ctx, "0xbb", "method", 0))
+
+	// the first request left the window
+	clock.Advance(22 * time.Hour)
+	require.NoError(t, limiter.Allow(ctx, sender, "method", 0))
+	requireExceeded(t, limiter.Allow(ctx, sender, "method", 0), "sender", 2*time.Hour)
+}
+
+func TestLimiter_PerMethodPayloadBytes(t *testing.T) {
+	t.Parallel()
+
+	clock := clockwork.NewFakeClockAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cfg := config.QuotaConfig{
+		WindowSec: 60,
+		PerMethod: map[string]config.QuotaLimits{"upload": {MaxPayloadBytes: 100}},
+	}
+	require.True(t, quota.Enabled(cfg))
+	limiter := quota.NewLimiter(cfg, sharedstate.NewMemoryStore(), clock)
+	ctx := testutils.Context(t)
+
+	require.NoError(t, limiter.Allow(ctx, sender, "upload", 60))
+	clock.Advance(10 * time.Second)
+	require.NoError(t, limiter.Allow(ctx, sender, "upload", 30))
+	requireExceeded(t, limiter.Allow(ctx, sender, "upload", 20), "method upload", 50*time.Second)
+	// rejected requests are not counted
+	require.NoError(t, limiter.Allow(ctx, sender, "upload", 10))
+	// other methods are not limited
+	require.NoError(t, limiter.Allow(ctx, sender, "download", 1000))
+	// requests larger than the quota never fit
+	requireExceeded(t, limiter.Allow(ctx, "0xbb", "upload", 101), "method upload", time.Minute)
+}
+
+func TestLimiter_SharedStore(t *testing.T) {
+	t.Parallel()
+
+	clock := clockwork.NewFakeClock()
+	cfg := config.QuotaConfig{PerSender: config.QuotaLimits{MaxRequests: 1}}
+	store := sharedstate.NewMemoryStore()
+	ctx := testutils.Context(t)
+
+	require.NoError(t, quota.NewLimiter(cfg, store, clock).Allow(ctx, sender, "method", 0))
+	require.Error(t, quota.NewLimiter(cfg, store, clock).Allow(ctx, sender, "method", 0))
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.QuotaConfig{PerMethod: map[string]config.QuotaLimits{"method": {}}}
+	require.False(t, quota.Enabled(cfg))
+	limiter := quota.NewLimiter(cfg, sharedstate.NewMemoryStore(), clockwork.NewFakeClock())
+	for i := 0; i < 10; i++ {
+		require.NoError(t, limiter.Allow(testutils.Context(t), sender, "method", 1000))
+	}
+}
@@ -0,0 +1,132 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+)
+
+var _ registrysyncer.Launcher = (*gateway)(nil)
+
+// Launch is called by the registry syncer with the latest state of the capabilities registry.
+// Members and F of linked DONs are updated without dropping connections of nodes that remain members.
+func (g *gateway) Launch(ctx context.Context, registry *registrysyncer.LocalRegistry) error {
+	g.registryMu.Lock()
+	defer g.registryMu.Unlock()
+
+	var err error
+	for donId, current := range g.registryDons {
+		updated, err2 := donConfigFromRegistry(current, registry)
+		if err2 != nil {
+			err = multierr.Append(err, err2)
+			continue
+		}
+		if updated.F == current.F && slices.Equal(updated.Members, current.Members) {
+			continue
+		}
+		if err2 = g.connMgr.DONConnectionManager(donId).UpdateMembers(updated.Members, updated.F); err2 != nil {
+			err = multierr.Append(err, err2)
+			continue
+		}
+		// NewGatewayFromConfig only links DONs with handlers that support updates
+		g.handlers[donId].(handlers.DONConfigUpdater).UpdateDONConfig(updated)
+		g.registryDons[donId] = updated
+		g.lggr.Infow("updated DON members from capabilities registry", "donId", donId, "registryDonId", updated.RegistryDonId, "members", len(updated.Members), "f", updated.F)
+	}
+	return err
+}
+
+// donConfigFromRegistry returns a copy of current with Members and F taken from the registry.
+// Node addresses are looked up by peer ID in NodeAddresses, normalized by normalizeNodeAddresses.
+func donConfigFromRegistry(current *config.DONConfig, registry *registrysyncer.LocalRegistry) (*config.DONConfig, error) {
+	don, ok := registry.IDsToDONs[registrysyncer.DonID(current.RegistryDonId)]
+	if !ok {
+		return nil, fmt.Errorf("DON %d not found in capabilities registry", current.RegistryDonId)
+	}
+	members := make([]config.NodeConfig, 0, len(don.Members))
+	for _, peerID := range don.Members {
+		address, ok := current.NodeAddresses[peerID.String()]
+		if !ok {
+			return nil, fmt.Errorf("no address configured for node %s of DON %d", peerID, current.RegistryDonId)
+		}
+		members = append(members, config.NodeConfig{
+			Name:    peerID.String(),
+			Address: address,
+		})
+	}
+	slices.SortFunc(members, func(a, b config.NodeConfig) int {
+		return strings.Compare(a.Address, b.Address)
+	})
+	updated := *current
+	updated.Members = members
+	updated.F = int(don.F)
+	return &updated, nil
+}
+
+// normalizeNodeAddresses validates NodeAddresses of a DON linked to the registry and rewrites
+// peer IDs to their canonical form and addresses to lower case.
+func normalizeNodeAddresses(donConfig *config.DONConfig) error {
+	if len(donConfig.NodeAddresses) == 0 {
+		return fmt.Errorf("DON %s has RegistryDonId set but no NodeAddresses", donConfig.DonId)
+	}
+	normalized := make(map[string]string, len(donConfig.NodeAddresses))
+	for rawPeerID, address := range donConfig.NodeAddresses {
+		var peerID p2ptypes.PeerID
+		if err := peerID.UnmarshalText([]byte(strings.TrimPrefix(rawPeerID, "p2p_"))); err != nil {
+			return fmt.Errorf("invalid peer ID %s of DON %s: %w", rawPeerID, donConfig.DonId, err)
+		}
+		if !common.IsHexAddress(address) {
+			return fmt.Errorf("invalid address %s of node %s", address, rawPeerID)
+		}
+		normalized[peerID.String()] = strings.ToLower(address)
+	}
+	donConfig.NodeAddresses = normalized
+	return nil
+}
+
+func newRegistrySyncer(registryConfig *config.CapabilitiesRegistryConfig, relayers RelayGetter, lggr logger.Logger) (registrysyncer.RegistrySyncer, error) {
+	if relayers == nil {
+		return nil, errors.New("relayers are not available")
+	}
+	networkID := registryConfig.NetworkID
+	if networkID == "" {
+		networkID = relay.NetworkEVM
+	}
+	relayer, err := relayers.Get(types.NewRelayID(networkID, registryConfig.ChainID))
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch relayer for capabilities registry: %w", err)
+	}
+	getPeerID := func() (p2ptypes.PeerID, error) {
+		return p2ptypes.PeerID{}, errors.New("gateway does not have a peer ID")
+	}
+	return registrysyncer.New(lggr, getPeerID, relayer, registryConfig.Address, registryORM{})
+}
+
+// registryORM does not persist registry state. The node-wide registry_syncer_states table
+// belongs to the capabilities registry syncer, which may track a different registry, so the
+// gateway always reads the registry from the chain on startup.
+type registryORM struct{}
+
+var _ registrysyncer.ORM = registryORM{}
+
+func (registryORM) AddLocalRegistry(context.Context, registrysyncer.LocalRegistry) error {
+	return nil
+}
+
+func (registryORM) LatestLocalRegistry(context.Context) (*registrysyncer.LocalRegistry, error) {
+	return nil, errors.New("local registry is not persisted by the gateway")
+}
@@ -0,0 +1,169 @@
+package gateway_test
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	kcr "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/keystone/generated/capabilities_registry"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	handler_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+)
+
+const registryConfig = `
+[capabilitiesRegistry]
+Address = "0x0001020304050607080900010203040506070809"
+ChainID = "1337"
+
+[[dons]]
+DonId = "my_don"
+HandlerName = "dummy"
+RegistryDonId = 7
+`
+
+// registryNode is a node registered in the capabilities registry. Its onchain signer is an
+// OCR2 key, unrelated to the Eth key its gateway connector authenticates with.
+type registryNode struct {
+	peerID  p2ptypes.PeerID
+	signer  [32]byte
+	gateway gc.TestNode
+}
+
+func newRegistryNodes(t *testing.T, n int) []registryNode {
+	var nodes []registryNode
+	for _, gatewayKey := range gc.NewTestNodes(t, n) {
+		node := registryNode{gateway: gatewayKey}
+		_, err := rand.Read(node.peerID[:])
+		require.NoError(t, err)
+		_, err = rand.Read(node.signer[:])
+		require.NoError(t, err)
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// nodeAddressesConfig maps peer IDs of nodes to their gateway connector addresses.
+func nodeAddressesConfig(nodes []registryNode) string {
+	result := "[dons.NodeAddresses]\n"
+	for _, node := range nodes {
+		result += fmt.Sprintf("%q = %q\n", node.peerID.String(), node.gateway.Address)
+	}
+	return result
+}
+
+type updatableHandler struct {
+	*handler_mocks.Handler
+	updates []*config.DONConfig
+}
+
+func (h *updatableHandler) UpdateDONConfig(donConfig *config.DONConfig) {
+	h.updates = append(h.updates, donConfig)
+}
+
+type staticHandlerFactory struct {
+	handler handlers.Handler
+}
+
+func (f staticHandlerFactory) NewHandler(gateway.HandlerType, json.RawMessage, *config.DONConfig, handlers.DON, *sharedstate.Replica) (handlers.Handler, error) {
+	return f.handler, nil
+}
+
+func newRegistry(donID uint32, f uint8, nodes []registryNode) *registrysyncer.LocalRegistry {
+	registry := &registrysyncer.LocalRegistry{
+		IDsToDONs:  map[registrysyncer.DonID]registrysyncer.DON{},
+		IDsToNodes: map[p2ptypes.PeerID]kcr.INodeInfoProviderNodeInfo{},
+	}
+	don := capabilities.DON{ID: donID, F: f}
+	for _, node := range nodes {
+		registry.IDsToNodes[node.peerID] = kcr.INodeInfoProviderNodeInfo{P2pId: node.peerID, Signer: node.signer}
+		don.Members = append(don.Members, node.peerID)
+	}
+	registry.IDsToDONs[registrysyncer.DonID(donID)] = registrysyncer.DON{DON: don}
+	return registry
+}
+
+func TestGateway_Launch(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	nodes := newRegistryNodes(t, 5)
+	// the last node joins the DON onchain before its address is configured
+	handler := &updatableHandler{Handler: handler_mocks.NewHandler(t)}
+	gw, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig(registryConfig+nodeAddressesConfig(nodes[:4]))), staticHandlerFactory{handler}, nil, nil, lggr)
+	require.NoError(t, err)
+
+	registry := newRegistry(7, 1, nodes[:4])
+	require.NoError(t, gw.Launch(testutils.Context(t), registry))
+	require.Len(t, handler.updates, 1)
+	donConfig := handler.updates[0]
+	require.Equal(t, "my_don", donConfig.DonId)
+	require.Equal(t, 1, donConfig.F)
+	require.Len(t, donConfig.Members, 4)
+	for _, node := range nodes[:4] {
+		require.Contains(t, donConfig.Members, config.NodeConfig{Name: node.peerID.String(), Address: node.gateway.Address})
+	}
+
+	// unchanged registry
+	require.NoError(t, gw.Launch(testutils.Context(t), registry))
+	require.Len(t, handler.updates, 1)
+
+	// node removed
+	require.NoError(t, gw.Launch(testutils.Context(t), newRegistry(7, 0, nodes[:3])))
+	require.Len(t, handler.updates, 2)
+	require.Equal(t, 0, handler.updates[1].F)
+	require.Len(t, handler.updates[1].Members, 3)
+
+	// node without a configured address
+	require.ErrorContains(t, gw.Launch(testutils.Context(t), newRegistry(7, 1, nodes)), "no address configured for node "+nodes[4].peerID.String())
+	require.Len(t, handler.updates, 2)
+
+	// DON missing from the registry
+	require.Error(t, gw.Launch(testutils.Context(t), newRegistry(8, 1, nodes[:4])))
+	require.Len(t, handler.updates, 2)
+}
+
+func TestGateway_NewGatewayFromConfig_InvalidRegistryConfig(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.TestLogger(t)
+	invalidCases := map[string]string{
+		"registry not configured": `
+[[dons]]
+DonId = "my_don"
+HandlerName = "dummy"
+RegistryDonId = 7
+`,
+		"invalid registry address": `
+[capabilitiesRegistry]
+Address = "0xnot_an_address"
+`,
+		"missing node addresses": registryConfig,
+		"invalid peer ID": registryConfig + `
+[dons.NodeAddresses]
+"not_a_peer_id" = "0x0001020304050607080900010203040506070809"
+`,
+		"invalid node address": registryConfig + fmt.Sprintf("[dons.NodeAddresses]\n%q = \"0x12\"\n", newRegistryNodes(t, 1)[0].peerID.String()),
+	}
+	for name, tomlConfig := range invalidCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig(tomlConfig)), gateway.NewHandlerFactory(nil, nil, nil, lggr), nil, nil, lggr)
+			require.Error(t, err)
+		})
+	}
+
+	// handlers that can't be updated can't be linked to the registry
+	_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig(registryConfig+nodeAddressesConfig(newRegistryNodes(t, 1)))), staticHandlerFactory{handler_mocks.NewHandler(t)}, nil, nil, lggr)
+	require.Error(t, err)
+}
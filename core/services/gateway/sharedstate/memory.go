@@ -0,0 +1,206 @@
+package sharedstate
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+type nodeKey struct {
+	donID       string
+	nodeAddress string
+}
+
+type memoryRequest struct {
+	owner     string
+	state     []byte
+	streaming bool
+	expiresAt time.Time
+}
+
+type memoryClaim struct {
+	owner     string
+	expiresAt time.Time
+}
+
+// memoryStore keeps the state in the memory of a single gateway process.
+type memoryStore struct {
+	mu       sync.Mutex
+	requests map[RequestKey]*memoryRequest
+	claims   map[nodeKey]memoryClaim
+	messages map[string][]Message
+	usage    map[string][]UsageBucket
+}
+
+var _ Store = (*memoryStore)(nil)
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		requests: make(map[RequestKey]*memoryRequest),
+		claims:   make(map[nodeKey]memoryClaim),
+		messages: make(map[string][]Message),
+		usage:    make(map[string][]UsageBucket),
+	}
+}
+
+func (s *memoryStore) CreateRequest(_ context.Context, key RequestKey, owner string, state []byte, streaming bool, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// expired requests which were not deleted yet are replaced
+	if existing, ok := s.requests[key]; ok && existing.expiresAt.After(time.Now()) {
+		return ErrAlreadyExists
+	}
+	s.requests[key] = &memoryRequest{owner: owner, state: state, streaming: streaming, expiresAt: expiresAt}
+	return nil
+}
+
+func (s *memoryStore) RequestOwner(_ context.Context, key RequestKey) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	req, ok := s.requests[key]
+	if !ok {
+		return "", false, ErrNotFound
+	}
+	return req.owner, req.streaming, nil
+}
+
+func (s *memoryStore) UpdateRequest(_ context.Context, key RequestKey, fn func(state []byte) ([]byte, bool)) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	req, ok := s.requests[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	newState, done := fn(req.state)
+	if done {
+		delete(s.requests, key)
+	} else if newState != nil {
+		req.state = newState
+	}
+	return req.owner, nil
+}
+
+func (s *memoryStore) DeleteRequest(_ context.Context, key RequestKey) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.requests[key]
+	delete(s.requests, key)
+	return ok, nil
+}
+
+func (s *memoryStore) ClaimNode(_ context.Context, donID string, nodeAddress string, owner string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.claims[nodeKey{donID, nodeAddress}] = memoryClaim{owner: owner, expiresAt: expiresAt}
+	return nil
+}
+
+func (s *memoryStore) ReleaseNode(_ context.Context, donID string, nodeAddress string, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := nodeKey{donID, nodeAddress}
+	if claim, ok := s.claims[key]; ok && claim.owner == owner {
+		delete(s.claims, key)
+	}
+	return nil
+}
+
+func (s *memoryStore) NodeOwner(_ context.Context, donID string, nodeAddress string, now time.Time) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	claim, ok := s.claims[nodeKey{donID, nodeAddress}]
+	if !ok || !claim.expiresAt.After(now) {
+		return "", ErrNotFound
+	}
+	return claim.owner, nil
+}
+
+func (s *memoryStore) Send(_ context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages[msg.Recipient] = append(s.messages[msg.Recipient], msg)
+	return nil
+}
+
+func (s *memoryStore) Receive(_ context.Context, recipient string, limit int) ([]Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	queued := s.messages[recipient]
+	if len(queued) > limit {
+		s.messages[recipient] = queued[limit:]
+		return queued[:limit:limit], nil
+	}
+	delete(s.messages, recipient)
+	return queued, nil
+}
+
+func (s *memoryStore) AddUsage(_ context.Context, key string, bucket UsageBucket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	buckets := s.usage[key]
+	for i := range buckets {
+		if buckets[i].Start.Equal(bucket.Start) {
+			buckets[i].Requests += bucket.Requests
+			buckets[i].PayloadBytes += bucket.PayloadBytes
+			return nil
+		}
+	}
+	buckets = append(buckets, bucket)
+	slices.SortFunc(buckets, func(a, b UsageBucket) int {
+		return a.Start.Compare(b.Start)
+	})
+	s.usage[key] = buckets
+	return nil
+}
+
+func (s *memoryStore) Usage(_ context.Context, key string, now time.Time) ([]UsageBucket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var buckets []UsageBucket
+	for _, bucket := range s.usage[key] {
+		if bucket.ExpiresAt.After(now) {
+			buckets = append(buckets, bucket)
+		}
+	}
+	return buckets, nil
+}
+
+func (s *memoryStore) DeleteExpired(_ context.Context, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, req := range s.requests {
+		if !req.expiresAt.After(now) {
+			delete(s.requests, key)
+		}
+	}
+	for key, claim := range s.claims {
+		if !claim.expiresAt.After(now) {
+			delete(s.claims, key)
+		}
+	}
+	for recipient, queued := range s.messages {
+		var kept []Message
+		for _, msg := range queued {
+			if msg.ExpiresAt.After(now) {
+				kept = append(kept, msg)
+			}
+		}
+		if len(kept) == 0 {
+			delete(s.messages, recipient)
+		} else {
+			s.messages[recipient] = kept
+		}
+	}
+	for key, buckets := range s.usage {
+		buckets = slices.DeleteFunc(buckets, func(bucket UsageBucket) bool {
+			return !bucket.ExpiresAt.After(now)
+		})
+		if len(buckets) == 0 {
+			delete(s.usage, key)
+		} else {
+			s.usage[key] = buckets
+		}
+	}
+	return nil
+}
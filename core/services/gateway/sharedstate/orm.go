@@ -0,0 +1,213 @@
+package sharedstate
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// orm is the postgres backend, shared by all gateway replicas using the same database.
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ Store = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) Store {
+	return &orm{ds: ds}
+}
+
+// CreateRequest replaces requests that expired but were not deleted yet.
+func (o *orm) CreateRequest(ctx context.Context, key RequestKey, owner string, state []byte, streaming bool, expiresAt time.Time) error {
+	stmt := `
+INSERT INTO gateway_pending_requests (don_id, sender, message_id, owner, state, streaming, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (don_id, sender, message_id) DO UPDATE
+SET owner = EXCLUDED.owner, state = EXCLUDED.state, streaming = EXCLUDED.streaming, expires_at = EXCLUDED.expires_at
+WHERE gateway_pending_requests.expires_at <= NOW();`
+	res, err := o.ds.ExecContext(ctx, stmt, key.DonID, key.Sender, key.MessageID, owner, state, streaming, expiresAt)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrAlreadyExists
+	}
+	return nil
+}
+
+func (o *orm) RequestOwner(ctx context.Context, key RequestKey) (string, bool, error) {
+	var row struct {
+		Owner     string
+		Streaming bool
+	}
+	stmt := `SELECT owner, streaming FROM gateway_pending_requests WHERE don_id = $1 AND sender = $2 AND message_id = $3;`
+	if err := o.ds.GetContext(ctx, &row, stmt, key.DonID, key.Sender, key.MessageID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", false, ErrNotFound
+		}
+		return "", false, err
+	}
+	return row.Owner, row.Streaming, nil
+}
+
+func (o *orm) UpdateRequest(ctx context.Context, key RequestKey, fn func(state []byte) ([]byte, bool)) (owner string, err error) {
+	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		var row struct {
+			Owner string
+			State []byte
+		}
+		stmt := `
+SELECT owner, state FROM gateway_pending_requests
+WHERE don_id = $1 AND sender = $2 AND message_id = $3
+FOR UPDATE;`
+		if err := tx.GetContext(ctx, &row, stmt, key.DonID, key.Sender, key.MessageID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrNotFound
+			}
+			return err
+		}
+		owner = row.Owner
+		newState, done := fn(row.State)
+		switch {
+		case done:
+			_, err := tx.ExecContext(ctx, `DELETE FROM gateway_pending_requests WHERE don_id = $1 AND sender = $2 AND message_id = $3;`, key.DonID, key.Sender, key.MessageID)
+			return err
+		case newState != nil:
+			_, err := tx.ExecContext(ctx, `UPDATE gateway_pending_requests SET state = $4 WHERE don_id = $1 AND sender = $2 AND message_id = $3;`, key.DonID, key.Sender, key.MessageID, newState)
+			return err
+		default:
+			return nil
+		}
+	})
+	return
+}
+
+func (o *orm) DeleteRequest(ctx context.Context, key RequestKey) (bool, error) {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM gateway_pending_requests WHERE don_id = $1 AND sender = $2 AND message_id = $3;`, key.DonID, key.Sender, key.MessageID)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (o *orm) ClaimNode(ctx context.Context, donID string, nodeAddress string, owner string, expiresAt time.Time) error {
+	stmt := `
+INSERT INTO gateway_node_claims (don_id, node_address, owner, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (don_id, node_address) DO UPDATE
+SET owner = EXCLUDED.owner, expires_at = EXCLUDED.expires_at;`
+	_, err := o.ds.ExecContext(ctx, stmt, donID, nodeAddress, owner, expiresAt)
+	return err
+}
+
+func (o *orm) ReleaseNode(ctx context.Context, donID string, nodeAddress string, owner string) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM gateway_node_claims WHERE don_id = $1 AND node_address = $2 AND owner = $3;`, donID, nodeAddress, owner)
+	return err
+}
+
+func (o *orm) NodeOwner(ctx context.Context, donID string, nodeAddress string, now time.Time) (string, error) {
+	var owner string
+	err := o.ds.GetContext(ctx, &owner, `SELECT owner FROM gateway_node_claims WHERE don_id = $1 AND node_address = $2 AND expires_at > $3;`, donID, nodeAddress, now)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return owner, err
+}
+
+func (o *orm) Send(ctx context.Context, msg Message) error {
+	stmt := `
+INSERT INTO gateway_replica_messages (recipient, kind, payload, expires_at)
+VALUES ($1, $2, $3, $4);`
+	_, err := o.ds.ExecContext(ctx, stmt, msg.Recipient, msg.Kind, msg.Payload, msg.ExpiresAt)
+	return err
+}
+
+type messageRow struct {
+	ID        int64
+	Recipient string
+	Kind      string
+	Payload   []byte
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+func (o *orm) Receive(ctx context.Context, recipient string, limit int) ([]Message, error) {
+	var rows []messageRow
+	stmt := `
+DELETE FROM gateway_replica_messages
+WHERE id IN (
+	SELECT id FROM gateway_replica_messages
+	WHERE recipient = $1
+	ORDER BY id
+	LIMIT $2
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING id, recipient, kind, payload, expires_at;`
+	if err := o.ds.SelectContext(ctx, &rows, stmt, recipient, limit); err != nil {
+		return nil, err
+	}
+	// RETURNING does not preserve the order of the subquery
+	slices.SortFunc(rows, func(a, b messageRow) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	msgs := make([]Message, len(rows))
+	for i, row := range rows {
+		msgs[i] = Message{Recipient: row.Recipient, Kind: row.Kind, Payload: row.Payload, ExpiresAt: row.ExpiresAt}
+	}
+	return msgs, nil
+}
+
+func (o *orm) AddUsage(ctx context.Context, key string, bucket UsageBucket) error {
+	stmt := `
+INSERT INTO gateway_quota_usage (quota_key, bucket_start, requests, payload_bytes, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (quota_key, bucket_start) DO UPDATE
+SET requests = gateway_quota_usage.requests + EXCLUDED.requests,
+	payload_bytes = gateway_quota_usage.payload_bytes + EXCLUDED.payload_bytes;`
+	_, err := o.ds.ExecContext(ctx, stmt, key, bucket.Start, bucket.Requests, bucket.PayloadBytes, bucket.ExpiresAt)
+	return err
+}
+
+func (o *orm) Usage(ctx context.Context, key string, now time.Time) ([]UsageBucket, error) {
+	var rows []struct {
+		BucketStart  time.Time `db:"bucket_start"`
+		ExpiresAt    time.Time `db:"expires_at"`
+		Requests     uint64
+		PayloadBytes uint64 `db:"payload_bytes"`
+	}
+	stmt := `
+SELECT bucket_start, expires_at, requests, payload_bytes FROM gateway_quota_usage
+WHERE quota_key = $1 AND expires_at > $2
+ORDER BY bucket_start;`
+	if err := o.ds.SelectContext(ctx, &rows, stmt, key, now); err != nil {
+		return nil, err
+	}
+	buckets := make([]UsageBucket, len(rows))
+	for i, row := range rows {
+		buckets[i] = UsageBucket{Start: row.BucketStart, ExpiresAt: row.ExpiresAt, Requests: row.Requests, PayloadBytes: row.PayloadBytes}
+	}
+	return buckets, nil
+}
+
+func (o *orm) DeleteExpired(ctx context.Context, now time.Time) error {
+	return sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		for _, table := range []string{"gateway_pending_requests", "gateway_node_claims", "gateway_replica_messages", "gateway_quota_usage"} {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE expires_at <= $1;`, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
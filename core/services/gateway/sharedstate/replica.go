@@ -0,0 +1,415 @@
+package sharedstate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+)
+
+const (
+	defaultPollInterval = 200 * time.Millisecond
+	defaultNodeLease    = 30 * time.Second
+	messageTTL          = time.Minute
+	receiveBatchSize    = 100
+	storeTimeout        = 5 * time.Second
+
+	kindResponse = "response"
+	kindUpdate   = "update"
+	kindNode     = "node"
+)
+
+var (
+	ErrRequestExists    = errors.New("request already exists")
+	ErrRequestCacheFull = errors.New("request cache is full")
+	ErrRequestNotFound  = errors.New("request not found")
+)
+
+// NodeForwarder writes a message to a node connected to this replica.
+type NodeForwarder func(ctx context.Context, donID string, nodeAddress string, msg *api.Message) error
+
+// Replica is a single gateway process using a Store shared with other replicas.
+//
+// User callbacks are kept in the memory of the replica that received the user request. Responses
+// aggregated by other replicas are delivered to it through the store, as are messages for nodes
+// connected to it (node connections stick to the replica that accepted them).
+type Replica struct {
+	services.StateMachine
+
+	id           string
+	store        Store
+	pollInterval time.Duration
+	nodeLease    time.Duration
+	forwarder    NodeForwarder
+
+	mu        sync.Mutex
+	callbacks map[RequestKey]*callback
+	pending   map[string]uint32 // number of callbacks per DON
+	nodes     map[nodeKey]struct{}
+
+	chStop services.StopChan
+	wgDone sync.WaitGroup
+	lggr   logger.Logger
+}
+
+type callback struct {
+	ch        chan<- handlers.UserCallbackPayload
+	updatesCh chan<- handlers.UserStreamUpdate
+	timer     *time.Timer
+}
+
+type responseEnvelope struct {
+	Key      RequestKey
+	Response handlers.UserCallbackPayload
+}
+
+type updateEnvelope struct {
+	Key    RequestKey
+	Update handlers.UserStreamUpdate
+}
+
+type nodeEnvelope struct {
+	DonID       string
+	NodeAddress string
+	Msg         *api.Message
+}
+
+func NewReplica(store Store, cfg config.SharedStateConfig, lggr logger.Logger) *Replica {
+	id := cfg.ReplicaId
+	if id == "" {
+		id = uuid.NewString()
+	}
+	pollInterval := defaultPollInterval
+	if cfg.PollIntervalMillis > 0 {
+		pollInterval = time.Duration(cfg.PollIntervalMillis) * time.Millisecond
+	}
+	nodeLease := defaultNodeLease
+	if cfg.NodeLeaseSec > 0 {
+		nodeLease = time.Duration(cfg.NodeLeaseSec) * time.Second
+	}
+	return &Replica{
+		id:           id,
+		store:        store,
+		pollInterval: pollInterval,
+		nodeLease:    nodeLease,
+		callbacks:    make(map[RequestKey]*callback),
+		pending:      make(map[string]uint32),
+		nodes:        make(map[nodeKey]struct{}),
+		chStop:       make(services.StopChan),
+		lggr:         lggr.Named("GatewayReplica").With("replicaId", id),
+	}
+}
+
+func (r *Replica) ID() string { return r.id }
+
+// SetNodeForwarder sets the function writing messages forwarded by other replicas to nodes.
+func (r *Replica) SetNodeForwarder(forwarder NodeForwarder) {
+	r.forwarder = forwarder
+}
+
+func (r *Replica) Start(context.Context) error {
+	return r.StartOnce("GatewayReplica", func() error {
+		r.wgDone.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *Replica) Close() error {
+	return r.StopOnce("GatewayReplica", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+
+		ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+		defer cancel()
+		var err error
+		for key := range r.localNodes() {
+			err = errors.Join(err, r.store.ReleaseNode(ctx, key.donID, key.nodeAddress, r.id))
+		}
+		return err
+	})
+}
+
+func (r *Replica) Name() string { return r.lggr.Name() }
+
+func (r *Replica) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+// NewRequest stores the initial state of a request received by this replica and registers callbackCh,
+// which receives the aggregated response or a timeout error. Updates published for the request are
+// sent to updatesCh, if not nil. A limit of 0 means no limit on the number of pending requests per DON.
+func (r *Replica) NewRequest(ctx context.Context, key RequestKey, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, updatesCh chan<- handlers.UserStreamUpdate, state []byte, timeout time.Duration, limit uint32) error {
+	r.mu.Lock()
+	if _, ok := r.callbacks[key]; ok {
+		r.mu.Unlock()
+		return ErrRequestExists
+	}
+	if limit > 0 && r.pending[key.DonID] >= limit {
+		r.mu.Unlock()
+		return ErrRequestCacheFull
+	}
+	// reserve the slot before the request becomes visible to other replicas
+	cb := &callback{ch: callbackCh, updatesCh: updatesCh}
+	r.callbacks[key] = cb
+	r.pending[key.DonID]++
+	r.mu.Unlock()
+
+	if err := r.store.CreateRequest(ctx, key, r.id, state, updatesCh != nil, time.Now().Add(timeout)); err != nil {
+		r.removeCallback(key)
+		if errors.Is(err, ErrAlreadyExists) {
+			return ErrRequestExists
+		}
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.callbacks[key] == cb {
+		cb.timer = time.AfterFunc(timeout, func() {
+			r.expire(key, request)
+		})
+	}
+	return nil
+}
+
+// ProcessResponse atomically updates the state of a pending request with the result of fn.
+// Once fn returns an aggregated response, the request is deleted and the response is delivered
+// to the replica which received the request.
+func (r *Replica) ProcessResponse(ctx context.Context, key RequestKey, fn func(state []byte) (aggregated *handlers.UserCallbackPayload, newState []byte)) error {
+	var aggregated *handlers.UserCallbackPayload
+	owner, err := r.store.UpdateRequest(ctx, key, func(state []byte) ([]byte, bool) {
+		var newState []byte
+		aggregated, newState = fn(state)
+		return newState, aggregated != nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return ErrRequestNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if aggregated == nil {
+		return nil
+	}
+	if owner == r.id {
+		r.deliver(key, *aggregated)
+		return nil
+	}
+	payload, err := json.Marshal(responseEnvelope{Key: key, Response: *aggregated})
+	if err != nil {
+		return err
+	}
+	return r.store.Send(ctx, Message{Recipient: owner, Kind: kindResponse, Payload: payload, ExpiresAt: time.Now().Add(messageTTL)})
+}
+
+// Publish sends an intermediate update to the replica streaming the request. Updates of requests
+// which are not streamed, or no longer pending, are dropped.
+func (r *Replica) Publish(ctx context.Context, key RequestKey, update handlers.UserStreamUpdate) error {
+	r.mu.Lock()
+	cb, local := r.callbacks[key]
+	r.mu.Unlock()
+	if local {
+		r.sendUpdate(key, cb, update)
+		return nil
+	}
+	owner, streaming, err := r.store.RequestOwner(ctx, key)
+	if errors.Is(err, ErrNotFound) || (err == nil && (!streaming || owner == r.id)) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(updateEnvelope{Key: key, Update: update})
+	if err != nil {
+		return err
+	}
+	return r.store.Send(ctx, Message{Recipient: owner, Kind: kindUpdate, Payload: payload, ExpiresAt: time.Now().Add(messageTTL)})
+}
+
+// ClaimNode records that a node is connected to this replica. The claim is renewed until the
+// node is released or the replica is closed.
+func (r *Replica) ClaimNode(ctx context.Context, donID string, nodeAddress string) error {
+	if err := r.store.ClaimNode(ctx, donID, nodeAddress, r.id, time.Now().Add(r.nodeLease)); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nodes[nodeKey{donID, nodeAddress}] = struct{}{}
+	return nil
+}
+
+func (r *Replica) ReleaseNode(ctx context.Context, donID string, nodeAddress string) error {
+	r.mu.Lock()
+	delete(r.nodes, nodeKey{donID, nodeAddress})
+	r.mu.Unlock()
+	return r.store.ReleaseNode(ctx, donID, nodeAddress, r.id)
+}
+
+// NodeOwner returns the ID of the replica the node is connected to, or ErrNotFound.
+func (r *Replica) NodeOwner(ctx context.Context, donID string, nodeAddress string) (string, error) {
+	return r.store.NodeOwner(ctx, donID, nodeAddress, time.Now())
+}
+
+// ForwardToNode sends a message for a node connected to another replica.
+func (r *Replica) ForwardToNode(ctx context.Context, owner string, donID string, nodeAddress string, msg *api.Message) error {
+	payload, err := json.Marshal(nodeEnvelope{DonID: donID, NodeAddress: nodeAddress, Msg: msg})
+	if err != nil {
+		return err
+	}
+	return r.store.Send(ctx, Message{Recipient: owner, Kind: kindNode, Payload: payload, ExpiresAt: time.Now().Add(messageTTL)})
+}
+
+func (r *Replica) run() {
+	defer r.wgDone.Done()
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+
+	pollTicker := time.NewTicker(r.pollInterval)
+	defer pollTicker.Stop()
+	leaseTicker := time.NewTicker(r.nodeLease / 3)
+	defer leaseTicker.Stop()
+
+	for {
+		select {
+		case <-r.chStop:
+			return
+		case <-pollTicker.C:
+			r.receive(ctx)
+		case <-leaseTicker.C:
+			r.renewNodes(ctx)
+			if err := r.store.DeleteExpired(ctx, time.Now()); err != nil {
+				r.lggr.Errorw("failed to delete expired shared state", "err", err)
+			}
+		}
+	}
+}
+
+func (r *Replica) receive(ctx context.Context) {
+	for {
+		msgs, err := r.store.Receive(ctx, r.id, receiveBatchSize)
+		if err != nil {
+			r.lggr.Errorw("failed to receive messages", "err", err)
+			return
+		}
+		for _, msg := range msgs {
+			if err := r.handleMessage(ctx, msg); err != nil {
+				r.lggr.Errorw("failed to handle message", "kind", msg.Kind, "err", err)
+			}
+		}
+		if len(msgs) < receiveBatchSize {
+			return
+		}
+	}
+}
+
+func (r *Replica) handleMessage(ctx context.Context, msg Message) error {
+	switch msg.Kind {
+	case kindResponse:
+		var envelope responseEnvelope
+		if err := json.Unmarshal(msg.Payload, &envelope); err != nil {
+			return err
+		}
+		r.deliver(envelope.Key, envelope.Response)
+		return nil
+	case kindUpdate:
+		var envelope updateEnvelope
+		if err := json.Unmarshal(msg.Payload, &envelope); err != nil {
+			return err
+		}
+		r.mu.Lock()
+		cb, ok := r.callbacks[envelope.Key]
+		r.mu.Unlock()
+		if ok {
+			r.sendUpdate(envelope.Key, cb, envelope.Update)
+		}
+		return nil
+	case kindNode:
+		var envelope nodeEnvelope
+		if err := json.Unmarshal(msg.Payload, &envelope); err != nil {
+			return err
+		}
+		if r.forwarder == nil {
+			return errors.New("node forwarder is not set")
+		}
+		return r.forwarder(ctx, envelope.DonID, envelope.NodeAddress, envelope.Msg)
+	default:
+		return fmt.Errorf("unknown message kind %s", msg.Kind)
+	}
+}
+
+func (r *Replica) renewNodes(ctx context.Context) {
+	expiresAt := time.Now().Add(r.nodeLease)
+	for key := range r.localNodes() {
+		if err := r.store.ClaimNode(ctx, key.donID, key.nodeAddress, r.id, expiresAt); err != nil {
+			r.lggr.Errorw("failed to renew node claim", "donId", key.donID, "nodeAddress", key.nodeAddress, "err", err)
+		}
+	}
+}
+
+func (r *Replica) localNodes() map[nodeKey]struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	nodes := make(map[nodeKey]struct{}, len(r.nodes))
+	for key := range r.nodes {
+		nodes[key] = struct{}{}
+	}
+	return nodes
+}
+
+func (r *Replica) expire(key RequestKey, request *api.Message) {
+	ctx, cancel := r.chStop.CtxWithTimeout(storeTimeout)
+	defer cancel()
+	if _, err := r.store.DeleteRequest(ctx, key); err != nil {
+		r.lggr.Errorw("failed to delete expired request", "messageId", key.MessageID, "err", err)
+	}
+	r.deliver(key, handlers.UserCallbackPayload{Msg: request, ErrMsg: "timeout", ErrCode: api.RequestTimeoutError})
+}
+
+// deliver sends the response to a callback registered on this replica, at most once.
+func (r *Replica) deliver(key RequestKey, response handlers.UserCallbackPayload) {
+	cb := r.removeCallback(key)
+	if cb == nil {
+		return
+	}
+	if cb.timer != nil {
+		cb.timer.Stop()
+	}
+	cb.ch <- response
+	close(cb.ch)
+}
+
+func (r *Replica) sendUpdate(key RequestKey, cb *callback, update handlers.UserStreamUpdate) {
+	if cb.updatesCh == nil {
+		return
+	}
+	if !handlers.TrySendUpdate(cb.updatesCh, update) {
+		r.lggr.Debugw("dropped update of a slow stream", "donId", key.DonID, "messageId", key.MessageID)
+	}
+}
+
+func (r *Replica) removeCallback(key RequestKey) *callback {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cb, ok := r.callbacks[key]
+	if !ok {
+		return nil
+	}
+	delete(r.callbacks, key)
+	r.pending[key.DonID]--
+	if r.pending[key.DonID] == 0 {
+		delete(r.pending, key.DonID)
+	}
+	return cb
+}
@@ -0,0 +1,228 @@
+package sharedstate_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
+)
+
+func newReplicas(t *testing.T, n int) []*sharedstate.Replica {
+	store := sharedstate.NewMemoryStore()
+	replicas := make([]*sharedstate.Replica, n)
+	for i := range replicas {
+		replicas[i] = sharedstate.NewReplica(store, config.SharedStateConfig{PollIntervalMillis: 10}, logger.TestLogger(t))
+	}
+	return replicas
+}
+
+func newRequest(id string) (sharedstate.RequestKey, *api.Message) {
+	msg := &api.Message{Body: api.MessageBody{MessageId: id, DonId: "don", Sender: "0xaa"}}
+	return sharedstate.RequestKey{DonID: "don", Sender: "0xaa", MessageID: id}, msg
+}
+
+// countResponses aggregates the request after the given number of responses.
+func countResponses(msg *api.Message, responses int) func(state []byte) (*handlers.UserCallbackPayload, []byte) {
+	return func(state []byte) (*handlers.UserCallbackPayload, []byte) {
+		if len(state)+1 >= responses {
+			return &handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError}, nil
+		}
+		return nil, append(state, 'x')
+	}
+}
+
+func TestReplica_ResponseAggregatedByAnotherReplica(t *testing.T) {
+	t.Parallel()
+
+	replicas := newReplicas(t, 2)
+	for _, replica := range replicas {
+		servicetest.Run(t, replica)
+	}
+	ctx := testutils.Context(t)
+	key, msg := newRequest("1")
+
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, replicas[0].NewRequest(ctx, key, msg, callbackCh, nil, []byte{}, time.Hour, 0))
+	require.NoError(t, replicas[1].ProcessResponse(ctx, key, countResponses(msg, 2)))
+	require.Empty(t, callbackCh)
+	require.NoError(t, replicas[1].ProcessResponse(ctx, key, countResponses(msg, 2)))
+
+	select {
+	case resp := <-callbackCh:
+		require.Equal(t, api.NoError, resp.ErrCode)
+		require.Equal(t, msg.Body.MessageId, resp.Msg.Body.MessageId)
+	case <-ctx.Done():
+		t.Fatal("response not delivered")
+	}
+	_, open := <-callbackCh
+	require.False(t, open)
+
+	// late responses are ignored
+	require.ErrorIs(t, replicas[1].ProcessResponse(ctx, key, countResponses(msg, 2)), sharedstate.ErrRequestNotFound)
+}
+
+func TestReplica_PublishUpdates(t *testing.T) {
+	t.Parallel()
+
+	replicas := newReplicas(t, 2)
+	for _, replica := range replicas {
+		servicetest.Run(t, replica)
+	}
+	ctx := testutils.Context(t)
+	key, msg := newRequest("1")
+
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	updatesCh := make(chan handlers.UserStreamUpdate, 10)
+	require.NoError(t, replicas[0].NewRequest(ctx, key, msg, callbackCh, updatesCh, nil, time.Hour, 0))
+
+	// published locally and by another replica, in order
+	require.NoError(t, replicas[0].Publish(ctx, key, handlers.UserStreamUpdate{Type: handlers.UserStreamStatus, Status: "accepted"}))
+	require.NoError(t, replicas[1].Publish(ctx, key, handlers.UserStreamUpdate{Type: handlers.UserStreamNodeResponse, NodeAddress: "0xbb", Msg: msg}))
+	require.NoError(t, replicas[1].Publish(ctx, key, handlers.UserStreamUpdate{Type: handlers.UserStreamStatus, Status: "running"}))
+
+	update := <-updatesCh
+	require.Equal(t, "accepted", update.Status)
+	update = <-updatesCh
+	require.Equal(t, handlers.UserStreamNodeResponse, update.Type)
+	require.Equal(t, "0xbb", update.NodeAddress)
+	require.Equal(t, msg.Body.MessageId, update.Msg.Body.MessageId)
+	update = <-updatesCh
+	require.Equal(t, "running", update.Status)
+
+	// updates of requests which are not streamed or not pending are dropped
+	otherKey, otherMsg := newRequest("2")
+	require.NoError(t, replicas[0].NewRequest(ctx, otherKey, otherMsg, make(chan handlers.UserCallbackPayload, 1), nil, nil, time.Hour, 0))
+	require.NoError(t, replicas[1].Publish(ctx, otherKey, handlers.UserStreamUpdate{Type: handlers.UserStreamStatus}))
+	unknownKey, _ := newRequest("3")
+	require.NoError(t, replicas[1].Publish(ctx, unknownKey, handlers.UserStreamUpdate{Type: handlers.UserStreamStatus}))
+}
+
+func TestReplica_ResponseAggregatedLocally(t *testing.T) {
+	t.Parallel()
+
+	replica := newReplicas(t, 1)[0]
+	ctx := testutils.Context(t)
+	key, msg := newRequest("1")
+
+	// delivered without polling the store
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, replica.NewRequest(ctx, key, msg, callbackCh, nil, nil, time.Hour, 0))
+	require.NoError(t, replica.ProcessResponse(ctx, key, countResponses(msg, 1)))
+	resp := <-callbackCh
+	require.Equal(t, api.NoError, resp.ErrCode)
+}
+
+func TestReplica_Timeout(t *testing.T) {
+	t.Parallel()
+
+	replica := newReplicas(t, 1)[0]
+	ctx := testutils.Context(t)
+	key, msg := newRequest("1")
+
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, replica.NewRequest(ctx, key, msg, callbackCh, nil, nil, 10*time.Millisecond, 0))
+	resp := <-callbackCh
+	require.Equal(t, api.RequestTimeoutError, resp.ErrCode)
+	require.ErrorIs(t, replica.ProcessResponse(ctx, key, countResponses(msg, 1)), sharedstate.ErrRequestNotFound)
+
+	// the message ID can be reused after the timeout
+	require.NoError(t, replica.NewRequest(ctx, key, msg, make(chan handlers.UserCallbackPayload, 1), nil, nil, time.Hour, 0))
+}
+
+func TestReplica_DuplicateRequests(t *testing.T) {
+	t.Parallel()
+
+	replicas := newReplicas(t, 2)
+	ctx := testutils.Context(t)
+	key, msg := newRequest("1")
+
+	require.NoError(t, replicas[0].NewRequest(ctx, key, msg, make(chan handlers.UserCallbackPayload, 1), nil, nil, time.Hour, 0))
+	require.ErrorIs(t, replicas[0].NewRequest(ctx, key, msg, make(chan handlers.UserCallbackPayload, 1), nil, nil, time.Hour, 0), sharedstate.ErrRequestExists)
+	require.ErrorIs(t, replicas[1].NewRequest(ctx, key, msg, make(chan handlers.UserCallbackPayload, 1), nil, nil, time.Hour, 0), sharedstate.ErrRequestExists)
+}
+
+func TestReplica_CacheFull(t *testing.T) {
+	t.Parallel()
+
+	replica := newReplicas(t, 1)[0]
+	ctx := testutils.Context(t)
+	key1, msg1 := newRequest("1")
+	key2, msg2 := newRequest("2")
+
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	require.NoError(t, replica.NewRequest(ctx, key1, msg1, callbackCh, nil, nil, time.Hour, 1))
+	require.ErrorIs(t, replica.NewRequest(ctx, key2, msg2, make(chan handlers.UserCallbackPayload, 1), nil, nil, time.Hour, 1), sharedstate.ErrRequestCacheFull)
+
+	// the limit applies per DON
+	otherDON := sharedstate.RequestKey{DonID: "other", Sender: key2.Sender, MessageID: key2.MessageID}
+	require.NoError(t, replica.NewRequest(ctx, otherDON, msg2, make(chan handlers.UserCallbackPayload, 1), nil, nil, time.Hour, 1))
+
+	require.NoError(t, replica.ProcessResponse(ctx, key1, countResponses(msg1, 1)))
+	<-callbackCh
+	require.NoError(t, replica.NewRequest(ctx, key2, msg2, make(chan handlers.UserCallbackPayload, 1), nil, nil, time.Hour, 1))
+}
+
+func TestReplica_ForwardToNode(t *testing.T) {
+	t.Parallel()
+
+	replicas := newReplicas(t, 2)
+	forwarded := make(chan *api.Message, 1)
+	replicas[0].SetNodeForwarder(func(_ context.Context, donID string, nodeAddress string, msg *api.Message) error {
+		require.Equal(t, "don", donID)
+		require.Equal(t, "0xbb", nodeAddress)
+		forwarded <- msg
+		return nil
+	})
+	require.NoError(t, replicas[0].Start(testutils.Context(t)))
+	ctx := testutils.Context(t)
+
+	_, err := replicas[1].NodeOwner(ctx, "don", "0xbb")
+	require.ErrorIs(t, err, sharedstate.ErrNotFound)
+	require.NoError(t, replicas[0].ClaimNode(ctx, "don", "0xbb"))
+	owner, err := replicas[1].NodeOwner(ctx, "don", "0xbb")
+	require.NoError(t, err)
+	require.Equal(t, replicas[0].ID(), owner)
+
+	_, msg := newRequest("1")
+	require.NoError(t, replicas[1].ForwardToNode(ctx, owner, "don", "0xbb", msg))
+	select {
+	case received := <-forwarded:
+		require.Equal(t, msg.Body.MessageId, received.Body.MessageId)
+	case <-ctx.Done():
+		t.Fatal("message not forwarded")
+	}
+
+	// claims are released when the replica is closed
+	require.NoError(t, replicas[0].Close())
+	_, err = replicas[1].NodeOwner(ctx, "don", "0xbb")
+	require.ErrorIs(t, err, sharedstate.ErrNotFound)
+}
+
+func TestReplica_RenewsNodeClaims(t *testing.T) {
+	t.Parallel()
+
+	replica := sharedstate.NewReplica(sharedstate.NewMemoryStore(), config.SharedStateConfig{NodeLeaseSec: 1}, logger.TestLogger(t))
+	servicetest.Run(t, replica)
+	ctx := testutils.Context(t)
+
+	require.NoError(t, replica.ClaimNode(ctx, "don", "0xbb"))
+	// still claimed after the initial lease expired
+	time.Sleep(1500 * time.Millisecond)
+	owner, err := replica.NodeOwner(ctx, "don", "0xbb")
+	require.NoError(t, err)
+	require.Equal(t, replica.ID(), owner)
+
+	require.NoError(t, replica.ReleaseNode(ctx, "don", "0xbb"))
+	_, err = replica.NodeOwner(ctx, "don", "0xbb")
+	require.ErrorIs(t, err, sharedstate.ErrNotFound)
+}
@@ -0,0 +1,95 @@
+package sharedstate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+)
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+// Store holds the state shared between gateway replicas.
+// All methods are thread-safe.
+type Store interface {
+	// CreateRequest stores the state of a new pending request owned by a replica.
+	// Returns ErrAlreadyExists if a request with the same key is pending.
+	CreateRequest(ctx context.Context, key RequestKey, owner string, state []byte, streaming bool, expiresAt time.Time) error
+	// RequestOwner returns the replica owning a pending request and whether it streams updates, or ErrNotFound.
+	RequestOwner(ctx context.Context, key RequestKey) (owner string, streaming bool, err error)
+	// UpdateRequest atomically replaces the state of a pending request with the result of fn.
+	// A nil newState keeps the current state. The request is deleted when fn returns done.
+	// Returns the owner of the request, or ErrNotFound.
+	UpdateRequest(ctx context.Context, key RequestKey, fn func(state []byte) (newState []byte, done bool)) (owner string, err error)
+	// DeleteRequest deletes a pending request and reports whether it existed.
+	DeleteRequest(ctx context.Context, key RequestKey) (bool, error)
+
+	// ClaimNode records that a node is connected to a replica until expiresAt.
+	ClaimNode(ctx context.Context, donID string, nodeAddress string, owner string, expiresAt time.Time) error
+	// ReleaseNode removes the claim of a node, if it is still held by owner.
+	ReleaseNode(ctx context.Context, donID string, nodeAddress string, owner string) error
+	// NodeOwner returns the replica holding an unexpired claim of a node, or ErrNotFound.
+	NodeOwner(ctx context.Context, donID string, nodeAddress string, now time.Time) (string, error)
+
+	// Send queues a message for a replica.
+	Send(ctx context.Context, msg Message) error
+	// Receive removes and returns up to limit messages queued for a replica, oldest first.
+	Receive(ctx context.Context, recipient string, limit int) ([]Message, error)
+
+	// AddUsage adds to the usage counted in a bucket of a quota key. Buckets are identified by
+	// their start and kept until the expiry set when they are first added to.
+	AddUsage(ctx context.Context, key string, bucket UsageBucket) error
+	// Usage returns the buckets of a quota key which did not expire at now, oldest first.
+	Usage(ctx context.Context, key string, now time.Time) ([]UsageBucket, error)
+
+	// DeleteExpired removes requests, node claims, messages and usage buckets that expired before now.
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
+
+// RequestKey identifies a pending user request.
+type RequestKey struct {
+	DonID     string
+	Sender    string
+	MessageID string
+}
+
+// Message is sent between replicas.
+type Message struct {
+	Recipient string
+	Kind      string
+	Payload   []byte
+	ExpiresAt time.Time
+}
+
+// UsageBucket counts requests and their payload bytes over a part of a quota window.
+type UsageBucket struct {
+	Start        time.Time
+	ExpiresAt    time.Time
+	Requests     uint64
+	PayloadBytes uint64
+}
+
+// NewStore returns the store for the given backend. The postgres backend requires a data source.
+func NewStore(backend string, ds sqlutil.DataSource) (Store, error) {
+	switch backend {
+	case "", BackendMemory:
+		return NewMemoryStore(), nil
+	case BackendPostgres:
+		if ds == nil {
+			return nil, errors.New("postgres shared state backend requires a data source")
+		}
+		return NewORM(ds), nil
+	default:
+		return nil, fmt.Errorf("unknown shared state backend %q", backend)
+	}
+}
@@ -0,0 +1,192 @@
+package sharedstate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/sharedstate"
+)
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+	runStoreTests(t, func(t *testing.T) sharedstate.Store { return sharedstate.NewMemoryStore() })
+}
+
+func TestORM(t *testing.T) {
+	t.Parallel()
+	runStoreTests(t, func(t *testing.T) sharedstate.Store { return sharedstate.NewORM(pgtest.NewSqlxDB(t)) })
+}
+
+func TestNewStore(t *testing.T) {
+	t.Parallel()
+
+	_, err := sharedstate.NewStore("", nil)
+	require.NoError(t, err)
+	_, err = sharedstate.NewStore(sharedstate.BackendMemory, nil)
+	require.NoError(t, err)
+	_, err = sharedstate.NewStore(sharedstate.BackendPostgres, nil)
+	require.Error(t, err)
+	_, err = sharedstate.NewStore("redis", nil)
+	require.Error(t, err)
+}
+
+func runStoreTests(t *testing.T, newStore func(t *testing.T) sharedstate.Store) {
+	key := sharedstate.RequestKey{DonID: "don", Sender: "0xaa", MessageID: "1"}
+
+	t.Run("requests", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		store := newStore(t)
+		expiresAt := time.Now().Add(time.Hour)
+
+		require.NoError(t, store.CreateRequest(ctx, key, "replica1", []byte("a"), true, expiresAt))
+		require.ErrorIs(t, store.CreateRequest(ctx, key, "replica2", []byte("b"), false, expiresAt), sharedstate.ErrAlreadyExists)
+		owner, streaming, err := store.RequestOwner(ctx, key)
+		require.NoError(t, err)
+		require.Equal(t, "replica1", owner)
+		require.True(t, streaming)
+
+		owner, err = store.UpdateRequest(ctx, key, func(state []byte) ([]byte, bool) {
+			require.Equal(t, []byte("a"), state)
+			return []byte("ab"), false
+		})
+		require.NoError(t, err)
+		require.Equal(t, "replica1", owner)
+
+		// nil state is not stored
+		_, err = store.UpdateRequest(ctx, key, func(state []byte) ([]byte, bool) {
+			require.Equal(t, []byte("ab"), state)
+			return nil, false
+		})
+		require.NoError(t, err)
+
+		_, err = store.UpdateRequest(ctx, key, func(state []byte) ([]byte, bool) {
+			require.Equal(t, []byte("ab"), state)
+			return nil, true
+		})
+		require.NoError(t, err)
+		_, err = store.UpdateRequest(ctx, key, func(state []byte) ([]byte, bool) { return nil, true })
+		require.ErrorIs(t, err, sharedstate.ErrNotFound)
+		_, _, err = store.RequestOwner(ctx, key)
+		require.ErrorIs(t, err, sharedstate.ErrNotFound)
+
+		require.NoError(t, store.CreateRequest(ctx, key, "replica1", []byte("a"), false, expiresAt))
+		deleted, err := store.DeleteRequest(ctx, key)
+		require.NoError(t, err)
+		require.True(t, deleted)
+		deleted, err = store.DeleteRequest(ctx, key)
+		require.NoError(t, err)
+		require.False(t, deleted)
+	})
+
+	t.Run("expired requests", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		store := newStore(t)
+
+		require.NoError(t, store.CreateRequest(ctx, key, "replica1", []byte("a"), false, time.Now().Add(-time.Second)))
+		// expired requests can be replaced
+		require.NoError(t, store.CreateRequest(ctx, key, "replica2", []byte("b"), false, time.Now().Add(-time.Second)))
+		require.NoError(t, store.DeleteExpired(ctx, time.Now()))
+		_, err := store.UpdateRequest(ctx, key, func(state []byte) ([]byte, bool) { return nil, true })
+		require.ErrorIs(t, err, sharedstate.ErrNotFound)
+	})
+
+	t.Run("node claims", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		store := newStore(t)
+		now := time.Now()
+
+		_, err := store.NodeOwner(ctx, "don", "0xbb", now)
+		require.ErrorIs(t, err, sharedstate.ErrNotFound)
+
+		require.NoError(t, store.ClaimNode(ctx, "don", "0xbb", "replica1", now.Add(time.Minute)))
+		owner, err := store.NodeOwner(ctx, "don", "0xbb", now)
+		require.NoError(t, err)
+		require.Equal(t, "replica1", owner)
+
+		// the node reconnected to another replica
+		require.NoError(t, store.ClaimNode(ctx, "don", "0xbb", "replica2", now.Add(time.Minute)))
+		require.NoError(t, store.ReleaseNode(ctx, "don", "0xbb", "replica1"))
+		owner, err = store.NodeOwner(ctx, "don", "0xbb", now)
+		require.NoError(t, err)
+		require.Equal(t, "replica2", owner)
+
+		_, err = store.NodeOwner(ctx, "don", "0xbb", now.Add(2*time.Minute))
+		require.ErrorIs(t, err, sharedstate.ErrNotFound)
+
+		require.NoError(t, store.ReleaseNode(ctx, "don", "0xbb", "replica2"))
+		_, err = store.NodeOwner(ctx, "don", "0xbb", now)
+		require.ErrorIs(t, err, sharedstate.ErrNotFound)
+	})
+
+	t.Run("usage", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		store := newStore(t)
+		now := time.Now().Truncate(time.Second)
+
+		buckets, err := store.Usage(ctx, "quota", now)
+		require.NoError(t, err)
+		require.Empty(t, buckets)
+
+		older := sharedstate.UsageBucket{Start: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour), Requests: 1, PayloadBytes: 10}
+		newer := sharedstate.UsageBucket{Start: now, ExpiresAt: now.Add(2 * time.Hour), Requests: 1, PayloadBytes: 5}
+		require.NoError(t, store.AddUsage(ctx, "quota", newer))
+		require.NoError(t, store.AddUsage(ctx, "quota", older))
+		require.NoError(t, store.AddUsage(ctx, "quota", newer))
+		require.NoError(t, store.AddUsage(ctx, "other", newer))
+
+		buckets, err = store.Usage(ctx, "quota", now)
+		require.NoError(t, err)
+		require.Len(t, buckets, 2)
+		require.True(t, older.Start.Equal(buckets[0].Start))
+		require.Equal(t, uint64(1), buckets[0].Requests)
+		require.Equal(t, uint64(10), buckets[0].PayloadBytes)
+		require.True(t, newer.Start.Equal(buckets[1].Start))
+		require.Equal(t, uint64(2), buckets[1].Requests)
+		require.Equal(t, uint64(10), buckets[1].PayloadBytes)
+
+		// expired buckets are not counted
+		buckets, err = store.Usage(ctx, "quota", now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, buckets, 1)
+		require.NoError(t, store.DeleteExpired(ctx, now.Add(3*time.Hour)))
+		buckets, err = store.Usage(ctx, "other", now)
+		require.NoError(t, err)
+		require.Empty(t, buckets)
+	})
+
+	t.Run("messages", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		store := newStore(t)
+		expiresAt := time.Now().Add(time.Minute)
+
+		for _, payload := range []string{"1", "2", "3"} {
+			require.NoError(t, store.Send(ctx, sharedstate.Message{Recipient: "replica1", Kind: "kind", Payload: []byte(payload), ExpiresAt: expiresAt}))
+		}
+		require.NoError(t, store.Send(ctx, sharedstate.Message{Recipient: "replica2", Kind: "kind", Payload: []byte("4"), ExpiresAt: expiresAt}))
+		require.NoError(t, store.Send(ctx, sharedstate.Message{Recipient: "replica2", Kind: "kind", Payload: []byte("5"), ExpiresAt: time.Now().Add(-time.Second)}))
+
+		msgs, err := store.Receive(ctx, "replica1", 2)
+		require.NoError(t, err)
+		require.Len(t, msgs, 2)
+		require.Equal(t, []byte("1"), msgs[0].Payload)
+		require.Equal(t, []byte("2"), msgs[1].Payload)
+		msgs, err = store.Receive(ctx, "replica1", 2)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.Equal(t, []byte("3"), msgs[0].Payload)
+		msgs, err = store.Receive(ctx, "replica1", 2)
+		require.NoError(t, err)
+		require.Empty(t, msgs)
+
+		require.NoError(t, store.DeleteExpired(ctx, time.Now()))
+		msgs, err = store.Receive(ctx, "replica2", 10)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.Equal(t, "kind", msgs[0].Kind)
+		require.Equal(t, []byte("4"), msgs[0].Payload)
+	})
+}
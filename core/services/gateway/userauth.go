@@ -0,0 +1,96 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// MessageSigner signs messages with Eth keys held by the gateway node. It is satisfied by keystore.Eth.
+type MessageSigner interface {
+	SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error)
+}
+
+// apiKeyAuth authenticates users by API keys, sent by clients which can't sign requests.
+// Nodes only accept signed messages, so the gateway signs such requests with the key's sender.
+type apiKeyAuth struct {
+	required bool
+	// keys by hex-encoded SHA-256 hash
+	keys   map[string]config.APIKeyConfig
+	signer MessageSigner
+}
+
+func newAPIKeyAuth(cfg config.APIKeysConfig, signer MessageSigner) (*apiKeyAuth, error) {
+	auth := &apiKeyAuth{required: cfg.Required, keys: make(map[string]config.APIKeyConfig), signer: signer}
+	for _, key := range cfg.Keys {
+		hash, err := hex.DecodeString(strings.TrimPrefix(key.KeyHash, "0x"))
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid hash of API key %s", key.Name)
+		}
+		if !common.IsHexAddress(key.Sender) {
+			return nil, fmt.Errorf("invalid sender address %s of API key %s", key.Sender, key.Name)
+		}
+		key.Sender = strings.ToLower(key.Sender)
+		encodedHash := hex.EncodeToString(hash)
+		if _, ok := auth.keys[encodedHash]; ok {
+			return nil, fmt.Errorf("duplicate API key %s", key.Name)
+		}
+		auth.keys[encodedHash] = key
+	}
+	if auth.required && len(auth.keys) == 0 {
+		return nil, errors.New("API keys are required but none are configured")
+	}
+	if len(auth.keys) > 0 && signer == nil {
+		return nil, errors.New("API keys require a message signer")
+	}
+	return auth, nil
+}
+
+// authenticate validates msg and sets its sender. Signed messages are sent by their signer, unsigned
+// messages require an API key and are signed with the sender configured for the key.
+func (a *apiKeyAuth) authenticate(ctx context.Context, msg *api.Message) (api.ErrorCode, error) {
+	var key *config.APIKeyConfig
+	// API keys are ignored when none are configured
+	if apiKey := gw_net.APIKeyFromContext(ctx); apiKey != "" && len(a.keys) > 0 {
+		hash := sha256.Sum256([]byte(apiKey))
+		found, ok := a.keys[hex.EncodeToString(hash[:])]
+		if !ok {
+			return api.UnauthorizedError, errors.New("invalid API key")
+		}
+		key = &found
+	} else if a.required {
+		return api.UnauthorizedError, errors.New("missing API key")
+	}
+	if key == nil || msg.Signature != "" {
+		if err := msg.Validate(); err != nil {
+			return api.UserMessageParseError, err
+		}
+		return api.NoError, nil
+	}
+	if err := msg.ValidateBody(); err != nil {
+		return api.UserMessageParseError, err
+	}
+	signature, err := a.signer.SignMessage(ctx, common.HexToAddress(key.Sender), bytes.Join(api.GetRawMessageBody(&msg.Body), nil))
+	if err != nil {
+		return api.FatalError, fmt.Errorf("failed to sign request of API key %s: %w", key.Name, err)
+	}
+	msg.Signature = utils.StringToHex(string(signature))
+	if err := msg.Validate(); err != nil {
+		return api.FatalError, fmt.Errorf("invalid signature of API key %s: %w", key.Name, err)
+	}
+	if msg.Body.Sender != key.Sender {
+		return api.FatalError, fmt.Errorf("request of API key %s signed by %s instead of %s", key.Name, msg.Body.Sender, key.Sender)
+	}
+	return api.NoError, nil
+}
@@ -0,0 +1,192 @@
+package gateway_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	handler_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const testAPIKey = "secret-key"
+
+var (
+	testAPIKeyPrivateKey, _ = crypto.HexToECDSA("4a1d6cc5f3e1cdf6a3b2e0c1d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0")
+	testAPIKeySender        = strings.ToLower(crypto.PubkeyToAddress(testAPIKeyPrivateKey.PublicKey).Hex())
+)
+
+// keySigner signs messages like keystore.Eth would with a single key
+type keySigner struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+func (s keySigner) SignMessage(_ context.Context, address common.Address, data []byte) ([]byte, error) {
+	if address != crypto.PubkeyToAddress(s.privateKey.PublicKey) {
+		return nil, errors.New("key not found")
+	}
+	return utils.GenerateEthSignature(s.privateKey, data)
+}
+
+func apiKeyContext(t *testing.T, apiKey string) context.Context {
+	return gw_net.ContextWithAPIKey(testutils.Context(t), apiKey)
+}
+
+func newGatewayWithUserConfig(t *testing.T, toAppend string) (gateway.Gateway, *handler_mocks.Handler) {
+	handler := handler_mocks.NewHandler(t)
+	tomlConfig := buildConfig(`
+[[dons]]
+DonId = "testDON"
+HandlerName = "dummy"
+` + toAppend)
+	lggr := logger.TestLogger(t)
+	gw, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, tomlConfig), staticHandlerFactory{handler}, nil, keySigner{testAPIKeyPrivateKey}, lggr)
+	require.NoError(t, err)
+	return gw, handler
+}
+
+// echoSender responds with the signer of the forwarded message, as seen by the nodes
+func echoSender(handler *handler_mocks.Handler) {
+	handler.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		msg := args.Get(1).(*api.Message)
+		callbackCh := args.Get(2).(chan<- handlers.UserCallbackPayload)
+		forwarded := api.Message{Signature: msg.Signature, Body: msg.Body}
+		forwarded.Body.Sender = ""
+		if err := forwarded.Validate(); err != nil {
+			callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: api.FatalError, ErrMsg: err.Error()}
+			return
+		}
+		msg.Body.Payload = []byte(fmt.Sprintf(`"%s"`, forwarded.Body.Sender))
+		msg.Signature = ""
+		callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError}
+	})
+}
+
+func apiKeysConfig(required bool) string {
+	hash := sha256.Sum256([]byte(testAPIKey))
+	return fmt.Sprintf(`
+[apiKeys]
+Required = %t
+[[apiKeys.keys]]
+Name = "test"
+KeyHash = "%s"
+Sender = "%s"
+`, required, hex.EncodeToString(hash[:]), testAPIKeySender)
+}
+
+func newUnsignedRequest(t *testing.T, messageID string, method string, payload []byte) []byte {
+	msg := &api.Message{Body: api.MessageBody{MessageId: messageID, Method: method, DonId: "testDON", Payload: payload}}
+	rawRequest, err := (&api.JsonRPCCodec{}).EncodeRequest(msg)
+	require.NoError(t, err)
+	return rawRequest
+}
+
+func TestGateway_ProcessRequest_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithUserConfig(t, apiKeysConfig(false))
+	echoSender(handler)
+
+	// unsigned requests are signed by the sender of the API key
+	response, statusCode := gw.ProcessRequest(apiKeyContext(t, testAPIKey), newUnsignedRequest(t, "abcd", "request", nil))
+	require.Equal(t, 200, statusCode)
+	require.Contains(t, string(response), testAPIKeySender)
+
+	response, statusCode = gw.ProcessRequest(apiKeyContext(t, "wrong"), newUnsignedRequest(t, "abcd", "request", nil))
+	requireJsonRPCError(t, response, "abcd", -32001, "invalid API key")
+	require.Equal(t, 401, statusCode)
+
+	response, statusCode = gw.ProcessRequest(testutils.Context(t), newUnsignedRequest(t, "abcd", "request", nil))
+	requireJsonRPCError(t, response, "abcd", -32700, "invalid hex-encoded signature length")
+	require.Equal(t, 400, statusCode)
+
+	// signed requests don't need an API key
+	_, statusCode = gw.ProcessRequest(testutils.Context(t), newSignedRequest(t, "abcd", "request", "testDON", []byte{}))
+	require.Equal(t, 200, statusCode)
+}
+
+func TestGateway_ProcessRequest_APIKeyRequired(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithUserConfig(t, apiKeysConfig(true))
+	echoSender(handler)
+
+	response, statusCode := gw.ProcessRequest(testutils.Context(t), newSignedRequest(t, "abcd", "request", "testDON", []byte{}))
+	requireJsonRPCError(t, response, "abcd", -32001, "missing API key")
+	require.Equal(t, 401, statusCode)
+
+	_, statusCode = gw.ProcessRequest(apiKeyContext(t, testAPIKey), newSignedRequest(t, "abcd", "request", "testDON", []byte{}))
+	require.Equal(t, 200, statusCode)
+}
+
+func TestGateway_NewGatewayFromConfig_InvalidAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	for name, toAppend := range map[string]string{
+		"invalid hash":   "[[apiKeys.keys]]\nName = \"test\"\nKeyHash = \"abcd\"\nSender = \"" + testAPIKeySender + "\"",
+		"invalid sender": "[[apiKeys.keys]]\nName = \"test\"\nKeyHash = \"" + hex.EncodeToString(make([]byte, 32)) + "\"\nSender = \"0x12\"",
+		"no keys":        "[apiKeys]\nRequired = true",
+		"no signer":      apiKeysConfig(false),
+	} {
+		t.Run(name, func(t *testing.T) {
+			lggr := logger.TestLogger(t)
+			_, err := gateway.NewGatewayFromConfig(parseTOMLConfig(t, buildConfig(toAppend)), gateway.NewHandlerFactory(nil, nil, nil, lggr), nil, nil, lggr)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGateway_ProcessRequest_QuotaExceeded(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithUserConfig(t, apiKeysConfig(false)+`
+[quotas]
+WindowSec = 3600
+[quotas.perSender]
+MaxRequests = 3
+[quotas.perMethod.upload]
+MaxPayloadBytes = 5
+`)
+	echoSender(handler)
+	ctx := apiKeyContext(t, testAPIKey)
+
+	_, statusCode := gw.ProcessRequest(ctx, newUnsignedRequest(t, "1", "upload", []byte(`"abc"`)))
+	require.Equal(t, 200, statusCode)
+	response, statusCode := gw.ProcessRequest(ctx, newUnsignedRequest(t, "2", "upload", []byte(`"abc"`)))
+	require.Equal(t, 429, statusCode)
+	var jsonResponse api.JsonRPCResponse
+	require.NoError(t, json.Unmarshal(response, &jsonResponse))
+	require.Equal(t, -32005, jsonResponse.Error.Code)
+	require.Equal(t, "method upload quota exceeded", jsonResponse.Error.Message)
+	var data struct {
+		RetryAfterSec int64 `json:"retry_after_sec"`
+	}
+	require.NoError(t, json.Unmarshal(jsonResponse.Error.Data, &data))
+	require.Greater(t, data.RetryAfterSec, int64(3600-150))
+	require.LessOrEqual(t, data.RetryAfterSec, int64(3600))
+
+	for _, id := range []string{"3", "4"} {
+		_, statusCode = gw.ProcessRequest(ctx, newUnsignedRequest(t, id, "request", nil))
+		require.Equal(t, 200, statusCode)
+	}
+	response, statusCode = gw.ProcessRequest(ctx, newUnsignedRequest(t, "5", "request", nil))
+	require.Equal(t, 429, statusCode)
+	require.Contains(t, string(response), `"message":"sender quota exceeded"`)
+}
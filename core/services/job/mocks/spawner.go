@@ -349,6 +349,53 @@ func (_c *Spawner_Ready_Call) RunAndReturn(run func() error) *Spawner_Ready_Call
 	return _c
 }
 
+// RestartJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) RestartJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestartJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_RestartJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartJob'
+type Spawner_RestartJob_Call struct {
+	*mock.Call
+}
+
+// RestartJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Spawner_Expecter) RestartJob(ctx interface{}, jobID interface{}) *Spawner_RestartJob_Call {
+	return &Spawner_RestartJob_Call{Call: _e.mock.On("RestartJob", ctx, jobID)}
+}
+
+func (_c *Spawner_RestartJob_Call) Run(run func(ctx context.Context, jobID int32)) *Spawner_RestartJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_RestartJob_Call) Return(_a0 error) *Spawner_RestartJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_RestartJob_Call) RunAndReturn(run func(context.Context, int32) error) *Spawner_RestartJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
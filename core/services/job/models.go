@@ -880,6 +880,7 @@ type WorkflowSpec struct {
 	CreatedAt     time.Time        `toml:"-"`
 	UpdatedAt     time.Time        `toml:"-"`
 	SpecType      WorkflowSpecType `toml:"spec_type" db:"spec_type"`
+	Paused        bool             `toml:"-" db:"paused"` // paused workflows do not start executions on trigger events
 	sdkWorkflow   *sdk.WorkflowSpec
 	rawSpec       []byte
 	config        []byte
@@ -30,6 +30,8 @@ type (
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
+		// RestartJob stops the services of a job, and starts them again from the job stored in the DB.
+		RestartJob(ctx context.Context, jobID int32) error
 
 		// StartService starts services for the given job spec.
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -342,6 +344,20 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) RestartJob(ctx context.Context, jobID int32) error {
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	js.stopService(jobID)
+	if err = js.StartService(ctx, jb); err != nil {
+		return err
+	}
+	js.lggr.Infow("Restarted job services", "type", jb.Type, "jobID", jb.ID)
+	return nil
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -0,0 +1,8 @@
+package keyrotation
+
+import "context"
+
+// CheckRotations runs a check of the pending rotations, as done periodically once started.
+func (r *Rotator) CheckRotations(ctx context.Context) {
+	r.checkRotations(ctx)
+}
@@ -0,0 +1,58 @@
+package keyrotation
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// KeyType is the type of the rotated key.
+type KeyType string
+
+const (
+	KeyTypeOCR2 KeyType = "ocr2"
+	KeyTypeP2P  KeyType = "p2p"
+)
+
+// State is the state of a rotation.
+type State string
+
+const (
+	// StatePending rotations wait for the contract config of their jobs to list the successor key.
+	StatePending State = "pending"
+	// StateArchived rotations have switched all their jobs to the successor key, and archived the old key.
+	StateArchived State = "archived"
+)
+
+// Rotation replaces an OCR2 key bundle or a P2P key by a successor key. Both keys remain valid while the contract
+// configs of the jobs using the old key are updated.
+type Rotation struct {
+	ID       int64
+	KeyType  KeyType `db:"key_type"`
+	OldKeyID string  `db:"old_key_id"`
+	NewKeyID string  `db:"new_key_id"`
+	State    State
+	// ArchivedKey is the old key exported with the keystore password, once archived.
+	ArchivedKey []byte    `db:"archived_key"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+	ArchivedAt  null.Time `db:"archived_at"`
+	Jobs        []Job     `db:"-"`
+}
+
+// Job is a job using the rotated key.
+type Job struct {
+	RotationID int64 `db:"key_rotation_id"`
+	JobID      int32 `db:"job_id"`
+	// ConfigDigest is the digest of the first contract config observed with the successor key, if any. Bootstrap jobs
+	// are not listed in contract configs.
+	ConfigDigest []byte `db:"config_digest"`
+	// SwitchedAt is set when the job switched to the successor key. P2P keys are shared by all the jobs, and take
+	// effect on the next restart of the node.
+	SwitchedAt null.Time `db:"switched_at"`
+}
+
+// Switched returns true if the job uses the successor key.
+func (j Job) Switched() bool {
+	return j.SwitchedAt.Valid
+}
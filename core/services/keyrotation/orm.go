@@ -0,0 +1,281 @@
+package keyrotation
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	ocr1types "github.com/smartcontractkit/libocr/offchainreporting/types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+type ORM interface {
+	CreateRotation(ctx context.Context, r *Rotation, jobIDs []int32) error
+	ListRotations(ctx context.Context) ([]Rotation, error)
+	PendingRotations(ctx context.Context) ([]Rotation, error)
+	MarkJobSwitched(ctx context.Context, rotationID int64, jobID int32, digest []byte) error
+	ArchiveRotation(ctx context.Context, id int64, archivedKey []byte) error
+
+	FindP2PJobIDs(ctx context.Context) ([]int32, error)
+	FindBootstrapJobIDs(ctx context.Context) ([]int32, error)
+	FindOCR2JobIDsByKeyBundle(ctx context.Context, keyBundleID string) ([]int32, error)
+	UpdateJobKeyBundle(ctx context.Context, jobID int32, keyBundleID string) error
+	ContractConfigs(ctx context.Context, jobID int32) ([]ocrtypes.ContractConfig, error)
+	OCR1ContractConfigs(ctx context.Context, jobID int32) ([]ocr1types.ContractConfig, error)
+
+	Transact(context.Context, func(ORM) error) error
+	WithDataSource(sqlutil.DataSource) ORM
+}
+
+var _ ORM = &orm{}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+func NewORM(ds sqlutil.DataSource) *orm {
+	return &orm{ds: ds}
+}
+
+func (o *orm) Transact(ctx context.Context, fn func(ORM) error) error {
+	return sqlutil.Transact(ctx, o.WithDataSource, o.ds, nil, fn)
+}
+
+func (o *orm) WithDataSource(ds sqlutil.DataSource) ORM { return &orm{ds} }
+
+// CreateRotation creates a pending rotation of the jobs.
+func (o *orm) CreateRotation(ctx context.Context, r *Rotation, jobIDs []int32) error {
+	return sqlutil.TransactDataSource(ctx, o.ds, nil, func(ds sqlutil.DataSource) error {
+		stmt := `
+INSERT INTO key_rotations (key_type, old_key_id, new_key_id, state, created_at, updated_at)
+VALUES ($1, $2, $3, $4, NOW(), NOW())
+RETURNING id, state, created_at, updated_at;
+`
+		if err := ds.QueryRowxContext(ctx, stmt, r.KeyType, r.OldKeyID, r.NewKeyID, StatePending).StructScan(r); err != nil {
+			return errors.Wrap(err, "CreateRotation failed")
+		}
+		r.Jobs = make([]Job, 0, len(jobIDs))
+		for _, jobID := range jobIDs {
+			if _, err := ds.ExecContext(ctx, `INSERT INTO key_rotation_jobs (key_rotation_id, job_id) VALUES ($1, $2);`, r.ID, jobID); err != nil {
+				return errors.Wrap(err, "CreateRotation failed to insert job")
+			}
+			r.Jobs = append(r.Jobs, Job{RotationID: r.ID, JobID: jobID})
+		}
+		return nil
+	})
+}
+
+// ListRotations lists all the rotations, the most recent first.
+func (o *orm) ListRotations(ctx context.Context) ([]Rotation, error) {
+	rotations, err := o.findRotations(ctx, `SELECT * FROM key_rotations ORDER BY id DESC;`)
+	return rotations, errors.Wrap(err, "ListRotations failed")
+}
+
+// PendingRotations lists the rotations waiting for the contract config of their jobs to list the successor key.
+func (o *orm) PendingRotations(ctx context.Context) ([]Rotation, error) {
+	rotations, err := o.findRotations(ctx, `SELECT * FROM key_rotations WHERE state = $1 ORDER BY id;`, StatePending)
+	return rotations, errors.Wrap(err, "PendingRotations failed")
+}
+
+func (o *orm) findRotations(ctx context.Context, stmt string, args ...any) ([]Rotation, error) {
+	var rotations []Rotation
+	if err := o.ds.SelectContext(ctx, &rotations, stmt, args...); err != nil {
+		return nil, err
+	}
+	if len(rotations) == 0 {
+		return rotations, nil
+	}
+
+	ids := make([]int64, len(rotations))
+	idx := make(map[int64]int, len(rotations))
+	for i, r := range rotations {
+		ids[i] = r.ID
+		idx[r.ID] = i
+	}
+	var jobs []Job
+	if err := o.ds.SelectContext(ctx, &jobs, `SELECT * FROM key_rotation_jobs WHERE key_rotation_id = ANY($1) ORDER BY job_id;`, pq.Array(ids)); err != nil {
+		return nil, err
+	}
+	for _, j := range jobs {
+		r := &rotations[idx[j.RotationID]]
+		r.Jobs = append(r.Jobs, j)
+	}
+	return rotations, nil
+}
+
+// MarkJobSwitched records that the job switched to the successor key, after the contract config with the given digest
+// was observed. The digest is nil for the bootstrap jobs, which are not listed in contract configs.
+func (o *orm) MarkJobSwitched(ctx context.Context, rotationID int64, jobID int32, digest []byte) error {
+	stmt := `
+UPDATE key_rotation_jobs SET config_digest = $1, switched_at = NOW()
+WHERE key_rotation_id = $2 AND job_id = $3 AND switched_at IS NULL;
+`
+	_, err := o.ds.ExecContext(ctx, stmt, digest, rotationID, jobID)
+	return errors.Wrap(err, "MarkJobSwitched failed")
+}
+
+// ArchiveRotation completes the rotation, and keeps the exported old key.
+func (o *orm) ArchiveRotation(ctx context.Context, id int64, archivedKey []byte) error {
+	stmt := `
+UPDATE key_rotations SET state = $1, archived_key = $2, archived_at = NOW(), updated_at = NOW()
+WHERE id = $3 AND state = $4;
+`
+	res, err := o.ds.ExecContext(ctx, stmt, StateArchived, archivedKey, id, StatePending)
+	if err != nil {
+		return errors.Wrap(err, "ArchiveRotation failed")
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return errors.Wrap(err, "ArchiveRotation failed")
+	} else if n == 0 {
+		return errors.Errorf("ArchiveRotation failed: no pending rotation with id %d", id)
+	}
+	return nil
+}
+
+// FindP2PJobIDs lists the OCR and OCR2 oracle and bootstrap jobs, which all use the P2P key of the node.
+func (o *orm) FindP2PJobIDs(ctx context.Context) (ids []int32, err error) {
+	stmt := `
+SELECT id FROM jobs
+WHERE ocr_oracle_spec_id IS NOT NULL OR ocr2_oracle_spec_id IS NOT NULL OR bootstrap_spec_id IS NOT NULL
+ORDER BY id;
+`
+	err = o.ds.SelectContext(ctx, &ids, stmt)
+	return ids, errors.Wrap(err, "FindP2PJobIDs failed")
+}
+
+// FindBootstrapJobIDs lists the OCR and OCR2 bootstrap jobs, whose peer IDs are not listed in contract configs.
+func (o *orm) FindBootstrapJobIDs(ctx context.Context) (ids []int32, err error) {
+	stmt := `
+SELECT jobs.id FROM jobs
+LEFT JOIN ocr_oracle_specs ON ocr_oracle_specs.id = jobs.ocr_oracle_spec_id
+WHERE jobs.bootstrap_spec_id IS NOT NULL OR ocr_oracle_specs.is_bootstrap_peer
+ORDER BY jobs.id;
+`
+	err = o.ds.SelectContext(ctx, &ids, stmt)
+	return ids, errors.Wrap(err, "FindBootstrapJobIDs failed")
+}
+
+// FindOCR2JobIDsByKeyBundle lists the OCR2 oracle jobs using the given key bundle.
+func (o *orm) FindOCR2JobIDsByKeyBundle(ctx context.Context, keyBundleID string) (ids []int32, err error) {
+	stmt := `
+SELECT jobs.id FROM jobs
+JOIN ocr2_oracle_specs ON ocr2_oracle_specs.id = jobs.ocr2_oracle_spec_id
+WHERE ocr2_oracle_specs.ocr_key_bundle_id = $1
+ORDER BY jobs.id;
+`
+	err = o.ds.SelectContext(ctx, &ids, stmt, keyBundleID)
+	return ids, errors.Wrap(err, "FindOCR2JobIDsByKeyBundle failed")
+}
+
+// UpdateJobKeyBundle sets the key bundle of an OCR2 oracle job.
+func (o *orm) UpdateJobKeyBundle(ctx context.Context, jobID int32, keyBundleID string) error {
+	stmt := `
+UPDATE ocr2_oracle_specs SET ocr_key_bundle_id = $1, updated_at = NOW()
+FROM jobs
+WHERE jobs.ocr2_oracle_spec_id = ocr2_oracle_specs.id AND jobs.id = $2;
+`
+	res, err := o.ds.ExecContext(ctx, stmt, keyBundleID, jobID)
+	if err != nil {
+		return errors.Wrap(err, "UpdateJobKeyBundle failed")
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return errors.Wrap(err, "UpdateJobKeyBundle failed")
+	} else if n == 0 {
+		return errors.Errorf("UpdateJobKeyBundle failed: no OCR2 oracle job with id %d", jobID)
+	}
+	return nil
+}
+
+// ContractConfigs returns the latest contract configs observed by the plugins of an OCR2 oracle job.
+func (o *orm) ContractConfigs(ctx context.Context, jobID int32) ([]ocrtypes.ContractConfig, error) {
+	stmt := `
+SELECT
+	c.config_digest,
+	c.config_count,
+	c.signers,
+	c.transmitters,
+	c.f,
+	c.onchain_config,
+	c.offchain_config_version,
+	c.offchain_config
+FROM ocr2_contract_configs c
+JOIN jobs ON jobs.ocr2_oracle_spec_id = c.ocr2_oracle_spec_id
+WHERE jobs.id = $1
+ORDER BY c.plugin_id;
+`
+	rows, err := o.ds.QueryContext(ctx, stmt, jobID)
+	if err != nil {
+		return nil, errors.Wrap(err, "ContractConfigs failed")
+	}
+	defer rows.Close()
+
+	var configs []ocrtypes.ContractConfig
+	for rows.Next() {
+		var c ocrtypes.ContractConfig
+		var digest []byte
+		var signers, transmitters [][]byte
+		if err = rows.Scan(
+			&digest,
+			&c.ConfigCount,
+			(*pq.ByteaArray)(&signers),
+			(*pq.ByteaArray)(&transmitters),
+			&c.F,
+			&c.OnchainConfig,
+			&c.OffchainConfigVersion,
+			&c.OffchainConfig,
+		); err != nil {
+			return nil, errors.Wrap(err, "ContractConfigs failed")
+		}
+		copy(c.ConfigDigest[:], digest)
+		for _, s := range signers {
+			c.Signers = append(c.Signers, s)
+		}
+		for _, t := range transmitters {
+			c.Transmitters = append(c.Transmitters, ocrtypes.Account(t))
+		}
+		configs = append(configs, c)
+	}
+	return configs, errors.Wrap(rows.Err(), "ContractConfigs failed")
+}
+
+// OCR1ContractConfigs returns the latest contract config observed by an OCR oracle job.
+func (o *orm) OCR1ContractConfigs(ctx context.Context, jobID int32) ([]ocr1types.ContractConfig, error) {
+	stmt := `
+SELECT c.config_digest, c.signers, c.transmitters, c.threshold, c.encoded_config_version, c.encoded
+FROM ocr_contract_configs c
+JOIN jobs ON jobs.ocr_oracle_spec_id = c.ocr_oracle_spec_id
+WHERE jobs.id = $1;
+`
+	rows, err := o.ds.QueryContext(ctx, stmt, jobID)
+	if err != nil {
+		return nil, errors.Wrap(err, "OCR1ContractConfigs failed")
+	}
+	defer rows.Close()
+
+	var configs []ocr1types.ContractConfig
+	for rows.Next() {
+		var c ocr1types.ContractConfig
+		var signers, transmitters [][]byte
+		if err = rows.Scan(
+			&c.ConfigDigest,
+			(*pq.ByteaArray)(&signers),
+			(*pq.ByteaArray)(&transmitters),
+			&c.Threshold,
+			&c.EncodedConfigVersion,
+			&c.Encoded,
+		); err != nil {
+			return nil, errors.Wrap(err, "OCR1ContractConfigs failed")
+		}
+		for _, s := range signers {
+			c.Signers = append(c.Signers, common.BytesToAddress(s))
+		}
+		for _, t := range transmitters {
+			c.Transmitters = append(c.Transmitters, common.BytesToAddress(t))
+		}
+		configs = append(configs, c)
+	}
+	return configs, errors.Wrap(rows.Err(), "OCR1ContractConfigs failed")
+}
@@ -0,0 +1,133 @@
+package keyrotation_test
+
+import (
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
+	ocr2validate "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+)
+
+func createOCR2Job(t *testing.T, db *sqlx.DB) job.Job {
+	t.Helper()
+	ctx := testutils.Context(t)
+
+	config := configtest.NewGeneralConfig(t, nil)
+	keyStore := cltest.NewKeyStore(t, db)
+	require.NoError(t, keyStore.OCR2().Add(ctx, cltest.DefaultOCR2Key))
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	lggr := logger.TestLogger(t)
+	pipelineORM := pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns())
+	jobORM := job.NewORM(db, pipelineORM, bridges.NewORM(db), keyStore, lggr)
+	t.Cleanup(func() { assert.NoError(t, jobORM.Close()) })
+
+	jb, err := ocr2validate.ValidatedOracleSpecToml(ctx, config.OCR2(), config.Insecure(), testspecs.GetOCR2EVMSpecMinimal(), nil)
+	require.NoError(t, err)
+	jb.OCR2OracleSpec.OCRKeyBundleID = null.StringFrom(cltest.DefaultOCR2Key.ID())
+	jb.OCR2OracleSpec.TransmitterID = null.StringFrom(address.String())
+	require.NoError(t, jobORM.CreateJob(ctx, &jb))
+	return jb
+}
+
+func Test_ORM_Rotation(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	orm := keyrotation.NewORM(db)
+	jb := createOCR2Job(t, db)
+	oldKeyID := cltest.DefaultOCR2Key.ID()
+	const newKeyID = "successor"
+
+	jobIDs, err := orm.FindP2PJobIDs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jb.ID}, jobIDs)
+	bootstrapJobIDs, err := orm.FindBootstrapJobIDs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, bootstrapJobIDs)
+	jobIDs, err = orm.FindOCR2JobIDsByKeyBundle(ctx, oldKeyID)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jb.ID}, jobIDs)
+
+	rot := keyrotation.Rotation{KeyType: keyrotation.KeyTypeOCR2, OldKeyID: oldKeyID, NewKeyID: newKeyID}
+	require.NoError(t, orm.CreateRotation(ctx, &rot, jobIDs))
+	assert.NotZero(t, rot.ID)
+	assert.Equal(t, keyrotation.StatePending, rot.State)
+
+	// Only one pending rotation per key
+	dup := keyrotation.Rotation{KeyType: keyrotation.KeyTypeOCR2, OldKeyID: oldKeyID, NewKeyID: "other"}
+	require.Error(t, orm.CreateRotation(ctx, &dup, nil))
+
+	pending, err := orm.PendingRotations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Len(t, pending[0].Jobs, 1)
+	assert.Equal(t, jb.ID, pending[0].Jobs[0].JobID)
+	assert.False(t, pending[0].Jobs[0].Switched())
+
+	configs, err := orm.ContractConfigs(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Empty(t, configs)
+	ocr1Configs, err := orm.OCR1ContractConfigs(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Empty(t, ocr1Configs)
+
+	cfg := contractConfig(t, cltest.DefaultOCR2Key, "")
+	require.NoError(t, ocr2.NewDB(db, jb.OCR2OracleSpec.ID, 0, logger.TestLogger(t)).WriteConfig(ctx, cfg))
+	configs, err = orm.ContractConfigs(ctx, jb.ID)
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+	assert.Equal(t, cfg, configs[0])
+
+	require.NoError(t, orm.Transact(ctx, func(tx keyrotation.ORM) error {
+		if err := tx.UpdateJobKeyBundle(ctx, jb.ID, newKeyID); err != nil {
+			return err
+		}
+		return tx.MarkJobSwitched(ctx, rot.ID, jb.ID, cfg.ConfigDigest[:])
+	}))
+	require.Error(t, orm.UpdateJobKeyBundle(ctx, -1, newKeyID))
+
+	jobIDs, err = orm.FindOCR2JobIDsByKeyBundle(ctx, oldKeyID)
+	require.NoError(t, err)
+	assert.Empty(t, jobIDs)
+	jobIDs, err = orm.FindOCR2JobIDsByKeyBundle(ctx, newKeyID)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jb.ID}, jobIDs)
+
+	pending, err = orm.PendingRotations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.True(t, pending[0].Jobs[0].Switched())
+	assert.Equal(t, cfg.ConfigDigest[:], pending[0].Jobs[0].ConfigDigest)
+
+	archived := []byte(`{"keyType":"OCR2"}`)
+	require.NoError(t, orm.ArchiveRotation(ctx, rot.ID, archived))
+	require.Error(t, orm.ArchiveRotation(ctx, rot.ID, archived))
+
+	pending, err = orm.PendingRotations(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	rotations, err := orm.ListRotations(ctx)
+	require.NoError(t, err)
+	require.Len(t, rotations, 1)
+	assert.Equal(t, keyrotation.StateArchived, rotations[0].State)
+	assert.Equal(t, archived, rotations[0].ArchivedKey)
+	assert.True(t, rotations[0].ArchivedAt.Valid)
+	require.Len(t, rotations[0].Jobs, 1)
+}
@@ -0,0 +1,459 @@
+package keyrotation
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	ocr1confighelper "github.com/smartcontractkit/libocr/offchainreporting/confighelper"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/confighelper"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3confighelper"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+)
+
+// checkInterval is the interval between the checks of the contract configs of the jobs of the pending rotations.
+const checkInterval = time.Minute
+
+// Rotator rotates the OCR2 key bundles and the P2P keys of the node with overlapping validity:
+//   - the successor key is created, and the jobs using the old key are recorded;
+//   - the successor key replaces the old one in the chain configs of the feeds managers, which receive its public keys
+//     to build the new contract configs;
+//   - each job switches to the successor key once it observes a contract config listing it;
+//   - the old key is exported to the rotation record, and deleted from the keystore, once all the jobs switched.
+//
+// The OCR2 oracle jobs are restarted with the successor key bundle. The P2P key is shared by all the jobs, and the
+// successor is used after the next restart of the node with P2P.PeerID set to it: the old P2P key is kept until the
+// peer of the node runs with the successor, and the bootstrap jobs, which are not listed in contract configs, switch
+// with the peer.
+type Rotator struct {
+	services.StateMachine
+	orm        ORM
+	keyStore   keystore.Master
+	jobSpawner job.Spawner
+	feeds      feeds.Service
+	peer       Peer
+	password   string
+	lggr       logger.Logger
+
+	// awaitingRestart holds the P2P rotations reported as waiting for the restart of the node.
+	awaitingRestart map[int64]bool
+
+	chStop services.StopChan
+	wgDone sync.WaitGroup
+}
+
+// Peer is the P2P peer of the node.
+type Peer interface {
+	// ActivePeerID returns the peer ID of the P2P key used by the peer, or false if the peer is not started.
+	ActivePeerID() (p2pkey.PeerID, bool)
+}
+
+// NewRotator returns a Rotator. The password of the keystore is used to export the archived keys. The peer is nil if
+// P2P networking is disabled.
+func NewRotator(orm ORM, keyStore keystore.Master, jobSpawner job.Spawner, feedsService feeds.Service, peer Peer, password string, lggr logger.Logger) *Rotator {
+	return &Rotator{
+		orm:             orm,
+		keyStore:        keyStore,
+		jobSpawner:      jobSpawner,
+		feeds:           feedsService,
+		peer:            peer,
+		password:        password,
+		lggr:            lggr.Named("KeyRotator"),
+		awaitingRestart: make(map[int64]bool),
+		chStop:          make(chan struct{}),
+	}
+}
+
+func (r *Rotator) Start(context.Context) error {
+	return r.StartOnce("KeyRotator", func() error {
+		r.wgDone.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+func (r *Rotator) Close() error {
+	return r.StopOnce("KeyRotator", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+		return nil
+	})
+}
+
+func (r *Rotator) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *Rotator) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+// RotateOCR2 starts the rotation of an OCR2 key bundle.
+func (r *Rotator) RotateOCR2(ctx context.Context, keyBundleID string) (Rotation, error) {
+	old, err := r.keyStore.OCR2().Get(keyBundleID)
+	if err != nil {
+		return Rotation{}, err
+	}
+	jobIDs, err := r.orm.FindOCR2JobIDsByKeyBundle(ctx, old.ID())
+	if err != nil {
+		return Rotation{}, err
+	}
+	successor, err := r.keyStore.OCR2().Create(ctx, old.ChainType())
+	if err != nil {
+		return Rotation{}, errors.Wrap(err, "failed to create the successor key bundle")
+	}
+
+	rot := Rotation{KeyType: KeyTypeOCR2, OldKeyID: old.ID(), NewKeyID: successor.ID()}
+	if err = r.orm.CreateRotation(ctx, &rot, jobIDs); err != nil {
+		if derr := r.keyStore.OCR2().Delete(ctx, successor.ID()); derr != nil {
+			r.lggr.Errorw("Failed to delete the successor key bundle", "keyBundleID", successor.ID(), "err", derr)
+		}
+		return Rotation{}, err
+	}
+	r.lggr.Infow("Started OCR2 key bundle rotation", "id", rot.ID, "oldKeyBundleID", rot.OldKeyID, "newKeyBundleID", rot.NewKeyID,
+		"onchainPublicKey", successor.OnChainPublicKey(), "jobIDs", jobIDs)
+	r.publish(ctx, rot)
+	return rot, nil
+}
+
+// RotateP2P starts the rotation of a P2P key.
+func (r *Rotator) RotateP2P(ctx context.Context, peerID p2pkey.PeerID) (Rotation, error) {
+	old, err := r.keyStore.P2P().Get(peerID)
+	if err != nil {
+		return Rotation{}, err
+	}
+	jobIDs, err := r.orm.FindP2PJobIDs(ctx)
+	if err != nil {
+		return Rotation{}, err
+	}
+	successor, err := r.keyStore.P2P().Create(ctx)
+	if err != nil {
+		return Rotation{}, errors.Wrap(err, "failed to create the successor P2P key")
+	}
+
+	rot := Rotation{KeyType: KeyTypeP2P, OldKeyID: old.ID(), NewKeyID: successor.ID()}
+	if err = r.orm.CreateRotation(ctx, &rot, jobIDs); err != nil {
+		if _, derr := r.keyStore.P2P().Delete(ctx, successor.PeerID()); derr != nil {
+			r.lggr.Errorw("Failed to delete the successor P2P key", "peerID", successor.ID(), "err", derr)
+		}
+		return Rotation{}, err
+	}
+	r.lggr.Infow("Started P2P key rotation: set P2P.PeerID to the successor P2P key before the next restart of the node",
+		"id", rot.ID, "oldPeerID", rot.OldKeyID, "newPeerID", rot.NewKeyID, "jobIDs", jobIDs)
+	r.publish(ctx, rot)
+	return rot, nil
+}
+
+// Rotations lists all the rotations, the most recent first.
+func (r *Rotator) Rotations(ctx context.Context) ([]Rotation, error) {
+	return r.orm.ListRotations(ctx)
+}
+
+// publish replaces the old key by the successor in the chain configs of the feeds managers, which sends the public keys
+// of the successor to the feeds managers.
+func (r *Rotator) publish(ctx context.Context, rot Rotation) {
+	mgrs, err := r.feeds.ListManagers(ctx)
+	if err != nil {
+		r.lggr.Errorw("Failed to list the feeds managers", "err", err)
+		return
+	}
+	if len(mgrs) == 0 {
+		return
+	}
+	mgrIDs := make([]int64, 0, len(mgrs))
+	for _, mgr := range mgrs {
+		mgrIDs = append(mgrIDs, mgr.ID)
+	}
+	cfgs, err := r.feeds.ListChainConfigsByManagerIDs(ctx, mgrIDs)
+	if err != nil {
+		r.lggr.Errorw("Failed to list the chain configs of the feeds managers", "err", err)
+		return
+	}
+
+	for _, cfg := range cfgs {
+		var updated bool
+		switch rot.KeyType {
+		case KeyTypeOCR2:
+			if cfg.OCR2Config.KeyBundleID.String == rot.OldKeyID {
+				cfg.OCR2Config.KeyBundleID = null.StringFrom(rot.NewKeyID)
+				updated = true
+			}
+		case KeyTypeP2P:
+			if samePeerID(cfg.OCR1Config.P2PPeerID, rot.OldKeyID) {
+				cfg.OCR1Config.P2PPeerID = null.StringFrom(rot.NewKeyID)
+				updated = true
+			}
+			if samePeerID(cfg.OCR2Config.P2PPeerID, rot.OldKeyID) {
+				cfg.OCR2Config.P2PPeerID = null.StringFrom(rot.NewKeyID)
+				updated = true
+			}
+		}
+		if !updated {
+			continue
+		}
+		if _, err = r.feeds.UpdateChainConfig(ctx, cfg); err != nil {
+			r.lggr.Errorw("Failed to update the chain config of the feeds manager", "feedsManagerID", cfg.FeedsManagerID, "chainConfigID", cfg.ID, "err", err)
+		}
+	}
+}
+
+func samePeerID(s null.String, peerID string) bool {
+	if !s.Valid {
+		return false
+	}
+	id, err := p2pkey.MakePeerID(s.String)
+	return err == nil && id.Raw() == peerID
+}
+
+func (r *Rotator) run() {
+	defer r.wgDone.Done()
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+
+	ticker := services.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.checkRotations(ctx)
+		case <-r.chStop:
+			return
+		}
+	}
+}
+
+func (r *Rotator) checkRotations(ctx context.Context) {
+	rotations, err := r.orm.PendingRotations(ctx)
+	if err != nil {
+		r.lggr.Errorw("Failed to load the pending rotations", "err", err)
+		return
+	}
+	for _, rot := range rotations {
+		if err = r.checkRotation(ctx, rot); err != nil && ctx.Err() == nil {
+			r.lggr.Errorw("Failed to check the rotation", "id", rot.ID, "keyType", rot.KeyType, "err", err)
+		}
+	}
+}
+
+// checkRotation switches the jobs which observed a contract config with the successor key, and archives the old key
+// once all the jobs switched.
+func (r *Rotator) checkRotation(ctx context.Context, rot Rotation) error {
+	lists, err := r.successorMatcher(rot)
+	if err != nil {
+		return err
+	}
+	// The P2P key is used by the peer of the node until it restarts with the successor
+	onSuccessor := true
+	bootstrapJobIDs := map[int32]bool{}
+	if rot.KeyType == KeyTypeP2P {
+		onSuccessor = r.peerUsesSuccessor(rot)
+		ids, err := r.orm.FindBootstrapJobIDs(ctx)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			bootstrapJobIDs[id] = true
+		}
+	}
+
+	pending := 0
+	for _, j := range rot.Jobs {
+		if j.Switched() {
+			continue
+		}
+		if bootstrapJobIDs[j.JobID] {
+			if onSuccessor {
+				if err = r.orm.MarkJobSwitched(ctx, rot.ID, j.JobID, nil); err != nil {
+					return err
+				}
+				r.lggr.Infow("Switched bootstrap job to the successor P2P key", "id", rot.ID, "jobID", j.JobID)
+			}
+			continue
+		}
+		digest, found, err := r.findSuccessorConfig(ctx, rot, j.JobID, lists)
+		if err != nil {
+			return err
+		}
+		if !found {
+			pending++
+			continue
+		}
+		if err = r.switchJob(ctx, rot, j.JobID, digest); err != nil {
+			return err
+		}
+	}
+	if pending > 0 {
+		return nil
+	}
+	if !onSuccessor {
+		if !r.awaitingRestart[rot.ID] {
+			r.awaitingRestart[rot.ID] = true
+			r.lggr.Criticalw("All the jobs observed the successor P2P key: set P2P.PeerID to it and restart the node to complete the rotation",
+				"id", rot.ID, "oldPeerID", rot.OldKeyID, "newPeerID", rot.NewKeyID)
+		}
+		return nil
+	}
+	return r.archive(ctx, rot)
+}
+
+// peerUsesSuccessor returns true if the peer of the node runs with the successor P2P key, or if P2P networking is
+// disabled.
+func (r *Rotator) peerUsesSuccessor(rot Rotation) bool {
+	if r.peer == nil {
+		return true
+	}
+	peerID, started := r.peer.ActivePeerID()
+	return started && peerID.Raw() == rot.NewKeyID
+}
+
+// findSuccessorConfig returns the digest of the first contract config observed by the job with an oracle using the
+// successor key. OCR oracle jobs are only part of P2P rotations.
+func (r *Rotator) findSuccessorConfig(ctx context.Context, rot Rotation, jobID int32, lists func(confighelper.OracleIdentity) bool) ([]byte, bool, error) {
+	configs, err := r.orm.ContractConfigs(ctx, jobID)
+	if err != nil {
+		return nil, false, err
+	}
+	if digest, found := findConfig(configs, lists); found {
+		return digest[:], true, nil
+	}
+	if rot.KeyType != KeyTypeP2P {
+		return nil, false, nil
+	}
+	ocr1Configs, err := r.orm.OCR1ContractConfigs(ctx, jobID)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, c := range ocr1Configs {
+		pc, err := ocr1confighelper.PublicConfigFromContractConfig(nil, true, c)
+		if err != nil {
+			continue
+		}
+		for _, id := range pc.OracleIdentities {
+			if id.PeerID == rot.NewKeyID {
+				return c.ConfigDigest[:], true, nil
+			}
+		}
+	}
+	return nil, false, nil
+}
+
+// successorMatcher returns a function reporting if an oracle identity uses the successor key of the rotation.
+func (r *Rotator) successorMatcher(rot Rotation) (func(confighelper.OracleIdentity) bool, error) {
+	switch rot.KeyType {
+	case KeyTypeOCR2:
+		successor, err := r.keyStore.OCR2().Get(rot.NewKeyID)
+		if err != nil {
+			return nil, err
+		}
+		offchainPublicKey := successor.OffchainPublicKey()
+		return func(id confighelper.OracleIdentity) bool { return id.OffchainPublicKey == offchainPublicKey }, nil
+	case KeyTypeP2P:
+		return func(id confighelper.OracleIdentity) bool { return id.PeerID == rot.NewKeyID }, nil
+	}
+	return nil, errors.Errorf("unknown key type %q", rot.KeyType)
+}
+
+// findConfig returns the digest of the first contract config with an oracle using the successor key.
+func findConfig(configs []ocrtypes.ContractConfig, lists func(confighelper.OracleIdentity) bool) (ocrtypes.ConfigDigest, bool) {
+	for _, c := range configs {
+		for _, id := range oracleIdentities(c) {
+			if lists(id) {
+				return c.ConfigDigest, true
+			}
+		}
+	}
+	return ocrtypes.ConfigDigest{}, false
+}
+
+// oracleIdentities decodes the oracles of an OCR2 or OCR3 contract config.
+func oracleIdentities(c ocrtypes.ContractConfig) []confighelper.OracleIdentity {
+	if pc, err := confighelper.PublicConfigFromContractConfig(true, c); err == nil {
+		return pc.OracleIdentities
+	}
+	if pc, err := ocr3confighelper.PublicConfigFromContractConfig(true, c); err == nil {
+		return pc.OracleIdentities
+	}
+	return nil
+}
+
+func (r *Rotator) switchJob(ctx context.Context, rot Rotation, jobID int32, digest []byte) error {
+	if rot.KeyType == KeyTypeP2P {
+		r.lggr.Infow("Observed contract config with the successor P2P key", "id", rot.ID, "jobID", jobID, "configDigest", hex.EncodeToString(digest))
+		return r.orm.MarkJobSwitched(ctx, rot.ID, jobID, digest)
+	}
+
+	err := r.orm.Transact(ctx, func(tx ORM) error {
+		if err := tx.UpdateJobKeyBundle(ctx, jobID, rot.NewKeyID); err != nil {
+			return err
+		}
+		return tx.MarkJobSwitched(ctx, rot.ID, jobID, digest)
+	})
+	if err != nil {
+		return err
+	}
+	r.lggr.Infow("Switched job to the successor key bundle", "id", rot.ID, "jobID", jobID, "configDigest", hex.EncodeToString(digest), "keyBundleID", rot.NewKeyID)
+	if err = r.jobSpawner.RestartJob(ctx, jobID); err != nil {
+		// The job uses the successor key bundle once its services start
+		r.lggr.Errorw("Failed to restart the job with the successor key bundle", "id", rot.ID, "jobID", jobID, "err", err)
+	}
+	return nil
+}
+
+// archive exports the old key to the rotation record, and deletes it from the keystore.
+func (r *Rotator) archive(ctx context.Context, rot Rotation) error {
+	var exported []byte
+	switch rot.KeyType {
+	case KeyTypeOCR2:
+		// Jobs created with the old key bundle during the rotation keep it in use
+		jobIDs, err := r.orm.FindOCR2JobIDsByKeyBundle(ctx, rot.OldKeyID)
+		if err != nil {
+			return err
+		}
+		if len(jobIDs) > 0 {
+			r.lggr.Warnw("Not archiving the old key bundle, which is still used by jobs", "id", rot.ID, "keyBundleID", rot.OldKeyID, "jobIDs", jobIDs)
+			return nil
+		}
+		if exported, err = r.keyStore.OCR2().Export(rot.OldKeyID, r.password); err != nil {
+			return errors.Wrap(err, "failed to export the old key bundle")
+		}
+	case KeyTypeP2P:
+		peerID, err := p2pkey.MakePeerID(rot.OldKeyID)
+		if err != nil {
+			return err
+		}
+		if exported, err = r.keyStore.P2P().Export(peerID, r.password); err != nil {
+			return errors.Wrap(err, "failed to export the old P2P key")
+		}
+	}
+
+	if err := r.orm.ArchiveRotation(ctx, rot.ID, exported); err != nil {
+		return err
+	}
+
+	switch rot.KeyType {
+	case KeyTypeOCR2:
+		if err := r.keyStore.OCR2().Delete(ctx, rot.OldKeyID); err != nil {
+			return errors.Wrap(err, "failed to delete the archived key bundle")
+		}
+		r.lggr.Infow("Archived the old key bundle", "id", rot.ID, "keyBundleID", rot.OldKeyID)
+	case KeyTypeP2P:
+		peerID, _ := p2pkey.MakePeerID(rot.OldKeyID)
+		if _, err := r.keyStore.P2P().Delete(ctx, peerID); err != nil {
+			return errors.Wrap(err, "failed to delete the archived P2P key")
+		}
+		delete(r.awaitingRestart, rot.ID)
+		r.lggr.Infow("Archived the old P2P key", "id", rot.ID, "oldPeerID", rot.OldKeyID, "newPeerID", rot.NewKeyID)
+	}
+	return nil
+}
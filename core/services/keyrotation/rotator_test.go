@@ -0,0 +1,349 @@
+package keyrotation_test
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	mrand "math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	ocr1confighelper "github.com/smartcontractkit/libocr/offchainreporting/confighelper"
+	ocr1types "github.com/smartcontractkit/libocr/offchainreporting/types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/confighelper"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	feedsMocks "github.com/smartcontractkit/chainlink/v2/core/services/feeds/mocks"
+	jobMocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const password = "p4SsW0rD1!@#_"
+
+type testRotator struct {
+	*keyrotation.Rotator
+	orm     *mocks.ORM
+	ocr2    *ksmocks.OCR2
+	p2p     *ksmocks.P2P
+	spawner *jobMocks.Spawner
+	feeds   *feedsMocks.Service
+	peer    *fakePeer
+}
+
+// fakePeer is a started peer running with the P2P key of peerID.
+type fakePeer struct {
+	peerID p2pkey.PeerID
+}
+
+func (p *fakePeer) ActivePeerID() (p2pkey.PeerID, bool) {
+	return p.peerID, true
+}
+
+func setupRotator(t *testing.T) testRotator {
+	orm := mocks.NewORM(t)
+	ocr2 := ksmocks.NewOCR2(t)
+	p2p := ksmocks.NewP2P(t)
+	keyStore := ksmocks.NewMaster(t)
+	keyStore.On("OCR2").Return(ocr2).Maybe()
+	keyStore.On("P2P").Return(p2p).Maybe()
+	spawner := jobMocks.NewSpawner(t)
+	feedsService := feedsMocks.NewService(t)
+	peer := &fakePeer{}
+
+	return testRotator{
+		Rotator: keyrotation.NewRotator(orm, keyStore, spawner, feedsService, peer, password, logger.TestLogger(t)),
+		orm:     orm,
+		ocr2:    ocr2,
+		p2p:     p2p,
+		spawner: spawner,
+		feeds:   feedsService,
+		peer:    peer,
+	}
+}
+
+// contractConfig returns a contract config of four oracles, the first one using the given key bundle and peer ID.
+func contractConfig(t *testing.T, bundle ocr2key.KeyBundle, peerID string) ocrtypes.ContractConfig {
+	var oracles []confighelper.OracleIdentityExtra
+	for i := 0; i < 4; i++ {
+		oracles = append(oracles, confighelper.OracleIdentityExtra{
+			OracleIdentity: confighelper.OracleIdentity{
+				OnchainPublicKey:  evmutils.RandomAddress().Bytes(),
+				TransmitAccount:   ocrtypes.Account(evmutils.RandomAddress().Hex()),
+				OffchainPublicKey: evmutils.RandomBytes32(),
+				PeerID:            utils.MustNewPeerID(),
+			},
+			ConfigEncryptionPublicKey: evmutils.RandomBytes32(),
+		})
+	}
+	oracles[0].OnchainPublicKey = bundle.PublicKey()
+	oracles[0].OffchainPublicKey = bundle.OffchainPublicKey()
+	oracles[0].ConfigEncryptionPublicKey = bundle.ConfigEncryptionPublicKey()
+	oracles[0].PeerID = peerID
+
+	signers, transmitters, f, onchainConfig, offchainConfigVersion, offchainConfig, err := confighelper.ContractSetConfigArgsForTests(
+		2*time.Second,        // deltaProgress
+		1*time.Second,        // deltaResend
+		1*time.Second,        // deltaRound
+		500*time.Millisecond, // deltaGrace
+		2*time.Second,        // deltaStage
+		3,
+		[]int{1, 1, 1, 1},
+		oracles,
+		nil,
+		nil,
+		50*time.Millisecond,
+		50*time.Millisecond,
+		50*time.Millisecond,
+		50*time.Millisecond,
+		50*time.Millisecond,
+		1, // faults
+		nil,
+	)
+	require.NoError(t, err)
+	return ocrtypes.ContractConfig{
+		ConfigDigest:          evmutils.RandomBytes32(),
+		ConfigCount:           1,
+		Signers:               signers,
+		Transmitters:          transmitters,
+		F:                     f,
+		OnchainConfig:         onchainConfig,
+		OffchainConfigVersion: offchainConfigVersion,
+		OffchainConfig:        offchainConfig,
+	}
+}
+
+// ocr1ContractConfig returns an OCR contract config of four oracles, the first one using the given peer ID.
+func ocr1ContractConfig(t *testing.T, peerID string) ocr1types.ContractConfig {
+	var oracles []ocr1confighelper.OracleIdentityExtra
+	for i := 0; i < 4; i++ {
+		offchainPublicKey := evmutils.RandomBytes32()
+		oracles = append(oracles, ocr1confighelper.OracleIdentityExtra{
+			OracleIdentity: ocr1confighelper.OracleIdentity{
+				OnChainSigningAddress: ocr1types.OnChainSigningAddress(evmutils.RandomAddress()),
+				TransmitAddress:       evmutils.RandomAddress(),
+				OffchainPublicKey:     offchainPublicKey[:],
+				PeerID:                utils.MustNewPeerID(),
+			},
+			SharedSecretEncryptionPublicKey: evmutils.RandomBytes32(),
+		})
+	}
+	oracles[0].PeerID = peerID
+
+	signers, transmitters, threshold, encodedConfigVersion, encodedConfig, err := ocr1confighelper.ContractSetConfigArgsForIntegrationTest(oracles, 1, 1000000000/100)
+	require.NoError(t, err)
+	var digest ocr1types.ConfigDigest
+	_, err = rand.Read(digest[:])
+	require.NoError(t, err)
+	return ocr1types.ContractConfig{
+		ConfigDigest:         digest,
+		Signers:              signers,
+		Transmitters:         transmitters,
+		Threshold:            threshold,
+		EncodedConfigVersion: encodedConfigVersion,
+		Encoded:              encodedConfig,
+	}
+}
+
+func transact(orm *mocks.ORM) {
+	orm.On("Transact", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(keyrotation.ORM) error) error {
+		return fn(orm)
+	})
+}
+
+func TestRotator_RotateOCR2(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	r := setupRotator(t)
+	old := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	successor := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	cfg := feeds.ChainConfig{
+		ID:             11,
+		FeedsManagerID: 1,
+		OCR2Config:     feeds.OCR2ConfigModel{Enabled: true, KeyBundleID: null.StringFrom(old.ID())},
+	}
+	other := feeds.ChainConfig{
+		ID:             12,
+		FeedsManagerID: 1,
+		OCR2Config:     feeds.OCR2ConfigModel{Enabled: true, KeyBundleID: null.StringFrom("other")},
+	}
+
+	r.ocr2.On("Get", old.ID()).Return(old, nil)
+	r.orm.On("FindOCR2JobIDsByKeyBundle", mock.Anything, old.ID()).Return([]int32{1, 2}, nil)
+	r.ocr2.On("Create", mock.Anything, chaintype.EVM).Return(successor, nil)
+	r.orm.On("CreateRotation", mock.Anything, mock.Anything, []int32{1, 2}).Run(func(args mock.Arguments) {
+		args.Get(1).(*keyrotation.Rotation).ID = 7
+	}).Return(nil)
+	r.feeds.On("ListManagers", mock.Anything).Return([]feeds.FeedsManager{{ID: 1}}, nil)
+	r.feeds.On("ListChainConfigsByManagerIDs", mock.Anything, []int64{1}).Return([]feeds.ChainConfig{cfg, other}, nil)
+	updated := cfg
+	updated.OCR2Config.KeyBundleID = null.StringFrom(successor.ID())
+	r.feeds.On("UpdateChainConfig", mock.Anything, updated).Return(cfg.ID, nil)
+
+	rot, err := r.RotateOCR2(ctx, old.ID())
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), rot.ID)
+	assert.Equal(t, keyrotation.KeyTypeOCR2, rot.KeyType)
+	assert.Equal(t, old.ID(), rot.OldKeyID)
+	assert.Equal(t, successor.ID(), rot.NewKeyID)
+}
+
+func TestRotator_RotateOCR2_DeletesSuccessorOnError(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	r := setupRotator(t)
+	old := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	successor := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+
+	r.ocr2.On("Get", old.ID()).Return(old, nil)
+	r.orm.On("FindOCR2JobIDsByKeyBundle", mock.Anything, old.ID()).Return([]int32{}, nil)
+	r.ocr2.On("Create", mock.Anything, chaintype.EVM).Return(successor, nil)
+	r.orm.On("CreateRotation", mock.Anything, mock.Anything, []int32{}).Return(assert.AnError)
+	r.ocr2.On("Delete", mock.Anything, successor.ID()).Return(nil)
+
+	_, err := r.RotateOCR2(ctx, old.ID())
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestRotator_CheckRotations_OCR2(t *testing.T) {
+	t.Parallel()
+
+	old := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	successor := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+
+	t.Run("switches the jobs observing the successor key bundle", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		r := setupRotator(t)
+		rot := keyrotation.Rotation{
+			ID: 7, KeyType: keyrotation.KeyTypeOCR2, OldKeyID: old.ID(), NewKeyID: successor.ID(), State: keyrotation.StatePending,
+			Jobs: []keyrotation.Job{{RotationID: 7, JobID: 1}, {RotationID: 7, JobID: 2}},
+		}
+		observed := contractConfig(t, successor, "")
+
+		r.orm.On("PendingRotations", mock.Anything).Return([]keyrotation.Rotation{rot}, nil)
+		r.ocr2.On("Get", successor.ID()).Return(successor, nil)
+		r.orm.On("ContractConfigs", mock.Anything, int32(1)).Return([]ocrtypes.ContractConfig{contractConfig(t, old, ""), observed}, nil)
+		r.orm.On("ContractConfigs", mock.Anything, int32(2)).Return([]ocrtypes.ContractConfig{contractConfig(t, old, "")}, nil)
+		transact(r.orm)
+		r.orm.On("UpdateJobKeyBundle", mock.Anything, int32(1), successor.ID()).Return(nil).Once()
+		r.orm.On("MarkJobSwitched", mock.Anything, int64(7), int32(1), observed.ConfigDigest[:]).Return(nil).Once()
+		r.spawner.On("RestartJob", mock.Anything, int32(1)).Return(nil).Once()
+
+		r.CheckRotations(ctx)
+	})
+
+	t.Run("archives the old key bundle once all the jobs switched", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		r := setupRotator(t)
+		rot := keyrotation.Rotation{
+			ID: 7, KeyType: keyrotation.KeyTypeOCR2, OldKeyID: old.ID(), NewKeyID: successor.ID(), State: keyrotation.StatePending,
+			Jobs: []keyrotation.Job{{RotationID: 7, JobID: 1, SwitchedAt: null.TimeFrom(time.Now())}},
+		}
+		exported := []byte(`{"id":"old"}`)
+
+		r.orm.On("PendingRotations", mock.Anything).Return([]keyrotation.Rotation{rot}, nil)
+		r.ocr2.On("Get", successor.ID()).Return(successor, nil)
+		r.orm.On("FindOCR2JobIDsByKeyBundle", mock.Anything, old.ID()).Return([]int32{}, nil)
+		r.ocr2.On("Export", old.ID(), password).Return(exported, nil)
+		r.orm.On("ArchiveRotation", mock.Anything, int64(7), exported).Return(nil).Once()
+		r.ocr2.On("Delete", mock.Anything, old.ID()).Return(nil).Once()
+
+		r.CheckRotations(ctx)
+	})
+
+	t.Run("keeps the old key bundle used by other jobs", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		r := setupRotator(t)
+		rot := keyrotation.Rotation{
+			ID: 7, KeyType: keyrotation.KeyTypeOCR2, OldKeyID: old.ID(), NewKeyID: successor.ID(), State: keyrotation.StatePending,
+		}
+
+		r.orm.On("PendingRotations", mock.Anything).Return([]keyrotation.Rotation{rot}, nil)
+		r.ocr2.On("Get", successor.ID()).Return(successor, nil)
+		r.orm.On("FindOCR2JobIDsByKeyBundle", mock.Anything, old.ID()).Return([]int32{3}, nil)
+
+		r.CheckRotations(ctx)
+	})
+}
+
+func TestRotator_CheckRotations_P2P(t *testing.T) {
+	t.Parallel()
+
+	old := p2pkey.MustNewV2XXXTestingOnly(big.NewInt(mrand.Int63()))
+	successor := p2pkey.MustNewV2XXXTestingOnly(big.NewInt(mrand.Int63()))
+	bundle := ocr2key.MustNewInsecure(rand.Reader, chaintype.EVM)
+	observed := contractConfig(t, bundle, successor.PeerID().Raw())
+	ocr1Observed := ocr1ContractConfig(t, successor.PeerID().Raw())
+	exported := []byte(`{"id":"old"}`)
+	newRotation := func(jobs ...keyrotation.Job) keyrotation.Rotation {
+		return keyrotation.Rotation{
+			ID: 8, KeyType: keyrotation.KeyTypeP2P, OldKeyID: old.ID(), NewKeyID: successor.ID(), State: keyrotation.StatePending,
+			Jobs: jobs,
+		}
+	}
+
+	t.Run("switches the OCR and OCR2 jobs observing the successor P2P key", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		r := setupRotator(t)
+		r.peer.peerID = old.PeerID()
+		rot := newRotation(keyrotation.Job{RotationID: 8, JobID: 1}, keyrotation.Job{RotationID: 8, JobID: 2}, keyrotation.Job{RotationID: 8, JobID: 3})
+
+		r.orm.On("PendingRotations", mock.Anything).Return([]keyrotation.Rotation{rot}, nil)
+		r.orm.On("FindBootstrapJobIDs", mock.Anything).Return([]int32{3}, nil)
+		r.orm.On("ContractConfigs", mock.Anything, int32(1)).Return([]ocrtypes.ContractConfig{observed}, nil)
+		r.orm.On("MarkJobSwitched", mock.Anything, int64(8), int32(1), observed.ConfigDigest[:]).Return(nil).Once()
+		r.orm.On("ContractConfigs", mock.Anything, int32(2)).Return([]ocrtypes.ContractConfig{}, nil)
+		r.orm.On("OCR1ContractConfigs", mock.Anything, int32(2)).Return([]ocr1types.ContractConfig{ocr1Observed}, nil)
+		r.orm.On("MarkJobSwitched", mock.Anything, int64(8), int32(2), ocr1Observed.ConfigDigest[:]).Return(nil).Once()
+
+		// The bootstrap job switches, and the old P2P key is archived, once the peer runs with the successor
+		r.CheckRotations(ctx)
+	})
+
+	t.Run("keeps the old P2P key until the peer runs with the successor", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		r := setupRotator(t)
+		r.peer.peerID = old.PeerID()
+		switched := keyrotation.Job{RotationID: 8, JobID: 1, SwitchedAt: null.TimeFrom(time.Now())}
+		rot := newRotation(switched, keyrotation.Job{RotationID: 8, JobID: 3})
+
+		r.orm.On("PendingRotations", mock.Anything).Return([]keyrotation.Rotation{rot}, nil)
+		r.orm.On("FindBootstrapJobIDs", mock.Anything).Return([]int32{3}, nil)
+
+		r.CheckRotations(ctx)
+		r.p2p.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("archives the old P2P key once the peer runs with the successor", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		r := setupRotator(t)
+		r.peer.peerID = successor.PeerID()
+		switched := keyrotation.Job{RotationID: 8, JobID: 1, SwitchedAt: null.TimeFrom(time.Now())}
+		rot := newRotation(switched, keyrotation.Job{RotationID: 8, JobID: 3})
+
+		r.orm.On("PendingRotations", mock.Anything).Return([]keyrotation.Rotation{rot}, nil)
+		r.orm.On("FindBootstrapJobIDs", mock.Anything).Return([]int32{3}, nil)
+		r.orm.On("MarkJobSwitched", mock.Anything, int64(8), int32(3), []byte(nil)).Return(nil).Once()
+		r.p2p.On("Export", old.PeerID(), password).Return(exported, nil)
+		r.orm.On("ArchiveRotation", mock.Anything, int64(8), exported).Return(nil).Once()
+		r.p2p.On("Delete", mock.Anything, old.PeerID()).Return(old, nil).Once()
+
+		r.CheckRotations(ctx)
+	})
+}
@@ -32,6 +32,7 @@ type Eth interface {
 	Enable(ctx context.Context, address common.Address, chainID *big.Int) error
 	Disable(ctx context.Context, address common.Address, chainID *big.Int) error
 	Add(ctx context.Context, address common.Address, chainID *big.Int) error
+	AddRemoteSigner(ctx context.Context, address common.Address, signer RemoteSigner, chainIDs ...*big.Int) error
 
 	EnsureKeys(ctx context.Context, chainIDs ...*big.Int) error
 	SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, unsub func())
@@ -59,6 +60,7 @@ type eth struct {
 	ds            sqlutil.DataSource
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
+	remoteSigners map[common.Address]RemoteSigner
 }
 
 var _ Eth = &eth{}
@@ -70,6 +72,7 @@ func newEthKeyStore(km *keyManager, orm keystateORM, ds sqlutil.DataSource) *eth
 		ds:            ds,
 		subscribers:   make([](chan struct{}), 0),
 		subscribersMu: new(sync.RWMutex),
+		remoteSigners: make(map[common.Address]RemoteSigner),
 	}
 }
 
@@ -79,6 +82,9 @@ func (ks *eth) Get(ctx context.Context, id string) (ethkey.KeyV2, error) {
 	if ks.isLocked() {
 		return ethkey.KeyV2{}, ErrLocked
 	}
+	if key, found := ks.getRemote(id); found {
+		return key, nil
+	}
 	return ks.getByID(id)
 }
 
@@ -96,6 +102,9 @@ func (ks *eth) getAll(ctx context.Context) (keys []ethkey.KeyV2) {
 	for _, key := range ks.keyRing.Eth {
 		keys = append(keys, key)
 	}
+	for address := range ks.remoteSigners {
+		keys = append(keys, ethkey.FromAddress(address))
+	}
 	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
 	return
 }
@@ -187,13 +196,36 @@ func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte,
 func (ks *eth) Add(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.hasKey(address) {
 		return ErrKeyNotFound
 	}
 	return ks.addKey(ctx, nil, address, chainID)
 }
 
+// AddRemoteSigner registers the signer of an account whose private key is held outside of the keystore, and adds the
+// account to the given chains. The account is not re-enabled on the chains it was disabled for.
+func (ks *eth) AddRemoteSigner(ctx context.Context, address common.Address, signer RemoteSigner, chainIDs ...*big.Int) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if _, found := ks.keyRing.Eth[address.Hex()]; found {
+		return errors.Errorf("eth key with address %s is already held by the keystore", address.Hex())
+	}
+	ks.remoteSigners[address] = signer
+	for _, chainID := range chainIDs {
+		if _, exists := ks.keyStates.KeyIDChainID[address.Hex()][chainID.String()]; exists {
+			continue
+		}
+		if err := ks.addKey(ctx, nil, address, chainID); err != nil {
+			return err
+		}
+	}
+	ks.notify()
+	return nil
+}
+
 // caller must hold lock!
 // ds is optional, for transactions
 func (ks *eth) addKey(ctx context.Context, ds sqlutil.DataSource, address common.Address, chainID *big.Int) error {
@@ -217,8 +249,7 @@ func (ks *eth) addKey(ctx context.Context, ds sqlutil.DataSource, address common
 func (ks *eth) Enable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.hasKey(address) {
 		return ErrKeyNotFound
 	}
 	return ks.enable(ctx, address, chainID)
@@ -246,8 +277,7 @@ func (ks *eth) enable(ctx context.Context, address common.Address, chainID *big.
 func (ks *eth) Disable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if !ks.hasKey(address) {
 		return errors.Errorf("no key exists with ID %s", address.Hex())
 	}
 	return ks.disable(ctx, address, chainID)
@@ -311,15 +341,13 @@ func (ks *eth) SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, uns
 }
 
 func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.String())
+	key, remote, err := ks.getSigner(address)
 	if err != nil {
 		return nil, err
 	}
+	if remote != nil {
+		return remote.SignTx(ctx, address, tx, chainID)
+	}
 	signer := types.LatestSignerForChainID(chainID)
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
@@ -395,14 +423,7 @@ func (ks *eth) CheckEnabled(ctx context.Context, address common.Address, chainID
 	if ks.isLocked() {
 		return ErrLocked
 	}
-	var found bool
-	for _, k := range ks.keyRing.Eth {
-		if k.Address == address {
-			found = true
-			break
-		}
-	}
-	if !found {
+	if !ks.hasKey(address) {
 		return errors.Errorf("no eth key exists with address %s", address.String())
 	}
 	states := ks.keyStates.KeyIDChainID[address.String()]
@@ -538,15 +559,13 @@ func (ks *eth) XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2) {
 // SignMessage signs the provided message using the private key associated with the given address,
 // following the EIP-191 specific identifier (e.g., keccak256("\x19Ethereum Signed Message:\n"${message length}${message}))
 func (ks *eth) SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.Hex())
+	key, remote, err := ks.getSigner(address)
 	if err != nil {
 		return nil, err
 	}
+	if remote != nil {
+		return remote.SignMessage(ctx, address, data)
+	}
 	signature, err := crypto.Sign(accounts.TextHash(data), key.ToEcdsaPrivKey())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to sign data")
@@ -563,6 +582,42 @@ func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
 	return key, nil
 }
 
+// getSigner returns the local key, or the remote signer of the given address. The remote signer is called without
+// holding the lock, so that slow signing requests do not block the keystore.
+func (ks *eth) getSigner(address common.Address) (ethkey.KeyV2, RemoteSigner, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, nil, ErrLocked
+	}
+	if remote, found := ks.remoteSigners[address]; found {
+		return ethkey.KeyV2{}, remote, nil
+	}
+	key, err := ks.getByID(address.Hex())
+	return key, nil, err
+}
+
+// caller must hold lock!
+func (ks *eth) getRemote(id string) (ethkey.KeyV2, bool) {
+	if !common.IsHexAddress(id) {
+		return ethkey.KeyV2{}, false
+	}
+	address := common.HexToAddress(id)
+	if _, found := ks.remoteSigners[address]; !found || address.Hex() != id {
+		return ethkey.KeyV2{}, false
+	}
+	return ethkey.FromAddress(address), true
+}
+
+// caller must hold lock!
+func (ks *eth) hasKey(address common.Address) bool {
+	if _, found := ks.keyRing.Eth[address.Hex()]; found {
+		return true
+	}
+	_, found := ks.remoteSigners[address]
+	return found
+}
+
 // caller must hold lock!
 func (ks *eth) enabledKeysForChain(chainID *big.Int) (keys []ethkey.KeyV2) {
 	return ks.keysForChain(chainID, false)
@@ -576,8 +631,11 @@ func (ks *eth) keysForChain(chainID *big.Int, includeDisabled bool) (keys []ethk
 	}
 	for keyID, state := range states {
 		if includeDisabled || !state.Disabled {
-			k := ks.keyRing.Eth[keyID]
-			keys = append(keys, k)
+			if k, found := ks.keyRing.Eth[keyID]; found {
+				keys = append(keys, k)
+			} else if k, found = ks.getRemote(keyID); found {
+				keys = append(keys, k)
+			}
 		}
 	}
 	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
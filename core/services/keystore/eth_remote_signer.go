@@ -0,0 +1,182 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// RemoteSigner signs with the private key of an EVM sending key held outside of the node keystore, e.g. by a KMS or a
+// web3signer instance.
+type RemoteSigner interface {
+	SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignMessage returns the EIP-191 signature of the message, in the [R || S || V] format where V is 0 or 1.
+	SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error)
+}
+
+// maxRemoteSignerResponseSize bounds the size of the responses read from a remote signer.
+const maxRemoteSignerResponseSize = 1 << 20
+
+type web3Signer struct {
+	url    string
+	client *http.Client
+	nextID atomic.Int64
+}
+
+var _ RemoteSigner = &web3Signer{}
+
+// NewWeb3Signer returns a RemoteSigner using the eth_signTransaction and eth_sign JSON-RPC methods of web3signer, or of
+// any signer implementing the same methods.
+func NewWeb3Signer(u *url.URL, timeout time.Duration) RemoteSigner {
+	return &web3Signer{
+		url:    u.String(),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+type web3SignerTx struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Value                *hexutil.Big      `json:"value"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	MaxFeePerBlobGas     *hexutil.Big      `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes  []common.Hash     `json:"blobVersionedHashes,omitempty"`
+}
+
+func (s *web3Signer) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := web3SignerTx{
+		From:  address,
+		To:    tx.To(),
+		Gas:   hexutil.Uint64(tx.Gas()),
+		Nonce: hexutil.Uint64(tx.Nonce()),
+		Value: (*hexutil.Big)(tx.Value()),
+		Data:  tx.Data(),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.DynamicFeeTxType, types.BlobTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		if accessList := tx.AccessList(); len(accessList) > 0 {
+			args.AccessList = &accessList
+		}
+		if tx.Type() == types.BlobTxType {
+			// The signature only covers the versioned hashes, the sidecar is not sent to the signer.
+			args.MaxFeePerBlobGas = (*hexutil.Big)(tx.BlobGasFeeCap())
+			args.BlobVersionedHashes = tx.BlobHashes()
+		}
+	default:
+		return nil, errors.Errorf("remote signer does not support transaction type %d", tx.Type())
+	}
+
+	var raw hexutil.Bytes
+	if err := s.call(ctx, &raw, "eth_signTransaction", args); err != nil {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the transaction signed by the remote signer")
+	}
+
+	// The signer must sign the transaction it was given, for the chain it was given, with the key of the given address.
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer returned a different transaction")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature from remote signer")
+	}
+	if sender != address {
+		return nil, errors.Errorf("remote signer signed with address %s, expected %s", sender, address)
+	}
+	if sidecar := tx.BlobTxSidecar(); sidecar != nil {
+		signed = signed.WithBlobTxSidecar(sidecar)
+	}
+	return signed, nil
+}
+
+func (s *web3Signer) SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error) {
+	var signature hexutil.Bytes
+	if err := s.call(ctx, &signature, "eth_sign", address, hexutil.Bytes(message)); err != nil {
+		return nil, err
+	}
+	if len(signature) != 65 {
+		return nil, errors.Errorf("invalid signature length from remote signer: %d", len(signature))
+	}
+	// eth_sign returns the V value offset by 27, as expected by ecrecover
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+	return signature, nil
+}
+
+type web3SignerRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int64  `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type web3SignerResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (s *web3Signer) call(ctx context.Context, result any, method string, params ...any) error {
+	body, err := json.Marshal(web3SignerRequest{JSONRPC: "2.0", ID: s.nextID.Add(1), Method: method, Params: params})
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode %s request", method)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s request", method)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "%s request to remote signer failed", method)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteSignerResponseSize))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s response from remote signer", method)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("%s request to remote signer failed with status %d: %s", method, resp.StatusCode, bytes.TrimSpace(b))
+	}
+
+	var res web3SignerResponse
+	if err = json.Unmarshal(b, &res); err != nil {
+		return errors.Wrapf(err, "failed to decode %s response from remote signer", method)
+	}
+	if res.Error != nil {
+		return errors.Errorf("remote signer %s error %d: %s", method, res.Error.Code, res.Error.Message)
+	}
+	if err = json.Unmarshal(res.Result, result); err != nil {
+		return errors.Wrapf(err, "failed to decode %s result from remote signer", method)
+	}
+	return nil
+}
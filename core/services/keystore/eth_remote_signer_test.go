@@ -0,0 +1,210 @@
+package keystore_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// newWeb3SignerServer emulates the eth_signTransaction and eth_sign methods of web3signer for the given key.
+// tamper is applied to the transaction before signing it.
+func newWeb3SignerServer(t *testing.T, key ethkey.KeyV2, chainID *big.Int, tamper func(*types.DynamicFeeTx)) *url.URL {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int64             `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var result any
+		switch req.Method {
+		case "eth_signTransaction":
+			var args struct {
+				To                   *common.Address  `json:"to"`
+				Gas                  hexutil.Uint64   `json:"gas"`
+				MaxFeePerGas         *hexutil.Big     `json:"maxFeePerGas"`
+				MaxPriorityFeePerGas *hexutil.Big     `json:"maxPriorityFeePerGas"`
+				Nonce                hexutil.Uint64   `json:"nonce"`
+				Value                *hexutil.Big     `json:"value"`
+				Data                 hexutil.Bytes    `json:"data"`
+				AccessList           types.AccessList `json:"accessList"`
+				MaxFeePerBlobGas     *hexutil.Big     `json:"maxFeePerBlobGas"`
+				BlobVersionedHashes  []common.Hash    `json:"blobVersionedHashes"`
+			}
+			require.NoError(t, json.Unmarshal(req.Params[0], &args))
+			var tx types.TxData
+			if len(args.BlobVersionedHashes) > 0 {
+				tx = &types.BlobTx{
+					ChainID:    uint256.MustFromBig(chainID),
+					Nonce:      uint64(args.Nonce),
+					GasTipCap:  uint256.MustFromBig(args.MaxPriorityFeePerGas.ToInt()),
+					GasFeeCap:  uint256.MustFromBig(args.MaxFeePerGas.ToInt()),
+					Gas:        uint64(args.Gas),
+					To:         *args.To,
+					Value:      uint256.MustFromBig(args.Value.ToInt()),
+					Data:       args.Data,
+					AccessList: args.AccessList,
+					BlobFeeCap: uint256.MustFromBig(args.MaxFeePerBlobGas.ToInt()),
+					BlobHashes: args.BlobVersionedHashes,
+				}
+			} else {
+				dynamicFeeTx := &types.DynamicFeeTx{
+					ChainID:    chainID,
+					Nonce:      uint64(args.Nonce),
+					GasTipCap:  args.MaxPriorityFeePerGas.ToInt(),
+					GasFeeCap:  args.MaxFeePerGas.ToInt(),
+					Gas:        uint64(args.Gas),
+					To:         args.To,
+					Value:      args.Value.ToInt(),
+					Data:       args.Data,
+					AccessList: args.AccessList,
+				}
+				if tamper != nil {
+					tamper(dynamicFeeTx)
+				}
+				tx = dynamicFeeTx
+			}
+			signed, err := types.SignNewTx(key.ToEcdsaPrivKey(), types.LatestSignerForChainID(chainID), tx)
+			require.NoError(t, err)
+			raw, err := signed.MarshalBinary()
+			require.NoError(t, err)
+			result = hexutil.Bytes(raw)
+		case "eth_sign":
+			var message hexutil.Bytes
+			require.NoError(t, json.Unmarshal(req.Params[1], &message))
+			signature, err := crypto.Sign(accounts.TextHash(message), key.ToEcdsaPrivKey())
+			require.NoError(t, err)
+			signature[64] += 27
+			result = hexutil.Bytes(signature)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result}))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestWeb3Signer(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	chainID := big.NewInt(1337)
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	to := testutils.NewAddress()
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(2e9),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(53),
+		Data:      []byte{1, 2, 3, 4},
+	})
+
+	t.Run("SignTx", func(t *testing.T) {
+		signer := keystore.NewWeb3Signer(newWeb3SignerServer(t, key, chainID, nil), time.Second)
+		signed, err := signer.SignTx(ctx, key.Address, tx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, types.LatestSignerForChainID(chainID).Hash(tx), types.LatestSignerForChainID(chainID).Hash(signed))
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, key.Address, sender)
+	})
+
+	t.Run("SignTx with access list", func(t *testing.T) {
+		withAccessList := types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      7,
+			GasTipCap:  big.NewInt(1e9),
+			GasFeeCap:  big.NewInt(2e9),
+			Gas:        21000,
+			To:         &to,
+			Value:      big.NewInt(53),
+			AccessList: types.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}},
+		})
+		signer := keystore.NewWeb3Signer(newWeb3SignerServer(t, key, chainID, nil), time.Second)
+		signed, err := signer.SignTx(ctx, key.Address, withAccessList, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, withAccessList.AccessList(), signed.AccessList())
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, key.Address, sender)
+	})
+
+	t.Run("SignTx blob transaction keeps the sidecar", func(t *testing.T) {
+		sidecar := &types.BlobTxSidecar{Blobs: make([]kzg4844.Blob, 1), Commitments: make([]kzg4844.Commitment, 1), Proofs: make([]kzg4844.Proof, 1)}
+		blobTx := types.NewTx(&types.BlobTx{
+			ChainID:    uint256.MustFromBig(chainID),
+			Nonce:      7,
+			GasTipCap:  uint256.NewInt(1e9),
+			GasFeeCap:  uint256.NewInt(2e9),
+			Gas:        21000,
+			To:         to,
+			Value:      uint256.NewInt(53),
+			BlobFeeCap: uint256.NewInt(3e9),
+			BlobHashes: sidecar.BlobHashes(),
+			Sidecar:    sidecar,
+		})
+		signer := keystore.NewWeb3Signer(newWeb3SignerServer(t, key, chainID, nil), time.Second)
+		signed, err := signer.SignTx(ctx, key.Address, blobTx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(types.BlobTxType), signed.Type())
+		assert.Equal(t, blobTx.BlobHashes(), signed.BlobHashes())
+		assert.Equal(t, sidecar, signed.BlobTxSidecar())
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, key.Address, sender)
+	})
+
+	t.Run("SignTx rejects a different transaction", func(t *testing.T) {
+		signer := keystore.NewWeb3Signer(newWeb3SignerServer(t, key, chainID, func(tx *types.DynamicFeeTx) { tx.Nonce++ }), time.Second)
+		_, err := signer.SignTx(ctx, key.Address, tx, chainID)
+		require.EqualError(t, err, "remote signer returned a different transaction")
+	})
+
+	t.Run("SignTx rejects a signature of another key", func(t *testing.T) {
+		other, err := ethkey.NewV2()
+		require.NoError(t, err)
+		signer := keystore.NewWeb3Signer(newWeb3SignerServer(t, key, chainID, nil), time.Second)
+		_, err = signer.SignTx(ctx, other.Address, tx, chainID)
+		require.ErrorContains(t, err, "remote signer signed with address")
+	})
+
+	t.Run("SignMessage", func(t *testing.T) {
+		signer := keystore.NewWeb3Signer(newWeb3SignerServer(t, key, chainID, nil), time.Second)
+		message := []byte("this is a message")
+		signature, err := signer.SignMessage(ctx, key.Address, message)
+		require.NoError(t, err)
+		pubKey, err := crypto.SigToPub(accounts.TextHash(message), signature)
+		require.NoError(t, err)
+		assert.Equal(t, key.Address, crypto.PubkeyToAddress(*pubKey))
+	})
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -876,3 +877,66 @@ func Test_EthKeyStore_Disable(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func Test_EthKeyStore_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+
+	chainID := big.NewInt(evmclient.NullClientChainID)
+	remoteKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	signer := keystore.NewInMemoryRemoteSigner(remoteKey)
+
+	t.Run("rejects the address of a local key", func(t *testing.T) {
+		k, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+		err := ethKeyStore.AddRemoteSigner(ctx, k.Address, signer, chainID)
+		require.ErrorContains(t, err, "is already held by the keystore")
+	})
+
+	require.NoError(t, ethKeyStore.AddRemoteSigner(ctx, remoteKey.Address, signer, chainID))
+	cltest.AssertCount(t, db, "evm.key_states", 2)
+
+	t.Run("lists the remote key", func(t *testing.T) {
+		key, err := ethKeyStore.Get(ctx, remoteKey.Address.Hex())
+		require.NoError(t, err)
+		assert.True(t, key.IsRemote())
+		assert.Equal(t, remoteKey.Address, key.Address)
+
+		keys, err := ethKeyStore.EnabledKeysForChain(ctx, chainID)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		require.NoError(t, ethKeyStore.CheckEnabled(ctx, remoteKey.Address, chainID))
+	})
+
+	t.Run("signs remotely", func(t *testing.T) {
+		tx := cltest.NewLegacyTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+		signed, err := ethKeyStore.SignTx(ctx, remoteKey.Address, tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, remoteKey.Address, sender)
+
+		message := []byte("this is a message")
+		signature, err := ethKeyStore.SignMessage(ctx, remoteKey.Address, message)
+		require.NoError(t, err)
+		pubKey, err := crypto.SigToPub(accounts.TextHash(message), signature)
+		require.NoError(t, err)
+		assert.Equal(t, remoteKey.Address, crypto.PubkeyToAddress(*pubKey))
+	})
+
+	t.Run("does not re-enable a disabled key", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.Disable(ctx, remoteKey.Address, chainID))
+		require.NoError(t, ethKeyStore.AddRemoteSigner(ctx, remoteKey.Address, signer, chainID))
+		require.Error(t, ethKeyStore.CheckEnabled(ctx, remoteKey.Address, chainID))
+	})
+
+	t.Run("cannot be exported", func(t *testing.T) {
+		_, err := ethKeyStore.Export(ctx, remoteKey.Address.Hex(), cltest.Password)
+		require.ErrorIs(t, err, keystore.ErrKeyNotFound)
+	})
+}
@@ -66,14 +66,33 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromAddress returns a key without private key material, for the accounts whose private key is held by a remote
+// signer.
+func FromAddress(address common.Address) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: types.EIP55AddressFromAddress(address),
+	}
+}
+
+// IsRemote returns true if the private key of the account is not held by the node.
+func (key KeyV2) IsRemote() bool {
+	return key.privateKey == nil
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
 
+// Raw returns nil for remote keys.
 func (key KeyV2) Raw() Raw {
+	if key.IsRemote() {
+		return nil
+	}
 	return key.privateKey.D.Bytes()
 }
 
+// ToEcdsaPrivKey returns nil for remote keys, callers which need private key material have to check IsRemote.
 func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 	return key.privateKey
 }
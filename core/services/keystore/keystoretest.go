@@ -3,10 +3,17 @@ package keystore
 import (
 	"context"
 	"errors"
+	"math/big"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -77,3 +84,34 @@ func NewInMemory(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr lo
 		vrf:        newVRFKeyStore(km),
 	}
 }
+
+// inMemoryRemoteSigner is a RemoteSigner holding the private keys in memory. This is only intended to be used in tests,
+// in place of an external signer.
+type inMemoryRemoteSigner struct {
+	keys map[common.Address]ethkey.KeyV2
+}
+
+// NewInMemoryRemoteSigner returns a RemoteSigner signing with the given keys.
+func NewInMemoryRemoteSigner(keys ...ethkey.KeyV2) RemoteSigner {
+	s := &inMemoryRemoteSigner{keys: make(map[common.Address]ethkey.KeyV2, len(keys))}
+	for _, k := range keys {
+		s.keys[k.Address] = k
+	}
+	return s
+}
+
+func (s *inMemoryRemoteSigner) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, ok := s.keys[address]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), key.ToEcdsaPrivKey())
+}
+
+func (s *inMemoryRemoteSigner) SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error) {
+	key, ok := s.keys[address]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return crypto.Sign(accounts.TextHash(message), key.ToEcdsaPrivKey())
+}
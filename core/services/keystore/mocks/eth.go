@@ -10,6 +10,8 @@ import (
 
 	ethkey "github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 
+	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/ethereum/go-ethereum/core/types"
@@ -76,6 +78,69 @@ func (_c *Eth_Add_Call) RunAndReturn(run func(context.Context, common.Address, *
 	return _c
 }
 
+// AddRemoteSigner provides a mock function with given fields: ctx, address, signer, chainIDs
+func (_m *Eth) AddRemoteSigner(ctx context.Context, address common.Address, signer keystore.RemoteSigner, chainIDs ...*big.Int) error {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, address, signer)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRemoteSigner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, keystore.RemoteSigner, ...*big.Int) error); ok {
+		r0 = rf(ctx, address, signer, chainIDs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Eth_AddRemoteSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRemoteSigner'
+type Eth_AddRemoteSigner_Call struct {
+	*mock.Call
+}
+
+// AddRemoteSigner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - signer keystore.RemoteSigner
+//   - chainIDs ...*big.Int
+func (_e *Eth_Expecter) AddRemoteSigner(ctx interface{}, address interface{}, signer interface{}, chainIDs ...interface{}) *Eth_AddRemoteSigner_Call {
+	return &Eth_AddRemoteSigner_Call{Call: _e.mock.On("AddRemoteSigner",
+		append([]interface{}{ctx, address, signer}, chainIDs...)...)}
+}
+
+func (_c *Eth_AddRemoteSigner_Call) Run(run func(ctx context.Context, address common.Address, signer keystore.RemoteSigner, chainIDs ...*big.Int)) *Eth_AddRemoteSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*big.Int, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(*big.Int)
+			}
+		}
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(keystore.RemoteSigner), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Eth_AddRemoteSigner_Call) Return(_a0 error) *Eth_AddRemoteSigner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Eth_AddRemoteSigner_Call) RunAndReturn(run func(context.Context, common.Address, keystore.RemoteSigner, ...*big.Int) error) *Eth_AddRemoteSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckEnabled provides a mock function with given fields: ctx, address, chainID
 func (_m *Eth) CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ret := _m.Called(ctx, address, chainID)
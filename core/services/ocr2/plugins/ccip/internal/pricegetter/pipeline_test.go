@@ -171,6 +171,7 @@ func newTestPipelineGetter(t *testing.T, source string) *pricegetter.PipelineGet
 	cfg.On("MaxRunDuration").Return(time.Second)
 	cfg.On("DefaultHTTPTimeout").Return(*config2.MustNewDuration(time.Second))
 	cfg.On("DefaultHTTPLimit").Return(int64(1024 * 10))
+	cfg.On("CaptureHTTPTraffic").Return(false).Maybe()
 	cfg.On("VerboseLogging").Return(true)
 	db := pgtest.NewSqlxDB(t)
 	bridgeORM := bridges.NewORM(db)
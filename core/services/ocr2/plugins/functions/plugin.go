@@ -213,6 +213,9 @@ func NewConnector(ctx context.Context, pluginConfig *config.PluginConfig, ethKey
 	if idx == -1 {
 		return nil, nil, errors.New("key for configured node address not found")
 	}
+	if enabledKeys[idx].IsRemote() {
+		return nil, nil, errors.New("key for configured node address is held by a remote signer, gateway connector requires a local key")
+	}
 	signerKey := enabledKeys[idx].ToEcdsaPrivKey()
 	if enabledKeys[idx].ID() != pluginConfig.GatewayConnectorConfig.NodeAddress {
 		return nil, nil, errors.New("node address mismatch")
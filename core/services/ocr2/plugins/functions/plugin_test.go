@@ -81,3 +81,30 @@ func TestNewConnector_NoKeyForConfiguredAddress(t *testing.T) {
 	_, _, err = functions.NewConnector(ctx, config, ethKeystore, chainID, s4Storage, allowlist, rateLimiter, subscriptions, listener, offchainTransmitter, logger.TestLogger(t))
 	require.Error(t, err)
 }
+
+func TestNewConnector_RemoteKeyForConfiguredAddress(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	remoteKey := ethkey.FromAddress(common.HexToAddress("0x00000000DE801ceE9471ADf23370c48b011f82a6"))
+	gwcCfg := &connector.ConnectorConfig{
+		NodeAddress: remoteKey.ID(),
+		DonId:       "my_don",
+	}
+	chainID := big.NewInt(80001)
+	ethKeystore := ksmocks.NewEth(t)
+	s4Storage := s4mocks.NewStorage(t)
+	allowlist := gfaMocks.NewOnchainAllowlist(t)
+	subscriptions := gfsMocks.NewOnchainSubscriptions(t)
+	rateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100.0, GlobalBurst: 100, PerSenderRPS: 100.0, PerSenderBurst: 100})
+	require.NoError(t, err)
+	listener := sfmocks.NewFunctionsListener(t)
+	offchainTransmitter := sfmocks.NewOffchainTransmitter(t)
+	ethKeystore.On("EnabledKeysForChain", mock.Anything, mock.Anything).Return([]ethkey.KeyV2{remoteKey}, nil)
+	config := &config.PluginConfig{
+		GatewayConnectorConfig: gwcCfg,
+	}
+	_, _, err = functions.NewConnector(ctx, config, ethKeystore, chainID, s4Storage, allowlist, rateLimiter, subscriptions, listener, offchainTransmitter, logger.TestLogger(t))
+	require.ErrorContains(t, err, "remote signer")
+}
@@ -83,6 +83,14 @@ func NewSingletonPeerWrapper(keyStore keystore.Master, p2pCfg config.P2P, ocrCfg
 
 func (p *SingletonPeerWrapper) IsStarted() bool { return p.Ready() == nil }
 
+// ActivePeerID returns the peer ID of the P2P key used by the peer, or false if the peer is not started.
+func (p *SingletonPeerWrapper) ActivePeerID() (p2pkey.PeerID, bool) {
+	if !p.IsStarted() {
+		return p2pkey.PeerID{}, false
+	}
+	return p.PeerID, true
+}
+
 // Start starts SingletonPeerWrapper.
 func (p *SingletonPeerWrapper) Start(context.Context) error {
 	return p.StartOnce("SingletonPeerWrapper", func() error {
@@ -1,8 +1,11 @@
 package periodicbackup
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"os/exec"
@@ -16,11 +19,18 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
+	"github.com/smartcontractkit/chainlink/v2/core/store/dialects"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
-	minBackupFrequency = time.Minute
+	filePattern = "cl_backup_%s.dump"
+	// timestampedFilePattern is used when backups are retained, see BackupConfig.Retention.
+	timestampedFilePattern = "cl_backup_%s_%s.dump"
+	timestampFormat        = "20060102T150405Z"
+	encryptedExtension     = ".enc"
+	minBackupFrequency     = time.Minute
+	schemaVersionTimeout   = 30 * time.Second
 
 	excludedDataFromTables = []string{
 		"pipeline_runs",
@@ -31,6 +41,7 @@ var (
 type backupResult struct {
 	size            int64
 	path            string
+	manifest        Manifest
 	maskedArguments []string
 	pgDumpArguments []string
 }
@@ -43,50 +54,72 @@ type (
 
 	databaseBackup struct {
 		services.StateMachine
-		logger          logger.Logger
-		databaseURL     url.URL
-		mode            config.DatabaseBackupMode
-		frequency       time.Duration
-		outputParentDir string
-		done            chan bool
+		logger           logger.Logger
+		databaseURL      url.URL
+		mode             config.DatabaseBackupMode
+		frequency        time.Duration
+		retention        time.Duration
+		tables           []string
+		keystorePassword string
+		outputParentDir  string
+		done             chan bool
 	}
 
 	BackupConfig interface {
 		URL() *url.URL
 		Dir() string
+		Encrypt() bool
 		Mode() config.DatabaseBackupMode
 		Frequency() time.Duration
+		Retention() time.Duration
+		Tables() []string
 	}
 )
 
-// NewDatabaseBackup instantiates a *databaseBackup
-func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, lggr logger.Logger) (DatabaseBackup, error) {
+// NewDatabaseBackup instantiates a *databaseBackup. The keystore password is only required when backups are encrypted.
+func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, keystorePassword string, lggr logger.Logger) (DatabaseBackup, error) {
 	lggr = lggr.Named("DatabaseBackup")
 	dbBackupUrl := backupConfig.URL()
 	if dbBackupUrl != nil {
 		dbUrl = *dbBackupUrl
 	}
 
-	outputParentDir := filepath.Join(rootDir, "backup")
-	if backupConfig.Dir() != "" {
-		dir, err := filepath.Abs(backupConfig.Dir())
-		if err != nil {
-			return nil, errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", backupConfig.Dir())
-		}
-		outputParentDir = dir
+	outputParentDir, err := Dir(rootDir, backupConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if !backupConfig.Encrypt() {
+		keystorePassword = ""
+	} else if keystorePassword == "" {
+		return nil, errors.New("Database.Backup.Encrypt requires the keystore password - please set it in the secrets or pass it with --password")
 	}
 
 	return &databaseBackup{
-		services.StateMachine{},
-		lggr,
-		dbUrl,
-		backupConfig.Mode(),
-		backupConfig.Frequency(),
-		outputParentDir,
-		make(chan bool),
+		logger:           lggr,
+		databaseURL:      dbUrl,
+		mode:             backupConfig.Mode(),
+		frequency:        backupConfig.Frequency(),
+		retention:        backupConfig.Retention(),
+		tables:           backupConfig.Tables(),
+		keystorePassword: keystorePassword,
+		outputParentDir:  outputParentDir,
+		done:             make(chan bool),
 	}, nil
 }
 
+// Dir returns the directory backups are written to.
+func Dir(rootDir string, backupConfig BackupConfig) (string, error) {
+	if backupConfig.Dir() == "" {
+		return filepath.Join(rootDir, "backup"), nil
+	}
+	dir, err := filepath.Abs(backupConfig.Dir())
+	if err != nil {
+		return "", errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", backupConfig.Dir())
+	}
+	return dir, nil
+}
+
 // Start starts DatabaseBackup.
 func (backup *databaseBackup) Start(context.Context) error {
 	return backup.StartOnce("DatabaseBackup", func() (err error) {
@@ -146,7 +179,85 @@ func (backup *databaseBackup) RunBackup(version string) error {
 		backup.SvcErrBuffer.Append(err)
 		return err
 	}
-	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path, "schemaVersion", result.manifest.SchemaVersion)
+	if backup.retention > 0 {
+		if err = backup.prune(time.Now()); err != nil {
+			backup.logger.Warnw("Failed to delete expired backups", "err", err)
+		}
+	}
+	return nil
+}
+
+// prune deletes the backups older than the retention period, the latest backup is always kept.
+func (backup *databaseBackup) prune(now time.Time) error {
+	backups, err := ListBackups(backup.outputParentDir)
+	if err != nil || len(backups) == 0 {
+		return err
+	}
+	for _, b := range backups[:len(backups)-1] {
+		if now.Sub(b.CreatedAt) <= backup.retention {
+			continue
+		}
+		backup.logger.Debugw("Deleting expired backup", "filePath", b.Path, "createdAt", b.CreatedAt)
+		if err = os.Remove(b.Path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err = os.Remove(b.Path + manifestExtension); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// schemaVersion returns the version of the latest migration applied to the backed up database.
+func (backup *databaseBackup) schemaVersion() (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), schemaVersionTimeout)
+	defer cancel()
+	db, err := sql.Open(string(dialects.Postgres), backup.databaseURL.String())
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	var version int64
+	err = db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version_id), 0) FROM goose_migrations WHERE is_applied`).Scan(&version)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read the schema version")
+	}
+	return version, nil
+}
+
+// dumpEncrypted runs pg_dump and encrypts its output on the fly into the file at path, so that the plaintext dump is
+// never written to disk.
+func (backup *databaseBackup) dumpEncrypted(args []string, path string) error {
+	dst, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create the tmp file")
+	}
+	cmd := exec.Command("pg_dump", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		_ = dst.Close()
+		return errors.Wrap(err, "pg_dump failed")
+	}
+	encErr := encrypt(dst, stdout, backup.keystorePassword, utils.DefaultScryptParams)
+	// drain the pipe so that pg_dump can exit if the encryption failed
+	_, _ = io.Copy(io.Discard, stdout)
+	err = cmd.Wait()
+	if cerr := dst.Close(); encErr == nil {
+		encErr = cerr
+	}
+	if err != nil {
+		return errors.Wrapf(err, "pg_dump failed with output: %s", stderr.String())
+	}
+	if encErr != nil {
+		return errors.Wrap(encErr, "Failed to encrypt the backup")
+	}
 	return nil
 }
 
@@ -166,15 +277,22 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 
 	args := []string{
 		backup.databaseURL.String(),
-		"-f", tmpFile.Name(),
 		"-F", "c", // format: custom (zipped)
 	}
+	encrypted := backup.keystorePassword != ""
+	if !encrypted {
+		// encrypted dumps are streamed through the encryption instead
+		args = append(args, "-f", tmpFile.Name())
+	}
 
 	if backup.mode == config.DatabaseBackupModeLite {
 		for _, table := range excludedDataFromTables {
 			args = append(args, fmt.Sprintf("--exclude-table-data=%s", table))
 		}
 	}
+	for _, table := range backup.tables {
+		args = append(args, fmt.Sprintf("--table=%s", table))
+	}
 
 	maskArgs := func(args []string) []string {
 		masked := make([]string, len(args))
@@ -186,19 +304,22 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 	maskedArgs := maskArgs(args)
 	backup.logger.Debugf("Running pg_dump with: %v", maskedArgs)
 
-	cmd := exec.Command(
-		"pg_dump", args...,
-	)
-
-	_, err = cmd.Output()
-
+	if encrypted {
+		err = backup.dumpEncrypted(args, tmpFile.Name())
+	} else {
+		_, err = exec.Command("pg_dump", args...).Output()
+	}
 	if err != nil {
+		_ = os.Remove(tmpFile.Name())
 		partialResult := &backupResult{
 			size:            0,
 			path:            "",
 			maskedArguments: maskedArgs,
 			pgDumpArguments: args,
 		}
+		if encrypted {
+			return partialResult, err
+		}
 		var ee *exec.ExitError
 		if errors.As(err, &ee) {
 			return partialResult, errors.Wrapf(err, "pg_dump failed with output: %s", string(ee.Stderr))
@@ -209,7 +330,27 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 	if version == "" {
 		version = "unknown"
 	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
+	manifest := Manifest{
+		NodeVersion: version,
+		CreatedAt:   time.Now().UTC(),
+		Mode:        backup.mode,
+		Tables:      backup.tables,
+		Encrypted:   encrypted,
+	}
+	manifest.SchemaVersion, err = backup.schemaVersion()
+	if err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return nil, err
+	}
+
+	fileName := fmt.Sprintf(filePattern, version)
+	if backup.retention > 0 {
+		fileName = fmt.Sprintf(timestampedFilePattern, version, manifest.CreatedAt.Format(timestampFormat))
+	}
+	if manifest.Encrypted {
+		fileName += encryptedExtension
+	}
+	finalFilePath := filepath.Join(backup.outputParentDir, fileName)
 	_ = os.Remove(finalFilePath)
 	err = os.Rename(tmpFile.Name(), finalFilePath)
 	if err != nil {
@@ -217,14 +358,18 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
 	}
 
-	file, err := os.Stat(finalFilePath)
+	manifest.SHA256, manifest.Size, err = checksum(finalFilePath)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to access the final backup file")
 	}
+	if err = writeManifest(finalFilePath, manifest); err != nil {
+		return nil, errors.Wrap(err, "Failed to write the backup manifest")
+	}
 
 	return &backupResult{
-		size:            file.Size(),
+		size:            manifest.Size,
 		path:            finalFilePath,
+		manifest:        manifest,
 		maskedArguments: maskedArgs,
 		pgDumpArguments: args,
 	}, nil
@@ -0,0 +1,159 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// chunkSize is the size of the plaintext chunks which are sealed one by one, so that backups of any size can be
+	// encrypted without holding them in memory.
+	chunkSize = 1 << 20
+	saltSize  = 32
+	keySize   = 32
+	scryptR   = 8
+)
+
+// encryptedMagic prefixes backup files encrypted by the node.
+var encryptedMagic = []byte("CLBACKUP1")
+
+// encryptedHeader follows encryptedMagic, the scrypt parameters are stored so that decryption does not depend on the
+// configuration of the node.
+type encryptedHeader struct {
+	N, P        uint32
+	Salt        [saltSize]byte
+	NoncePrefix [4]byte
+}
+
+func newAEAD(password string, h encryptedHeader) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(password), h.Salt[:], int(h.N), scryptR, int(h.P), keySize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive the backup encryption key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns a unique nonce for each chunk of a file, the last chunk being flagged in the additional data so
+// that a truncated file cannot be decrypted.
+func chunkNonce(h encryptedHeader, counter uint64) []byte {
+	nonce := make([]byte, 12)
+	copy(nonce, h.NoncePrefix[:])
+	binary.BigEndian.PutUint64(nonce[4:], counter)
+	return nonce
+}
+
+func chunkAdditionalData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// encrypt seals src with a key derived from the password and writes it to dst.
+func encrypt(dst io.Writer, src io.Reader, password string, params utils.ScryptParams) error {
+	h := encryptedHeader{N: uint32(params.N), P: uint32(params.P)} //nolint:gosec // scrypt parameters are small
+	if _, err := rand.Read(h.Salt[:]); err != nil {
+		return err
+	}
+	if _, err := rand.Read(h.NoncePrefix[:]); err != nil {
+		return err
+	}
+	aead, err := newAEAD(password, h)
+	if err != nil {
+		return err
+	}
+	if _, err = dst.Write(encryptedMagic); err != nil {
+		return err
+	}
+	if err = binary.Write(dst, binary.BigEndian, h); err != nil {
+		return err
+	}
+
+	r := bufio.NewReaderSize(src, chunkSize)
+	buf := make([]byte, chunkSize)
+	var sealed []byte
+	for counter := uint64(0); ; counter++ {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+		_, err = r.Peek(1)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		last := err != nil
+		sealed = aead.Seal(sealed[:0], chunkNonce(h, counter), buf[:n], chunkAdditionalData(last))
+		if err = binary.Write(dst, binary.BigEndian, uint32(len(sealed))); err != nil { //nolint:gosec // bounded by chunkSize
+			return err
+		}
+		if _, err = dst.Write(sealed); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// decrypt opens a file sealed by encrypt and writes the plaintext to dst.
+func decrypt(dst io.Writer, src io.Reader, password string) error {
+	magic := make([]byte, len(encryptedMagic))
+	if _, err := io.ReadFull(src, magic); err != nil || !bytes.Equal(magic, encryptedMagic) {
+		return errors.New("not an encrypted backup file")
+	}
+	var h encryptedHeader
+	if err := binary.Read(src, binary.BigEndian, &h); err != nil {
+		return errors.Wrap(err, "failed to read the encryption header")
+	}
+	aead, err := newAEAD(password, h)
+	if err != nil {
+		return err
+	}
+
+	var sealed, opened []byte
+	for counter := uint64(0); ; counter++ {
+		var size uint32
+		if err = binary.Read(src, binary.BigEndian, &size); err != nil {
+			return errors.Wrap(err, "backup file is truncated")
+		}
+		if size > chunkSize+uint32(aead.Overhead()) { //nolint:gosec // overhead is 16 bytes
+			return errors.New("backup file is corrupted")
+		}
+		if cap(sealed) < int(size) {
+			sealed = make([]byte, size)
+		}
+		sealed = sealed[:size]
+		if _, err = io.ReadFull(src, sealed); err != nil {
+			return errors.Wrap(err, "backup file is truncated")
+		}
+		last := false
+		opened, err = aead.Open(opened[:0], chunkNonce(h, counter), sealed, chunkAdditionalData(false))
+		if err != nil {
+			last = true
+			opened, err = aead.Open(opened[:0], chunkNonce(h, counter), sealed, chunkAdditionalData(true))
+			if err != nil {
+				return errors.New("failed to decrypt the backup file, either the password is wrong or the file is corrupted")
+			}
+		}
+		if _, err = dst.Write(opened); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
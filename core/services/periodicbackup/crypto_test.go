@@ -0,0 +1,39 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, 2*chunkSize + 7} {
+		plaintext := make([]byte, size)
+		_, err := rand.Read(plaintext)
+		require.NoError(t, err)
+
+		var encrypted bytes.Buffer
+		require.NoError(t, encrypt(&encrypted, bytes.NewReader(plaintext), "password", utils.FastScryptParams))
+
+		var decrypted bytes.Buffer
+		require.NoError(t, decrypt(&decrypted, bytes.NewReader(encrypted.Bytes()), "password"))
+		assert.True(t, bytes.Equal(plaintext, decrypted.Bytes()), "size %d", size)
+
+		err = decrypt(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()), "wrong")
+		require.ErrorContains(t, err, "failed to decrypt the backup file")
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		var encrypted bytes.Buffer
+		require.NoError(t, encrypt(&encrypted, bytes.NewReader(make([]byte, 2*chunkSize)), "password", utils.FastScryptParams))
+		truncated := encrypted.Bytes()[:encrypted.Len()-chunkSize]
+		require.Error(t, decrypt(&bytes.Buffer{}, bytes.NewReader(truncated), "password"))
+	})
+}
@@ -0,0 +1,147 @@
+package periodicbackup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const manifestExtension = ".json"
+
+// Manifest describes a backup file. It is written next to the backup file, with a .json extension.
+type Manifest struct {
+	NodeVersion string `json:"nodeVersion"`
+	// SchemaVersion is the version of the latest migration applied to the database when it was backed up.
+	SchemaVersion int64                     `json:"schemaVersion"`
+	CreatedAt     time.Time                 `json:"createdAt"`
+	Mode          config.DatabaseBackupMode `json:"mode"`
+	// Tables is empty unless the backup is restricted to some tables.
+	Tables    []string `json:"tables,omitempty"`
+	Encrypted bool     `json:"encrypted"`
+	Size      int64    `json:"size"`
+	// SHA256 is the checksum of the backup file, as written on disk.
+	SHA256 string `json:"sha256"`
+}
+
+// Backup is a backup file along with its manifest.
+type Backup struct {
+	Path string
+	Manifest
+}
+
+func writeManifest(path string, m Manifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path+manifestExtension, b, 0600)
+}
+
+// ReadManifest reads the manifest of the backup file at path.
+func ReadManifest(path string) (Manifest, error) {
+	var m Manifest
+	b, err := os.ReadFile(path + manifestExtension)
+	if err != nil {
+		return m, errors.Wrapf(err, "failed to read the manifest of backup %s", path)
+	}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return m, errors.Wrapf(err, "invalid manifest for backup %s", path)
+	}
+	return m, nil
+}
+
+func checksum(path string) (sum string, size int64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	size, err = io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
+
+// Verify checks the integrity of the backup file at path against its manifest.
+func Verify(path string) (Backup, error) {
+	m, err := ReadManifest(path)
+	if err != nil {
+		return Backup{}, err
+	}
+	sum, size, err := checksum(path)
+	if err != nil {
+		return Backup{}, errors.Wrapf(err, "failed to read backup %s", path)
+	}
+	if size != m.Size || sum != m.SHA256 {
+		return Backup{}, errors.Errorf("backup %s is corrupted: expected %d bytes with checksum %s, got %d bytes with checksum %s", path, m.Size, m.SHA256, size, sum)
+	}
+	return Backup{Path: path, Manifest: m}, nil
+}
+
+// ListBackups returns the backups in dir, from the oldest to the latest. Backups without a manifest, i.e. taken by
+// older versions of the node, are ignored.
+func ListBackups(dir string) ([]Backup, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "cl_backup_*"+manifestExtension))
+	if err != nil {
+		return nil, err
+	}
+	backups := make([]Backup, 0, len(paths))
+	for _, p := range paths {
+		path := strings.TrimSuffix(p, manifestExtension)
+		m, err := ReadManifest(path)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, Backup{Path: path, Manifest: m})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.Before(backups[j].CreatedAt)
+	})
+	return backups, nil
+}
+
+// FindBackup returns the latest backup in dir taken at or before the given time, or the latest one if before is zero.
+func FindBackup(dir string, before time.Time) (Backup, error) {
+	backups, err := ListBackups(dir)
+	if err != nil {
+		return Backup{}, err
+	}
+	for i := len(backups) - 1; i >= 0; i-- {
+		if before.IsZero() || !backups[i].CreatedAt.After(before) {
+			return backups[i], nil
+		}
+	}
+	if before.IsZero() {
+		return Backup{}, errors.Errorf("no backup found in %s", dir)
+	}
+	return Backup{}, errors.Errorf("no backup taken before %s found in %s", before.Format(time.RFC3339), dir)
+}
+
+// CheckSchemaVersion asserts the backup can be restored into a database at schema version current, by a node which
+// latest migration is latest. The database must be at the schema version of the backup: restoring an older backup
+// would leave the objects of the later migrations in place, and those of a table backup would not match the other
+// tables.
+func (b Backup) CheckSchemaVersion(current, latest int64) error {
+	if b.SchemaVersion <= 0 {
+		return errors.Errorf("backup %s has no schema version, it cannot be validated against the migrations of this node", b.Path)
+	}
+	if b.SchemaVersion > latest {
+		return errors.Errorf("backup %s has schema version %d, which is newer than the latest migration %d of this node. Restore it with node version %s or later", b.Path, b.SchemaVersion, latest, b.NodeVersion)
+	}
+	if b.SchemaVersion != current {
+		return errors.Errorf("backup %s has schema version %d, but the database is at version %d. Migrate or roll back the database to version %d before restoring it", b.Path, b.SchemaVersion, current, b.SchemaVersion)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Restore verifies the backup, decrypts it with the keystore password if needed and restores it into the database.
+// Encrypted backups are decrypted on the fly into pg_restore, the plaintext dump is never written to disk.
+// Full backups replace the objects of the database which already exist. Backups restricted to some tables only replace
+// the rows of those tables, since the tables may be referenced by foreign keys of tables which are not restored.
+// Either way, the whole restore runs in a single transaction. The caller is responsible for checking the schema
+// version of the backup with CheckSchemaVersion.
+func Restore(ctx context.Context, dbURL url.URL, path string, keystorePassword string, lggr logger.Logger) error {
+	backup, err := Verify(path)
+	if err != nil {
+		return err
+	}
+	if backup.Encrypted && keystorePassword == "" {
+		return errors.New("backup is encrypted, the keystore password is required to restore it")
+	}
+
+	lggr.Debugf("Running pg_restore on %s into %s", backup.Path, dbURL.Redacted())
+	if len(backup.Tables) > 0 {
+		return restoreTables(ctx, dbURL, backup, keystorePassword)
+	}
+	return pgRestore(ctx, backup, keystorePassword, nil,
+		"--clean",
+		"--if-exists",
+		"--no-owner",
+		"--single-transaction",
+		"--exit-on-error",
+		"-d", dbURL.String(),
+	)
+}
+
+// restoreTables replaces the rows of the tables of the backup. The data-only script of pg_restore is run by psql,
+// after the rows are deleted in the same transaction. The rows are deleted rather than truncated, as TRUNCATE
+// refuses tables referenced by foreign keys, with the triggers disabled so that the references are not checked.
+func restoreTables(ctx context.Context, dbURL url.URL, backup Backup, keystorePassword string) error {
+	var toc bytes.Buffer
+	if err := pgRestore(ctx, backup, keystorePassword, &toc, "--list"); err != nil {
+		return err
+	}
+	tables := dataTables(toc.String())
+	if len(tables) == 0 {
+		return errors.Errorf("backup %s has no table data", backup.Path)
+	}
+
+	cmd := exec.CommandContext(ctx, "psql", "--no-psqlrc", "--quiet", "-v", "ON_ERROR_STOP=1", "-d", dbURL.String())
+	var stderr bytes.Buffer
+	cmd.Stdout = io.Discard
+	cmd.Stderr = &stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		return errors.Wrap(err, "psql failed")
+	}
+	script := "BEGIN;\nSET LOCAL session_replication_role = replica;\n"
+	for _, table := range tables {
+		script += "DELETE FROM " + table + ";\n"
+	}
+	_, err = io.WriteString(stdin, script)
+	if err == nil {
+		err = pgRestore(ctx, backup, keystorePassword, stdin,
+			"--data-only",
+			"--disable-triggers",
+			"--no-owner",
+			"--exit-on-error",
+			"-f", "-",
+		)
+	}
+	if err == nil {
+		_, err = io.WriteString(stdin, "COMMIT;\n")
+	}
+	// psql rolls back the transaction if its input ends before the COMMIT
+	_ = stdin.Close()
+	if psqlErr := cmd.Wait(); psqlErr != nil {
+		return errors.Wrapf(psqlErr, "psql failed with output: %s", stderr.String())
+	}
+	return err
+}
+
+// dataTables returns the quoted names of the tables which data is listed in the table of contents of a backup, as
+// printed by pg_restore --list.
+func dataTables(toc string) []string {
+	var tables []string
+	for _, line := range strings.Split(toc, "\n") {
+		if strings.HasPrefix(line, ";") {
+			continue
+		}
+		_, entry, ok := strings.Cut(line, " TABLE DATA ")
+		if !ok {
+			continue
+		}
+		// schema name owner
+		fields := strings.Fields(entry)
+		if len(fields) < 2 {
+			continue
+		}
+		tables = append(tables, pgx.Identifier{fields[0], fields[1]}.Sanitize())
+	}
+	return tables
+}
+
+// pgRestore runs pg_restore with args on the backup, decrypting it on the fly into its input if needed.
+func pgRestore(ctx context.Context, backup Backup, keystorePassword string, stdout io.Writer, args ...string) error {
+	if !backup.Encrypted {
+		args = append(args, backup.Path)
+	}
+	cmd := exec.CommandContext(ctx, "pg_restore", args...)
+	var stderr bytes.Buffer
+	cmd.Stdout = stdout
+	cmd.Stderr = &stderr
+	if backup.Encrypted {
+		return restoreEncrypted(cmd, backup.Path, keystorePassword, &stderr)
+	}
+	return pgRestoreError(cmd.Run(), &stderr)
+}
+
+// restoreEncrypted runs cmd with the decrypted backup at path as its input. If the decryption fails, cmd is killed
+// before its input ends so that pg_restore rolls back the partial restore.
+func restoreEncrypted(cmd *exec.Cmd, path string, keystorePassword string, stderr *bytes.Buffer) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		return pgRestoreError(err, stderr)
+	}
+	decErr := decrypt(stdin, src, keystorePassword)
+	if decErr != nil && !errors.Is(decErr, syscall.EPIPE) {
+		_ = cmd.Process.Kill()
+		_ = stdin.Close()
+		_ = cmd.Wait()
+		return decErr
+	}
+	// on EPIPE pg_restore exited early, its own error is reported instead
+	_ = stdin.Close()
+	return pgRestoreError(cmd.Wait(), stderr)
+}
+
+func pgRestoreError(err error, stderr *bytes.Buffer) error {
+	if err == nil {
+		return nil
+	}
+	var ee *exec.ExitError
+	if errors.As(err, &ee) {
+		return errors.Wrapf(err, "pg_restore failed with output: %s", stderr.String())
+	}
+	return errors.Wrap(err, "pg_restore failed")
+}
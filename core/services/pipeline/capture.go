@@ -0,0 +1,129 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+const redactedValue = "[redacted]"
+
+// sensitiveNameRegexp matches the names of headers and query parameters whose values are redacted before being captured.
+var sensitiveNameRegexp = regexp.MustCompile(`(?i)auth|token|secret|key|passw|cookie|signature|credential|session`)
+
+// HTTPCapture is the raw exchange of an http or bridge task, recorded when JobPipeline.HTTPRequest.CaptureTraffic is enabled.
+type HTTPCapture struct {
+	ID                int64
+	PipelineRunID     int64
+	PipelineTaskRunID uuid.UUID
+	DotID             string
+	Method            string
+	URL               string
+	RequestHeaders    HTTPHeaders
+	RequestBody       []byte
+	// StatusCode is zero if no response was received, Error holds the reason.
+	StatusCode      int
+	ResponseHeaders HTTPHeaders
+	ResponseBody    []byte
+	Latency         models.Interval
+	Error           null.String
+	CreatedAt       time.Time
+}
+
+// HTTPHeaders is a http.Header stored as JSON.
+type HTTPHeaders http.Header
+
+func (h HTTPHeaders) Value() (driver.Value, error) {
+	if h == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(h)
+}
+
+func (h *HTTPHeaders) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*h = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, h)
+	case string:
+		return json.Unmarshal([]byte(v), h)
+	default:
+		return pkgerrors.Errorf("unable to convert %v of %T to HTTPHeaders", value, value)
+	}
+}
+
+func redactHeaders(header http.Header) HTTPHeaders {
+	redacted := make(HTTPHeaders, len(header))
+	for name, values := range header {
+		if sensitiveNameRegexp.MatchString(name) {
+			redacted[name] = []string{redactedValue}
+		} else {
+			redacted[name] = values
+		}
+	}
+	return redacted
+}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	for name := range query {
+		if sensitiveNameRegexp.MatchString(name) {
+			query.Set(name, redactedValue)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.Redacted()
+}
+
+// replayTransport answers every request with the captured response, without reaching the network.
+type replayTransport struct {
+	capture *HTTPCapture
+}
+
+func (t replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.capture == nil {
+		return nil, pkgerrors.New("no traffic was captured for this task")
+	}
+	if t.capture.StatusCode == 0 {
+		return nil, pkgerrors.New(t.capture.Error.ValueOrZero())
+	}
+	return &http.Response{
+		Status:        http.StatusText(t.capture.StatusCode),
+		StatusCode:    t.capture.StatusCode,
+		Header:        http.Header(t.capture.ResponseHeaders),
+		Body:          io.NopCloser(bytes.NewReader(t.capture.ResponseBody)),
+		ContentLength: int64(len(t.capture.ResponseBody)),
+		Request:       req,
+	}, nil
+}
+
+// replayBridgeORM prevents replayed bridge tasks from reading or updating the bridge response cache,
+// which is not part of the captured traffic.
+type replayBridgeORM struct {
+	bridges.ORM
+}
+
+func (replayBridgeORM) GetCachedResponse(context.Context, string, int32, time.Duration) ([]byte, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (replayBridgeORM) UpsertBridgeResponse(context.Context, string, int32, []byte) error {
+	return nil
+}
@@ -65,6 +65,7 @@ type (
 	}
 
 	Config interface {
+		CaptureHTTPTraffic() bool
 		DefaultHTTPLimit() int64
 		DefaultHTTPTimeout() commonconfig.Duration
 		MaxRunDuration() time.Duration
@@ -108,6 +109,10 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// Capture is set by http and bridge tasks when traffic capture is enabled.
+	Capture *HTTPCapture
+	// SubResults holds the task run results of the sub-pipelines executed by a map task.
+	SubResults TaskRunResults
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -317,6 +322,7 @@ const (
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMap              TaskType = "map"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
@@ -413,6 +419,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &LookupTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLowercase:
 		task = &LowercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMap:
+		task = &MapTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeUppercase:
 		task = &UppercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeConditional:
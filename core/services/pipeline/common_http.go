@@ -9,8 +9,10 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 )
 
@@ -23,10 +25,13 @@ func makeHTTPRequest(
 	requestData MapParam,
 	client *http.Client,
 	httpLimit int64,
+	capture *HTTPCapture,
 ) ([]byte, int, http.Header, time.Duration, error) {
 	var bodyReader io.Reader
+	var bodyBytes []byte
 	if requestData != nil {
-		bodyBytes, err := json.Marshal(requestData)
+		var err error
+		bodyBytes, err = json.Marshal(requestData)
 		if err != nil {
 			return nil, 0, nil, 0, errors.Wrap(err, "failed to encode request body as JSON")
 		}
@@ -54,6 +59,22 @@ func makeHTTPRequest(
 
 	start := time.Now()
 	responseBytes, statusCode, respHeaders, err := httpRequest.SendRequest()
+	if capture != nil {
+		capture.Method = request.Method
+		capture.URL = redactURL(request.URL)
+		capture.RequestHeaders = redactHeaders(request.Header)
+		capture.RequestBody = bodyBytes
+		capture.StatusCode = statusCode
+		capture.ResponseHeaders = redactHeaders(respHeaders)
+		capture.ResponseBody = responseBytes
+		capture.Latency = models.Interval(time.Since(start))
+		capture.CreatedAt = start
+		if err != nil {
+			capture.Error = null.StringFrom(err.Error())
+		} else if ctx.Err() != nil {
+			capture.Error = null.StringFrom(ctx.Err().Error())
+		}
+	}
 	if ctx.Err() != nil {
 		return nil, 0, nil, 0, errors.New("http request timed out or interrupted")
 	}
@@ -48,22 +48,33 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	if err != nil {
 		return errors.Wrap(err, "could not unmarshal DOT into a pipeline.Graph")
 	}
-	g.AddImplicitDependenciesAsEdges()
-	return nil
+	return g.AddImplicitDependenciesAsEdges()
 }
 
 // Looks at node attributes and searches for implicit dependencies on other nodes
 // expressed as attribute values. Adds those dependencies as implicit edges in the graph.
-func (g *Graph) AddImplicitDependenciesAsEdges() {
+func (g *Graph) AddImplicitDependenciesAsEdges() error {
 	for nodesIter := g.Nodes(); nodesIter.Next(); {
 		graphNode := nodesIter.Node().(*GraphNode)
 
+		// The template of a map task refers to its own tasks and element as well as to the tasks of this pipeline
+		var templateLocals map[string]bool
+		if TaskType(strings.ToLower(graphNode.attrs["type"])) == TaskTypeMap {
+			var err error
+			if templateLocals, err = mapTemplateLocals(graphNode.attrs["template"], g); err != nil {
+				return errors.Wrapf(err, "invalid template for map task %q", graphNode.DOTID())
+			}
+		}
+
 		params := make(map[string]bool)
 		// Walk through all attributes and find all params which this node depends on
 		for _, attr := range graphNode.Attributes() {
 			for _, item := range variableRegexp.FindAll([]byte(attr.Value), -1) {
 				expr := strings.TrimSpace(string(item[2 : len(item)-1]))
 				param := strings.Split(expr, ".")[0]
+				if attr.Key == "template" && templateLocals[param] {
+					continue
+				}
 				params[param] = true
 			}
 		}
@@ -84,6 +95,7 @@ func (g *Graph) AddImplicitDependenciesAsEdges() {
 			}
 		}
 	}
+	return nil
 }
 
 // Indicates whether there's an implicit edge from uid -> vid.
@@ -253,6 +265,12 @@ func Parse(text string) (*Pipeline, error) {
 			return nil, err
 		}
 
+		if mapTask, ok := task.(*MapTask); ok {
+			if _, err = mapTask.parseTemplate(); err != nil {
+				return nil, errors.Wrapf(err, "invalid template for map task %q", node.dotID)
+			}
+		}
+
 		if task.OutputIndex() > 0 {
 			_, exists := resultIdxs[task.OutputIndex()]
 			if exists {
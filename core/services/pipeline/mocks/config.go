@@ -22,6 +22,51 @@ func (_m *Config) EXPECT() *Config_Expecter {
 	return &Config_Expecter{mock: &_m.Mock}
 }
 
+// CaptureHTTPTraffic provides a mock function with given fields:
+func (_m *Config) CaptureHTTPTraffic() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CaptureHTTPTraffic")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Config_CaptureHTTPTraffic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CaptureHTTPTraffic'
+type Config_CaptureHTTPTraffic_Call struct {
+	*mock.Call
+}
+
+// CaptureHTTPTraffic is a helper method to define mock.On call
+func (_e *Config_Expecter) CaptureHTTPTraffic() *Config_CaptureHTTPTraffic_Call {
+	return &Config_CaptureHTTPTraffic_Call{Call: _e.mock.On("CaptureHTTPTraffic")}
+}
+
+func (_c *Config_CaptureHTTPTraffic_Call) Run(run func()) *Config_CaptureHTTPTraffic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_CaptureHTTPTraffic_Call) Return(_a0 bool) *Config_CaptureHTTPTraffic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_CaptureHTTPTraffic_Call) RunAndReturn(run func() bool) *Config_CaptureHTTPTraffic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DefaultHTTPLimit provides a mock function with given fields:
 func (_m *Config) DefaultHTTPLimit() int64 {
 	ret := _m.Called()
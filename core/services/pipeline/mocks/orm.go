@@ -321,6 +321,65 @@ func (_c *ORM_DeleteRunsOlderThan_Call) RunAndReturn(run func(context.Context, t
 	return _c
 }
 
+// FindHTTPCaptures provides a mock function with given fields: ctx, runID
+func (_m *ORM) FindHTTPCaptures(ctx context.Context, runID int64) ([]pipeline.HTTPCapture, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindHTTPCaptures")
+	}
+
+	var r0 []pipeline.HTTPCapture
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]pipeline.HTTPCapture, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []pipeline.HTTPCapture); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.HTTPCapture)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindHTTPCaptures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindHTTPCaptures'
+type ORM_FindHTTPCaptures_Call struct {
+	*mock.Call
+}
+
+// FindHTTPCaptures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+func (_e *ORM_Expecter) FindHTTPCaptures(ctx interface{}, runID interface{}) *ORM_FindHTTPCaptures_Call {
+	return &ORM_FindHTTPCaptures_Call{Call: _e.mock.On("FindHTTPCaptures", ctx, runID)}
+}
+
+func (_c *ORM_FindHTTPCaptures_Call) Run(run func(ctx context.Context, runID int64)) *ORM_FindHTTPCaptures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ORM_FindHTTPCaptures_Call) Return(_a0 []pipeline.HTTPCapture, _a1 error) *ORM_FindHTTPCaptures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindHTTPCaptures_Call) RunAndReturn(run func(context.Context, int64) ([]pipeline.HTTPCapture, error)) *ORM_FindHTTPCaptures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindRun provides a mock function with given fields: ctx, id
 func (_m *ORM) FindRun(ctx context.Context, id int64) (pipeline.Run, error) {
 	ret := _m.Called(ctx, id)
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
+
 	context "context"
 
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -26,6 +28,53 @@ func (_m *Runner) EXPECT() *Runner_Expecter {
 	return &Runner_Expecter{mock: &_m.Mock}
 }
 
+// BridgeHealth provides a mock function with given fields:
+func (_m *Runner) BridgeHealth() *bridges.HealthTracker {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealth")
+	}
+
+	var r0 *bridges.HealthTracker
+	if rf, ok := ret.Get(0).(func() *bridges.HealthTracker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.HealthTracker)
+		}
+	}
+
+	return r0
+}
+
+// Runner_BridgeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealth'
+type Runner_BridgeHealth_Call struct {
+	*mock.Call
+}
+
+// BridgeHealth is a helper method to define mock.On call
+func (_e *Runner_Expecter) BridgeHealth() *Runner_BridgeHealth_Call {
+	return &Runner_BridgeHealth_Call{Call: _e.mock.On("BridgeHealth")}
+}
+
+func (_c *Runner_BridgeHealth_Call) Run(run func()) *Runner_BridgeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Runner_BridgeHealth_Call) Return(_a0 *bridges.HealthTracker) *Runner_BridgeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_BridgeHealth_Call) RunAndReturn(run func() *bridges.HealthTracker) *Runner_BridgeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -534,6 +583,75 @@ func (_c *Runner_Ready_Call) RunAndReturn(run func() error) *Runner_Ready_Call {
 	return _c
 }
 
+// ReplayRun provides a mock function with given fields: ctx, run, captures
+func (_m *Runner) ReplayRun(ctx context.Context, run pipeline.Run, captures []pipeline.HTTPCapture) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, run, captures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run, []pipeline.HTTPCapture) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, run, captures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run, []pipeline.HTTPCapture) *pipeline.Run); ok {
+		r0 = rf(ctx, run, captures)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Run, []pipeline.HTTPCapture) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, run, captures)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Run, []pipeline.HTTPCapture) error); ok {
+		r2 = rf(ctx, run, captures)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Runner_ReplayRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayRun'
+type Runner_ReplayRun_Call struct {
+	*mock.Call
+}
+
+// ReplayRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run pipeline.Run
+//   - captures []pipeline.HTTPCapture
+func (_e *Runner_Expecter) ReplayRun(ctx interface{}, run interface{}, captures interface{}) *Runner_ReplayRun_Call {
+	return &Runner_ReplayRun_Call{Call: _e.mock.On("ReplayRun", ctx, run, captures)}
+}
+
+func (_c *Runner_ReplayRun_Call) Run(run func(ctx context.Context, run pipeline.Run, captures []pipeline.HTTPCapture)) *Runner_ReplayRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Run), args[2].([]pipeline.HTTPCapture))
+	})
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Runner_ReplayRun_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) RunAndReturn(run func(context.Context, pipeline.Run, []pipeline.HTTPCapture) (*pipeline.Run, pipeline.TaskRunResults, error)) *Runner_ReplayRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeRun provides a mock function with given fields: ctx, taskID, value, err
 func (_m *Runner) ResumeRun(ctx context.Context, taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(ctx, taskID, value, err)
@@ -642,6 +760,75 @@ func (_c *Runner_Run_Call) RunAndReturn(run func(context.Context, *pipeline.Run,
 	return _c
 }
 
+// SimulateRun provides a mock function with given fields: ctx, spec, sim
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, sim pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, sim)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, sim)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Simulation) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, sim)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Simulation) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, sim)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Simulation) error); ok {
+		r2 = rf(ctx, spec, sim)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Runner_SimulateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateRun'
+type Runner_SimulateRun_Call struct {
+	*mock.Call
+}
+
+// SimulateRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - sim pipeline.Simulation
+func (_e *Runner_Expecter) SimulateRun(ctx interface{}, spec interface{}, sim interface{}) *Runner_SimulateRun_Call {
+	return &Runner_SimulateRun_Call{Call: _e.mock.On("SimulateRun", ctx, spec, sim)}
+}
+
+func (_c *Runner_SimulateRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, sim pipeline.Simulation)) *Runner_SimulateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Simulation))
+	})
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Runner_SimulateRun_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error)) *Runner_SimulateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
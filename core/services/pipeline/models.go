@@ -275,6 +275,8 @@ type TaskRun struct {
 	FinishedAt    null.Time                         `json:"finishedAt"`
 	Index         int32                             `json:"index"`
 	DotID         string                            `json:"dotId"`
+	// Capture is the traffic recorded during this execution of the task, it is saved separately.
+	Capture *HTTPCapture `json:"-" db:"-"`
 
 	// Used internally for sorting completed results
 	task Task
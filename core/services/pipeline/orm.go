@@ -92,6 +92,8 @@ type ORM interface {
 
 	DeleteRunsOlderThan(context.Context, time.Duration) error
 	FindRun(ctx context.Context, id int64) (Run, error)
+	// FindHTTPCaptures returns the http and bridge traffic captured for the run, if any.
+	FindHTTPCaptures(ctx context.Context, runID int64) ([]HTTPCapture, error)
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 
@@ -308,6 +310,9 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 		if err != nil {
 			return fmt.Errorf("failed to insert pipeline task runs: %w", err)
 		}
+		if err = tx.insertHTTPCaptures(ctx, run.PipelineTaskRuns); err != nil {
+			return err
+		}
 		run.PipelineTaskRuns = taskRuns
 		return nil
 	})
@@ -404,6 +409,9 @@ VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created
 	`
 		var pipelineTaskRuns []TaskRun
 		for _, run := range runs {
+			if errC := tx.insertHTTPCaptures(ctx, run.PipelineTaskRuns); errC != nil {
+				return errC
+			}
 			if !saveSuccessfulTaskRuns && !run.HasErrors() {
 				continue
 			}
@@ -499,6 +507,11 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 		run.PipelineTaskRuns[i].PipelineRunID = run.ID
 	}
 
+	// captures are saved for successful runs too, a wrong answer can be just as worth replaying as an error
+	if err = o.insertHTTPCaptures(ctx, run.PipelineTaskRuns); err != nil {
+		return err
+	}
+
 	if !saveSuccessfulTaskRuns && !run.HasErrors() {
 		return nil
 	}
@@ -511,6 +524,34 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 	return errors.Wrap(err, "failed to insert pipeline_task_runs")
 }
 
+// insertHTTPCaptures saves the traffic captured by the task runs, which must already have their PipelineRunID set.
+func (o *orm) insertHTTPCaptures(ctx context.Context, taskRuns []TaskRun) error {
+	var captures []HTTPCapture
+	for _, tr := range taskRuns {
+		if tr.Capture == nil {
+			continue
+		}
+		capture := *tr.Capture
+		capture.PipelineRunID = tr.PipelineRunID
+		captures = append(captures, capture)
+	}
+	if len(captures) == 0 {
+		return nil
+	}
+
+	sql := `INSERT INTO pipeline_http_captures (pipeline_run_id, pipeline_task_run_id, dot_id, method, url, request_headers, request_body, status_code, response_headers, response_body, latency, error, created_at)
+		VALUES (:pipeline_run_id, :pipeline_task_run_id, :dot_id, :method, :url, :request_headers, :request_body, :status_code, :response_headers, :response_body, :latency, :error, :created_at)
+		ON CONFLICT (pipeline_run_id, pipeline_task_run_id) DO NOTHING;`
+	_, err := o.ds.NamedExecContext(ctx, sql, captures)
+	return errors.Wrap(err, "failed to insert pipeline_http_captures")
+}
+
+// FindHTTPCaptures returns the http and bridge traffic captured for the run, if any.
+func (o *orm) FindHTTPCaptures(ctx context.Context, runID int64) (captures []HTTPCapture, err error) {
+	err = o.ds.SelectContext(ctx, &captures, `SELECT * FROM pipeline_http_captures WHERE pipeline_run_id = $1 ORDER BY id`, runID)
+	return captures, errors.Wrap(err, "failed to select pipeline_http_captures")
+}
+
 // DeleteRunsOlderThan deletes all pipeline_runs that have been finished for a certain threshold to free DB space
 // Caller is expected to set timeout on calling context.
 func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
@@ -40,6 +40,14 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars) (run *Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, but tasks stubbed by the simulation return the
+	// stubbed result instead of being executed. Tasks with external side effects must be stubbed.
+	// The run is not persisted and not reported to prometheus.
+	SimulateRun(ctx context.Context, spec Spec, sim Simulation) (run *Run, trrs TaskRunResults, err error)
+	// ReplayRun re-executes a stored run in-memory. Http and bridge tasks are answered with the traffic captured
+	// during the original run instead of reaching the network, other tasks with external side effects return
+	// their saved result. The replay is not persisted and not reported to prometheus.
+	ReplayRun(ctx context.Context, run Run, captures []HTTPCapture) (replay *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error
@@ -53,12 +61,16 @@ type Runner interface {
 
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
+
+	// BridgeHealth returns the state of the bridges shared by the bridge tasks of all runs, see bridges.ResiliencePolicy.
+	BridgeHealth() *bridges.HealthTracker
 }
 
 type runner struct {
 	services.StateMachine
 	orm                    ORM
 	btORM                  bridges.ORM
+	bridgeHealth           *bridges.HealthTracker
 	config                 Config
 	bridgeConfig           BridgeConfig
 	legacyEVMChains        legacyevm.LegacyChainContainer
@@ -122,6 +134,7 @@ func NewRunner(
 	r := &runner{
 		orm:                    orm,
 		btORM:                  bridges.NewCache(btORM, lggr, bridges.DefaultUpsertInterval),
+		bridgeHealth:           bridges.NewHealthTracker(),
 		config:                 cfg,
 		bridgeConfig:           bridgeCfg,
 		legacyEVMChains:        legacyChains,
@@ -249,6 +262,10 @@ func NewRun(spec Spec, vars Vars) *Run {
 	}
 }
 
+func (r *runner) BridgeHealth() *bridges.HealthTracker {
+	return r.bridgeHealth
+}
+
 func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
@@ -289,6 +306,66 @@ func overtimeContext(ctx context.Context) (context.Context, context.CancelFunc)
 }
 
 func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, TaskRunResults, error) {
+	return r.executeRun(ctx, spec, vars, nil)
+}
+
+func (r *runner) SimulateRun(ctx context.Context, spec Spec, sim Simulation) (*Run, TaskRunResults, error) {
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = sim.validate(pipeline); err != nil {
+		return nil, nil, err
+	}
+	spec.Pipeline = pipeline
+	return r.executeRun(ctx, spec, NewVarsFrom(sim.Vars), &sim)
+}
+
+func (r *runner) ReplayRun(ctx context.Context, run Run, captures []HTTPCapture) (*Run, TaskRunResults, error) {
+	spec := run.PipelineSpec
+	spec.Pipeline = nil
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	capturesByDotID := make(map[string]*HTTPCapture, len(captures))
+	for i := range captures {
+		capturesByDotID[captures[i].DotID] = &captures[i]
+	}
+	sim := Simulation{Tasks: make(map[string]TaskStub)}
+	for _, task := range pipeline.Tasks {
+		switch t := task.(type) {
+		case *HTTPTask:
+			client := &http.Client{Transport: replayTransport{capture: capturesByDotID[t.DotID()]}}
+			t.httpClient, t.unrestrictedHTTPClient = client, client
+		case *BridgeTask:
+			t.httpClient = &http.Client{Transport: replayTransport{capture: capturesByDotID[t.DotID()]}}
+			t.orm = replayBridgeORM{ORM: t.orm}
+			// a replay is neither subject to nor affecting the health of the bridges
+			t.health = nil
+		default:
+			if !requiresStub(task) {
+				continue
+			}
+			taskRun := run.ByDotID(task.DotID())
+			if taskRun == nil || taskRun.IsPending() {
+				return nil, nil, pkgerrors.Errorf("task %q of type %s cannot be replayed, its result was not saved", task.DotID(), task.Type())
+			}
+			if taskRun.Error.Valid {
+				sim.Tasks[task.DotID()] = TaskStub{Error: taskRun.Error.String}
+			} else {
+				sim.Tasks[task.DotID()] = TaskStub{Output: taskRun.Output.Val}
+			}
+		}
+	}
+	spec.Pipeline = pipeline
+
+	inputs, _ := run.Inputs.Val.(map[string]interface{})
+	return r.executeRun(ctx, spec, NewVarsFrom(inputs), &sim)
+}
+
+func (r *runner) executeRun(ctx context.Context, spec Spec, vars Vars, sim *Simulation) (*Run, TaskRunResults, error) {
 	// Pipeline runs may return results after the context is cancelled, so we modify the
 	// deadline to give them time to return before the parent context deadline.
 	var cancel func()
@@ -313,7 +390,7 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	}
 
 	run := NewRun(spec, vars)
-	taskRunResults := r.run(ctx, pipeline, run, vars)
+	taskRunResults := r.run(ctx, pipeline, run, vars, sim)
 
 	if run.Pending {
 		return run, nil, fmt.Errorf("unexpected async run for spec ID %v, tried executing via ExecuteRun", spec.ID)
@@ -342,6 +419,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
 			// orm added to BridgeTask
 			task.(*BridgeTask).orm = r.btORM
+			task.(*BridgeTask).health = r.bridgeHealth
 			task.(*BridgeTask).specId = spec.ID
 			// URL is "safe" because it comes from the node's own database. We
 			// must use the unrestrictedHTTPClient because some node operators
@@ -368,6 +446,9 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeMap:
+			task.(*MapTask).runner = r
+			task.(*MapTask).spec = spec
 		default:
 		}
 	}
@@ -375,42 +456,27 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	return pipeline, nil
 }
 
-func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
-	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
+// run executes the pipeline, sim is only set for simulated runs.
+func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, sim *Simulation) TaskRunResults {
+	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName, "simulated", sim != nil)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
-	// This is "just in case" for cleaning up any stray reports.
-	// Normally the scheduler loop doesn't stop until all in progress runs report back
-	reportCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
-	defer cancel()
-
 	if pipelineTimeout := r.config.MaxRunDuration(); pipelineTimeout != 0 {
+		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, pipelineTimeout)
 		defer cancel()
 	}
 
-	for taskRun := range scheduler.taskCh {
-		taskRun := taskRun
-		// execute
-		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
-
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+	r.executeTasks(ctx, scheduler, run.PipelineSpec, l, sim)
 
-			scheduler.report(reportCtx, result)
-		}, func(err interface{}) {
-			t := time.Now()
-			scheduler.report(reportCtx, TaskRunResult{
-				ID:         uuid.New(),
-				Task:       taskRun.task,
-				Result:     Result{Error: ErrRunPanicked{err}},
-				FinishedAt: null.TimeFrom(t),
-				CreatedAt:  t, // TODO: more accurate start time
-			})
-		})
+	// map tasks report the task runs of their elements along with their own
+	var results []TaskRunResult
+	for _, result := range scheduler.results {
+		results = append(results, result)
+		results = append(results, result.runInfo.SubResults...)
 	}
 
 	// if the run is suspended, awaiting resumption
@@ -425,12 +491,14 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime = run.FinishedAt.Time.Sub(run.CreatedAt)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		if sim == nil {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
 	run.PipelineTaskRuns = nil
-	for _, result := range scheduler.results {
+	for _, result := range results {
 		output := result.Result.OutputDB()
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 			ID:            result.ID,
@@ -442,6 +510,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			Capture:       result.runInfo.Capture,
 			task:          result.Task,
 		})
 
@@ -475,17 +544,16 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			if sim == nil {
+				PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			}
 		} else {
 			run.State = RunStatusCompleted
 		}
 	}
 
 	// TODO: drop this once we stop using TaskRunResults
-	var taskRunResults TaskRunResults
-	for _, result := range scheduler.results {
-		taskRunResults = append(taskRunResults, result)
-	}
+	taskRunResults := TaskRunResults(results)
 
 	var idxs []int32
 	for i := range taskRunResults {
@@ -525,7 +593,62 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
-func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
+// executeTasks executes the task runs handed out by the scheduler until it is done.
+func (r *runner) executeTasks(ctx context.Context, scheduler *scheduler, spec Spec, l logger.Logger, sim *Simulation) {
+	// This is "just in case" for cleaning up any stray reports.
+	// Normally the scheduler loop doesn't stop until all in progress runs report back
+	reportCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	for taskRun := range scheduler.taskCh {
+		taskRun := taskRun
+		// execute
+		go recovery.WrapRecoverHandle(l, func() {
+			result := r.executeTaskRun(ctx, spec, taskRun, l, sim)
+
+			if sim == nil {
+				logTaskRunToPrometheus(result, spec)
+			}
+
+			scheduler.report(reportCtx, result)
+		}, func(err interface{}) {
+			t := time.Now()
+			scheduler.report(reportCtx, TaskRunResult{
+				ID:         uuid.New(),
+				Task:       taskRun.task,
+				Result:     Result{Error: ErrRunPanicked{err}},
+				FinishedAt: null.TimeFrom(t),
+				CreatedAt:  t, // TODO: more accurate start time
+			})
+		})
+	}
+}
+
+// runSubPipeline executes the template of a map task for a single element, see MapTask.
+func (r *runner) runSubPipeline(ctx context.Context, spec Spec, template string, vars Vars, l logger.Logger) (TaskRunResults, error) {
+	spec.DotDagSource = template
+	spec.Pipeline = nil
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduler := newScheduler(pipeline, NewRun(spec, vars), vars, l)
+	go scheduler.Run()
+
+	r.executeTasks(ctx, scheduler, spec, l, nil)
+
+	var results TaskRunResults
+	for _, task := range pipeline.Tasks {
+		if result, ok := scheduler.results[task.ID()]; ok {
+			results = append(results, result)
+			results = append(results, result.runInfo.SubResults...)
+		}
+	}
+	return results, nil
+}
+
+func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger, sim *Simulation) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
 		"taskType", taskRun.task.Type(),
@@ -552,7 +675,17 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	var result Result
+	var runInfo RunInfo
+	if stub, ok := sim.stubFor(taskRun.task); ok {
+		result = stub.result()
+	} else {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
+	if runInfo.Capture != nil {
+		runInfo.Capture.PipelineTaskRunID = taskRun.task.Base().uuid
+		runInfo.Capture.DotID = taskRun.task.DotID()
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -676,7 +809,7 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 	}
 
 	for {
-		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})))
+		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), nil)
 
 		if preinsert {
 			// FailSilently = run failed and task was marked failEarly. skip StoreRun and instead delete all trace of it
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -1049,3 +1050,154 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	// no expectations, stubbed bridges must not be looked up
+	btORM := bridgesMocks.NewORM(t)
+	r, _ := newRunner(t, db, btORM, cfg)
+
+	spec := pipeline.Spec{DotDagSource: `
+ds1          [type=bridge name="voter_turnout"]
+ds1_parse    [type=jsonparse path="data,result"]
+ds1_multiply [type=multiply input="$(ds1_parse)" times="$(jobRun.meta.times)"]
+ds2          [type=http method=GET url="https://chain.link/voter_turnout/USA-2020"]
+ds2_parse    [type=jsonparse path="turnout"]
+
+ds1 -> ds1_parse -> ds1_multiply
+ds2 -> ds2_parse
+`}
+
+	t.Run("executes the pipeline with stubbed tasks", func(t *testing.T) {
+		sim, err := pipeline.ParseSimulation(`
+[vars.jobRun.meta]
+times = 100
+
+[tasks.ds1]
+fixture = '{"data": {"result": 1.5}}'
+
+[tasks.ds2]
+error = "connection refused"
+`)
+		require.NoError(t, err)
+
+		run, trrs, err := r.SimulateRun(testutils.Context(t), spec, sim)
+		require.NoError(t, err)
+		require.Len(t, trrs, 5)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		assert.Len(t, run.PipelineTaskRuns, 5)
+
+		results := make(map[string]pipeline.Result)
+		for _, trr := range trrs {
+			results[trr.Task.DotID()] = trr.Result
+		}
+		assert.Equal(t, `{"data": {"result": 1.5}}`, results["ds1"].Value)
+		assert.Equal(t, "150", results["ds1_multiply"].Value.(decimal.Decimal).String())
+		assert.EqualError(t, results["ds2"].Error, "connection refused")
+		assert.Error(t, results["ds2_parse"].Error)
+	})
+
+	t.Run("stubs replace tasks without side effects", func(t *testing.T) {
+		run, trrs, err := r.SimulateRun(testutils.Context(t), spec, pipeline.Simulation{
+			Vars: map[string]interface{}{"jobRun": map[string]interface{}{"meta": map[string]interface{}{"times": 2}}},
+			Tasks: map[string]pipeline.TaskStub{
+				"ds1":       {Fixture: "{}"},
+				"ds1_parse": {Output: 21},
+				"ds2":       {Fixture: `{"turnout": 0.66}`},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		require.Len(t, trrs, 5)
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "ds1_multiply":
+				assert.Equal(t, "42", trr.Result.Value.(decimal.Decimal).String())
+			case "ds2_parse":
+				assert.Equal(t, json.Number("0.66"), trr.Result.Value)
+			}
+		}
+	})
+
+	t.Run("requires stubs for tasks with side effects", func(t *testing.T) {
+		_, _, err := r.SimulateRun(testutils.Context(t), spec, pipeline.Simulation{
+			Tasks: map[string]pipeline.TaskStub{"ds1": {Fixture: "{}"}},
+		})
+		require.EqualError(t, err, `task "ds2" of type http must be stubbed in a simulation`)
+	})
+
+	t.Run("rejects invalid stubs", func(t *testing.T) {
+		_, _, err := r.SimulateRun(testutils.Context(t), spec, pipeline.Simulation{
+			Tasks: map[string]pipeline.TaskStub{"ds1": {Fixture: "{}"}, "ds2": {Fixture: "{}"}, "ds3": {Error: "boom"}},
+		})
+		require.EqualError(t, err, `stub for task "ds3" does not match any task in the pipeline`)
+
+		_, _, err = r.SimulateRun(testutils.Context(t), spec, pipeline.Simulation{
+			Tasks: map[string]pipeline.TaskStub{"ds1": {Fixture: "{}", Error: "boom"}, "ds2": {Fixture: "{}"}},
+		})
+		require.EqualError(t, err, `invalid stub for task "ds1": exactly one of output, fixture or error must be set`)
+
+		_, err = pipeline.ParseSimulation(`
+[tasks.ds1]
+response = "{}"
+`)
+		require.Error(t, err)
+	})
+}
+
+func Test_PipelineRunner_ReplayRun(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.HTTPRequest.CaptureTraffic = ptr(true)
+	})
+	btORM := bridgesMocks.NewORM(t)
+	r, _ := newRunner(t, db, btORM, cfg)
+
+	var price atomic.Int64
+	price.Store(100)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Api-Key", "secret")
+		_, _ = fmt.Fprintf(w, `{"price": %d}`, price.Load())
+	}))
+	defer s.Close()
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+ds      [type=http method=GET url="%s?apikey=secret"]
+parse   [type=jsonparse path="price"]
+ds -> parse
+`, s.URL)}
+	run, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+	require.NoError(t, err)
+	require.Len(t, trrs, 2)
+
+	var captures []pipeline.HTTPCapture
+	for _, tr := range run.PipelineTaskRuns {
+		if tr.Capture != nil {
+			captures = append(captures, *tr.Capture)
+		}
+	}
+	require.Len(t, captures, 1)
+	capture := captures[0]
+	assert.Equal(t, "ds", capture.DotID)
+	assert.Equal(t, http.StatusOK, capture.StatusCode)
+	assert.Equal(t, `{"price": 100}`, string(capture.ResponseBody))
+	assert.Equal(t, []string{"[redacted]"}, capture.ResponseHeaders["X-Api-Key"])
+	assert.NotContains(t, capture.URL, "secret")
+
+	// the source has moved on, but the replay reproduces the original answer
+	price.Store(200)
+	run.PipelineSpec = spec
+	replay, trrs, err := r.ReplayRun(testutils.Context(t), *run, captures)
+	require.NoError(t, err)
+	require.Len(t, trrs, 2)
+	assert.Equal(t, pipeline.RunStatusCompleted, replay.State)
+	result, err := trrs.FinalResult().SingularResult()
+	require.NoError(t, err)
+	assert.Equal(t, json.Number("100"), result.Value)
+
+	// without captures, the task fails instead of reaching the network
+	_, trrs, err = r.ReplayRun(testutils.Context(t), *run, nil)
+	require.NoError(t, err)
+	assert.True(t, trrs.FinalResult().HasFatalErrors())
+}
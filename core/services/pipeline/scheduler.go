@@ -116,6 +116,11 @@ func (s *scheduler) reconstructResults() {
 	for _, r := range s.run.PipelineTaskRuns {
 		task := s.pipeline.ByDotID(r.DotID)
 
+		// the task runs of map task elements are already accounted for by the result of the map task
+		if task == nil && isMapElementTaskRun(s.pipeline, r.DotID) {
+			continue
+		}
+
 		if task == nil {
 			panic("can't find task by dot id")
 		}
@@ -0,0 +1,127 @@
+package pipeline
+
+import (
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// TaskStub replaces the execution of a single task during a simulated run.
+// Exactly one of Output, Fixture or Error must be set.
+type TaskStub struct {
+	// Output is returned as the task result value as-is, e.g. a number, a string or a table.
+	Output interface{} `toml:"output" json:"output,omitempty"`
+	// Fixture is a recorded raw response body, returned the same way http and bridge tasks return a response.
+	Fixture string `toml:"fixture" json:"fixture,omitempty"`
+	// Error makes the task fail with the given message.
+	Error string `toml:"error" json:"error,omitempty"`
+}
+
+func (s TaskStub) result() Result {
+	switch {
+	case s.Error != "":
+		return Result{Error: pkgerrors.New(s.Error)}
+	case s.Fixture != "":
+		return Result{Value: s.Fixture}
+	default:
+		return Result{Value: s.Output}
+	}
+}
+
+func (s TaskStub) validate() error {
+	var set int
+	if s.Output != nil {
+		set++
+	}
+	if s.Fixture != "" {
+		set++
+	}
+	if s.Error != "" {
+		set++
+	}
+	if set != 1 {
+		return pkgerrors.New("exactly one of output, fixture or error must be set")
+	}
+	return nil
+}
+
+// Simulation describes a dry-run of a pipeline, see Runner.SimulateRun.
+//
+//	[vars.jobRun]
+//	meta = { foo = "bar" }
+//
+//	[tasks.ds1]
+//	fixture = '{"data": {"result": 123.45}}'
+//
+//	[tasks.ds2]
+//	error = "connection refused"
+type Simulation struct {
+	// Vars are the initial pipeline variables, e.g. jobSpec or jobRun.
+	Vars map[string]interface{} `toml:"vars" json:"vars,omitempty"`
+	// Tasks holds the stubs keyed by task DOT ID.
+	Tasks map[string]TaskStub `toml:"tasks" json:"tasks,omitempty"`
+}
+
+// ParseSimulation decodes a Simulation from its TOML representation.
+func ParseSimulation(s string) (sim Simulation, err error) {
+	if err = toml.NewDecoder(strings.NewReader(s)).Strict(true).Decode(&sim); err != nil {
+		return sim, pkgerrors.Wrap(err, "failed to parse simulation")
+	}
+	return sim, nil
+}
+
+// stubFor returns the stub configured for the task, if any.
+func (s *Simulation) stubFor(task Task) (TaskStub, bool) {
+	if s == nil {
+		return TaskStub{}, false
+	}
+	stub, ok := s.Tasks[task.DotID()]
+	return stub, ok
+}
+
+// validate ensures that every stub refers to a task of the pipeline and that no task
+// reaching outside of the node (a bridge, an HTTP endpoint, a chain or a key) would be executed for real.
+func (s *Simulation) validate(p *Pipeline) error {
+	tasks := make(map[string]Task, len(p.Tasks))
+	for _, task := range p.Tasks {
+		tasks[task.DotID()] = task
+	}
+	for dotID, stub := range s.Tasks {
+		if _, ok := tasks[dotID]; !ok {
+			return pkgerrors.Errorf("stub for task %q does not match any task in the pipeline", dotID)
+		}
+		if err := stub.validate(); err != nil {
+			return pkgerrors.Wrapf(err, "invalid stub for task %q", dotID)
+		}
+	}
+	for _, task := range p.Tasks {
+		if _, ok := s.Tasks[task.DotID()]; !ok && requiresStub(task) {
+			return pkgerrors.Errorf("task %q of type %s must be stubbed in a simulation", task.DotID(), task.Type())
+		}
+	}
+	return nil
+}
+
+// requiresStub returns true for the tasks with side effects or external dependencies.
+func requiresStub(task Task) bool {
+	switch task.Type() {
+	case TaskTypeHTTP, TaskTypeBridge, TaskTypeETHCall, TaskTypeETHTx, TaskTypeEstimateGasLimit,
+		TaskTypeVRF, TaskTypeVRFV2, TaskTypeVRFV2Plus:
+		return true
+	case TaskTypeMap:
+		// the elements of a map task are not stubbed individually, the whole task is
+		template, err := task.(*MapTask).parseTemplate()
+		if err != nil {
+			return false
+		}
+		for _, t := range template.Tasks {
+			if requiresStub(t) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
@@ -66,6 +66,7 @@ type BridgeTask struct {
 
 	specId       int32
 	orm          bridges.ORM
+	health       *bridges.HealthTracker
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
@@ -112,7 +113,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.findBridge(overtimeCtx, bridges.BridgeName(name))
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
@@ -156,7 +157,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 	lggr.Tracew("Bridge task: sending request",
 		"requestData", string(requestDataJSON),
-		"url", url.String(),
+		"url", bt.URL.String(),
 	)
 
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
@@ -170,14 +171,12 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
-
-	// check for external adapter response object status
-	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
-		statusCode = code
-	}
+	response := t.fetch(requestCtx, overtimeCtx, lggr, bt, reqHeaders, requestData)
+	defer func() { runInfo.Capture = response.capture }()
+	url := URLParam(response.bridge.URL)
+	responseBytes, statusCode, headers, elapsed, err := response.body, response.statusCode, response.headers, response.elapsed, response.err
 
-	if err != nil || statusCode != http.StatusOK {
+	if response.failed() {
 		if adapterErr := eautils.BestEffortExtractEAError(responseBytes); adapterErr != nil {
 			err = adapterErr
 		}
@@ -248,12 +247,134 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
-	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
+func (t *BridgeTask) findBridge(ctx context.Context, name bridges.BridgeName) (bridges.BridgeType, error) {
+	bt, err := t.orm.FindBridge(ctx, name)
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bridges.BridgeType{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+	return bt, nil
+}
+
+// bridgeResponse is the outcome of a single request to a bridge.
+type bridgeResponse struct {
+	bridge     bridges.BridgeType
+	body       []byte
+	statusCode int
+	headers    http.Header
+	elapsed    time.Duration
+	err        error
+	capture    *HTTPCapture
+}
+
+func (r bridgeResponse) failed() bool {
+	return r.err != nil || r.statusCode != http.StatusOK
+}
+
+// fetch sends the request to the bridge, enforcing its resilience policy: requests are not sent while its circuit is open,
+// they are hedged once pending for longer than its p95 latency, and sent to its fallback bridge when they cannot succeed.
+func (t *BridgeTask) fetch(ctx, overtimeCtx context.Context, lggr logger.Logger, bt bridges.BridgeType, reqHeaders StringSliceParam, requestData MapParam) bridgeResponse {
+	var fallback *bridges.BridgeType
+	if bt.FallbackBridgeName != nil {
+		fb, err := t.findBridge(overtimeCtx, *bt.FallbackBridgeName)
+		if err != nil {
+			lggr.Warnw("Bridge task: fallback bridge not found", "err", err, "bridge", bt.Name, "fallback", *bt.FallbackBridgeName)
+		} else {
+			fallback = &fb
+		}
+	}
+
+	if err := t.health.Allow(bt.Name, bt.ResiliencePolicy); err != nil {
+		if fallback == nil {
+			return bridgeResponse{bridge: bt, err: errors.Wrapf(err, "bridge %s", bt.Name)}
+		}
+		lggr.Debugw("Bridge task: circuit is open, requesting fallback bridge", "bridge", bt.Name, "fallback", fallback.Name)
+		return t.fetchFallback(ctx, lggr, *fallback, reqHeaders, requestData)
+	}
+
+	var response bridgeResponse
+	if hedgeAfter, ok := t.health.HedgeAfter(bt.Name, bt.ResiliencePolicy); ok && t.Async != "true" {
+		hedge := bt
+		if fallback != nil {
+			hedge = *fallback
+		}
+		response = t.hedgedRequest(ctx, lggr, bt, hedge, hedgeAfter, reqHeaders, requestData)
+	} else {
+		response = t.request(ctx, lggr, bt, reqHeaders, requestData)
+	}
+
+	if response.failed() && fallback != nil && response.bridge.Name != fallback.Name && ctx.Err() == nil {
+		lggr.Debugw("Bridge task: request failed, requesting fallback bridge", "err", response.err, "statusCode", response.statusCode, "bridge", bt.Name, "fallback", fallback.Name)
+		if fallbackResponse := t.fetchFallback(ctx, lggr, *fallback, reqHeaders, requestData); !fallbackResponse.failed() {
+			return fallbackResponse
+		}
+	}
+	return response
+}
+
+// fetchFallback sends the request to a fallback bridge, which has its own circuit but no fallback of its own.
+func (t *BridgeTask) fetchFallback(ctx context.Context, lggr logger.Logger, fallback bridges.BridgeType, reqHeaders StringSliceParam, requestData MapParam) bridgeResponse {
+	if err := t.health.Allow(fallback.Name, fallback.ResiliencePolicy); err != nil {
+		return bridgeResponse{bridge: fallback, err: errors.Wrapf(err, "fallback bridge %s", fallback.Name)}
+	}
+	return t.request(ctx, lggr, fallback, reqHeaders, requestData)
+}
+
+// hedgedRequest sends the request to the bridge then, if no response was received after hedgeAfter, to the hedge bridge too.
+// The first successful response wins, the other request is cancelled.
+func (t *BridgeTask) hedgedRequest(ctx context.Context, lggr logger.Logger, bt, hedge bridges.BridgeType, hedgeAfter time.Duration, reqHeaders StringSliceParam, requestData MapParam) bridgeResponse {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses := make(chan bridgeResponse, 2)
+	go func() { responses <- t.request(ctx, lggr, bt, reqHeaders, requestData) }()
+
+	timer := time.NewTimer(hedgeAfter)
+	defer timer.Stop()
+
+	pending := 1
+	var first *bridgeResponse
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if first != nil || t.health.Allow(hedge.Name, hedge.ResiliencePolicy) != nil {
+				continue
+			}
+			lggr.Debugw("Bridge task: hedging request", "bridge", bt.Name, "hedge", hedge.Name, "hedgeAfter", hedgeAfter)
+			pending++
+			go func() { responses <- t.request(ctx, lggr, hedge, reqHeaders, requestData) }()
+		case response := <-responses:
+			pending--
+			if !response.failed() {
+				return response
+			}
+			if first == nil {
+				first = &response
+			}
+		}
+	}
+	return *first
+}
+
+// request sends a single request to the bridge and records its outcome.
+func (t *BridgeTask) request(ctx context.Context, lggr logger.Logger, bt bridges.BridgeType, reqHeaders StringSliceParam, requestData MapParam) bridgeResponse {
+	var capture *HTTPCapture
+	if t.config.CaptureHTTPTraffic() {
+		capture = &HTTPCapture{}
+	}
+
+	response := bridgeResponse{bridge: bt, capture: capture}
+	response.body, response.statusCode, response.headers, response.elapsed, response.err = makeHTTPRequest(ctx, lggr, "POST", URLParam(bt.URL), reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit(), capture)
+
+	// check for external adapter response object status
+	if code, ok := eautils.BestEffortExtractEAStatus(response.body); ok {
+		response.statusCode = code
+	}
+
+	// requests cancelled by the task itself, e.g. the losing side of a hedged request, say nothing about the health of the bridge
+	if !errors.Is(ctx.Err(), context.Canceled) || !response.failed() {
+		t.health.Record(bt.Name, bt.ResiliencePolicy, response.elapsed, response.failed())
 	}
-	return URLParam(bt.URL), nil
+	return response
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -0,0 +1,214 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	// mapItemKey and mapIndexKey are the variables holding the current element in a map task template.
+	mapItemKey  = "item"
+	mapIndexKey = "index"
+
+	defaultMapConcurrency = 10
+)
+
+// MapTask executes a sub-pipeline for every element of an array and collects the results into an array, in order.
+//
+// The template is a pipeline with a single terminal task. In addition to the variables of the enclosing pipeline,
+// it can refer to the current element as $(item) and to its position as $(index). Up to concurrency elements are
+// processed at a time, and up to allowedFaults elements may fail, in which case their result is null.
+//
+//	prices [type=map input="$(assets)" concurrency=4
+//	        template="ds [type=http method=GET url=\"$(item.url)\"]; parse [type=jsonparse path=\"price\" data=\"$(ds)\"]; ds -> parse"]
+//
+// The task runs of every element are reported along with the map task, the DOT ID of each one prefixed
+// with the DOT ID of the map task and the element index, e.g. prices[0].ds.
+//
+// Return types:
+//
+//	[]interface{}
+type MapTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Input         string `json:"input"`
+	Template      string `json:"template"`
+	Concurrency   string `json:"concurrency"`
+	AllowedFaults string `json:"allowedFaults"`
+
+	runner *runner
+	spec   Spec
+}
+
+var _ Task = (*MapTask)(nil)
+
+func (t *MapTask) Type() TaskType {
+	return TaskTypeMap
+}
+
+func (t *MapTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		items              SliceParam
+		maybeConcurrency   MaybeUint64Param
+		maybeAllowedFaults MaybeUint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&items, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maybeConcurrency, From(t.Concurrency)), "concurrency"),
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	concurrency := defaultMapConcurrency
+	if c, isSet := maybeConcurrency.Uint64(); isSet {
+		if c == 0 {
+			return Result{Error: errors.Wrap(ErrBadInput, "concurrency must be greater than zero")}, runInfo
+		}
+		concurrency = int(c)
+	}
+	allowedFaults, _ := maybeAllowedFaults.Uint64()
+
+	elements := make([]TaskRunResults, len(items))
+	values := make([]interface{}, len(items))
+	errs := make([]error, len(items))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, item := range items {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, item interface{}) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			elements[i], values[i], errs[i] = t.runElement(ctx, lggr, vars, i, item)
+		}(i, item)
+	}
+	wg.Wait()
+
+	var faults uint64
+	for i := range items {
+		runInfo.SubResults = append(runInfo.SubResults, elements[i]...)
+		if errs[i] != nil {
+			faults++
+			lggr.Debugw("Map task element failed", "index", i, "err", errs[i])
+		}
+	}
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "number of faulty elements %v > number allowed faults %v: %v", faults, allowedFaults, multierr.Combine(errs...))}, runInfo
+	}
+
+	return Result{Value: values}, runInfo
+}
+
+// runElement executes the template for a single element, returning its task run results along with the terminal result.
+func (t *MapTask) runElement(ctx context.Context, lggr logger.Logger, vars Vars, index int, item interface{}) (TaskRunResults, interface{}, error) {
+	elementVars := vars.Copy()
+	if err := multierr.Combine(elementVars.Set(mapItemKey, item), elementVars.Set(mapIndexKey, index)); err != nil {
+		return nil, nil, err
+	}
+
+	trrs, err := t.runner.runSubPipeline(ctx, t.spec, t.Template, elementVars, lggr.With("mapTask", t.DotID(), "mapIndex", index))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "element %d", index)
+	}
+
+	var final Result
+	prefix := fmt.Sprintf("%s[%d].", t.DotID(), index)
+	results := make(TaskRunResults, len(trrs))
+	for i, trr := range trrs {
+		trr.Task = copyTask(trr.Task)
+		base := trr.Task.Base()
+		if len(base.outputs) == 0 {
+			final = trr.Result
+			// the terminal task of the template feeds the map task, so that it is not mistaken for a terminal task of the run
+			base.outputs = []Task{t}
+		}
+		base.dotID = prefix + base.dotID
+		if trr.runInfo.Capture != nil {
+			capture := *trr.runInfo.Capture
+			capture.DotID = base.dotID
+			trr.runInfo.Capture = &capture
+		}
+		results[i] = trr
+	}
+	if final.Error != nil {
+		return results, nil, errors.Wrapf(final.Error, "element %d", index)
+	}
+	return results, final.Value, nil
+}
+
+// copyTask returns a shallow copy of the task, which keeps its concrete type so that the task run results of the
+// elements can be handled like any other.
+func copyTask(task Task) Task {
+	v := reflect.ValueOf(task).Elem()
+	c := reflect.New(v.Type())
+	c.Elem().Set(v)
+	return c.Interface().(Task)
+}
+
+// parseTemplate parses and validates the template of the task.
+func (t *MapTask) parseTemplate() (*Pipeline, error) {
+	p, err := Parse(t.Template)
+	if err != nil {
+		return nil, err
+	}
+	var terminals int
+	for _, task := range p.Tasks {
+		if len(task.Outputs()) == 0 {
+			terminals++
+		}
+	}
+	if terminals != 1 {
+		return nil, errors.Errorf("template must have exactly one terminal task, got %d", terminals)
+	}
+	if p.RequiresPreInsert() {
+		return nil, errors.New("template must not contain asynchronous tasks")
+	}
+	return p, nil
+}
+
+// mapTemplateLocals returns the names a map task template defines for itself, references to which
+// are not dependencies on the tasks of the enclosing pipeline g. It returns an error if one of them is also the
+// name of a task of g, which the template could then not refer to.
+func mapTemplateLocals(template string, g *Graph) (map[string]bool, error) {
+	locals := map[string]bool{mapItemKey: true, mapIndexKey: true}
+	tg := NewGraph()
+	// an invalid template is reported when the map task is parsed
+	if err := tg.UnmarshalText([]byte(template)); err == nil {
+		for nodes := tg.Nodes(); nodes.Next(); {
+			locals[nodes.Node().(*GraphNode).DOTID()] = true
+		}
+	}
+	for nodes := g.Nodes(); nodes.Next(); {
+		if name := nodes.Node().(*GraphNode).DOTID(); locals[name] {
+			return nil, errors.Errorf("%q is both a task of the pipeline and a name of the template", name)
+		}
+	}
+	return locals, nil
+}
+
+// isMapElementTaskRun returns true if the task run belongs to an element of a map task of the pipeline.
+func isMapElementTaskRun(p *Pipeline, dotID string) bool {
+	for _, task := range p.Tasks {
+		if task.Type() == TaskTypeMap && strings.HasPrefix(dotID, task.DotID()+"[") {
+			return true
+		}
+	}
+	return false
+}
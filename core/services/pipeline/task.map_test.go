@@ -0,0 +1,158 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestMapTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("template refers to its own tasks and to the enclosing pipeline", func(t *testing.T) {
+		p, err := pipeline.Parse(`
+other  [type=memo value="1"]
+base   [type=memo value="10"]
+prices [type=map input="$(assets)" template="ds [type=memo value=\"$(item)\"]; mul [type=multiply input=\"$(ds)\" times=\"$(base)\"]; ds -> mul"]
+`)
+		require.NoError(t, err)
+		inputs := p.ByDotID("prices").Inputs()
+		require.Len(t, inputs, 1)
+		assert.Equal(t, "base", inputs[0].InputTask.DotID())
+		assert.False(t, inputs[0].PropagateResult)
+	})
+
+	t.Run("template names must not shadow the tasks of the enclosing pipeline", func(t *testing.T) {
+		_, err := pipeline.Parse(`
+ds     [type=memo value="1"]
+prices [type=map input="$(assets)" template="ds [type=memo value=\"$(item)\"]"]
+`)
+		require.ErrorContains(t, err, `invalid template for map task "prices": "ds" is both a task of the pipeline and a name of the template`)
+
+		_, err = pipeline.Parse(`
+item   [type=memo value="1"]
+prices [type=map input="$(assets)" template="ds [type=memo value=\"$(item)\"]"]
+`)
+		require.ErrorContains(t, err, `"item" is both a task of the pipeline and a name of the template`)
+	})
+
+	for _, tt := range []struct {
+		name     string
+		template string
+		err      string
+	}{
+		{"empty", ``, "empty pipeline"},
+		{"multiple terminal tasks", `a [type=memo value=1]; b [type=memo value=2]`, "exactly one terminal task, got 2"},
+		{"asynchronous task", `tx [type=ethtx]`, "must not contain asynchronous tasks"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pipeline.Parse(fmt.Sprintf(`prices [type=map input="$(assets)" template="%s"]`, tt.template))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), `invalid template for map task "prices"`)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestMapTask(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+		switch strings.TrimPrefix(req.URL.Path, "/") {
+		case "BTC":
+			_, _ = w.Write([]byte(`{"price": 60000}`))
+		case "ETH":
+			_, _ = w.Write([]byte(`{"price": 3000}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"assets": []interface{}{s.URL + "/BTC", s.URL + "/ETH", s.URL + "/LINK"},
+	})
+	source := func(allowedFaults int) string {
+		return fmt.Sprintf(`
+prices [type=map input="$(assets)" concurrency=2 allowedFaults=%d
+        template="ds [type=http method=GET url=\"$(item)\"]; parse [type=jsonparse path=\"price\"]; ds -> parse"]
+`, allowedFaults)
+	}
+
+	t.Run("collects the results of every element", func(t *testing.T) {
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: source(1)}, vars)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+
+		// the map task and the two tasks of each of the three elements
+		require.Len(t, trrs, 7)
+		var dotIDs []string
+		for _, trr := range trrs {
+			dotIDs = append(dotIDs, trr.Task.DotID())
+			if strings.HasSuffix(trr.Task.DotID(), ".ds") {
+				// the task runs of the elements keep the type of their task
+				assert.IsType(t, &pipeline.HTTPTask{}, trr.Task)
+			}
+		}
+		assert.ElementsMatch(t, []string{
+			"prices",
+			"prices[0].ds", "prices[0].parse",
+			"prices[1].ds", "prices[1].parse",
+			"prices[2].ds", "prices[2].parse",
+		}, dotIDs)
+		assert.Len(t, trrs.Terminals(), 1)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{json.Number("60000"), json.Number("3000"), nil}, result.Value)
+
+		require.Len(t, run.PipelineTaskRuns, 7)
+		require.NotNil(t, run.ByDotID("prices[2].ds"))
+		assert.True(t, run.ByDotID("prices[2].ds").Error.Valid)
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.LessOrEqual(t, maxInFlight, 2)
+	})
+
+	t.Run("fails with too many faulty elements", func(t *testing.T) {
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: source(0)}, vars)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "number of faulty elements 1 > number allowed faults 0")
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // NewTestTelemetryIngressClient calls NewTelemetryIngressClient and injects telemClient.
@@ -19,7 +20,15 @@ func NewTestTelemetryIngressClient(t *testing.T, url *url.URL, serverPubKeyHex s
 
 // NewTestTelemetryIngressBatchClient calls NewTelemetryIngressBatchClient and injects telemClient.
 func NewTestTelemetryIngressBatchClient(t *testing.T, url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, telemClient telemPb.TelemClient, sendInterval time.Duration, uniconn bool) TelemetryService {
-	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn)
+	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, logging, logger.TestLogger(t), 100, 50, sendInterval, time.Second, uniconn, "", 0)
+	tc.(*telemetryIngressBatchClient).closeFn = func() error { return nil }
+	tc.(*telemetryIngressBatchClient).telemClient = telemClient
+	return tc
+}
+
+// NewTestTelemetryIngressBatchClientWithSpool calls NewTelemetryIngressBatchClient with a spool in spoolDir and injects telemClient.
+func NewTestTelemetryIngressBatchClientWithSpool(t *testing.T, url *url.URL, serverPubKeyHex string, ks keystore.CSA, telemClient telemPb.TelemClient, sendInterval time.Duration, spoolDir string) TelemetryService {
+	tc := NewTelemetryIngressBatchClient(url, serverPubKeyHex, ks, false, logger.TestLogger(t), 100, 50, sendInterval, time.Second, false, spoolDir, utils.MB)
 	tc.(*telemetryIngressBatchClient).closeFn = func() error { return nil }
 	tc.(*telemetryIngressBatchClient).telemClient = telemClient
 	return tc
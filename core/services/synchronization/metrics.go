@@ -26,6 +26,21 @@ var (
 		Help: "Number of telemetry messages dropped",
 	}, []string{"endpoint", "telemetry_type"})
 
+	TelemetryClientMessagesSpooled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_client_messages_spooled",
+		Help: "Number of telemetry messages persisted to the spool after failing to send",
+	}, []string{"endpoint", "telemetry_type"})
+
+	TelemetryClientMessagesReplayed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_client_messages_replayed",
+		Help: "Number of spooled telemetry messages sent to the telemetry ingress server",
+	}, []string{"endpoint", "telemetry_type"})
+
+	TelemetryClientMessagesSpoolDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_client_messages_spool_dropped",
+		Help: "Number of telemetry messages dropped because the spool was full or could not be written",
+	}, []string{"endpoint"})
+
 	TelemetryClientWorkers = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "telemetry_client_workers",
 		Help: "Number of telemetry workers",
@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // NoopTelemetryIngressBatchClient is a no-op interface for TelemetryIngressBatchClient
@@ -62,12 +63,21 @@ type telemetryIngressBatchClient struct {
 
 	useUniConn bool
 
+	// spoolDir is the directory of the spool of telemetry which failed to send, empty if disabled
+	spoolDir     string
+	spoolMaxSize utils.FileSize
+	spool        *telemetrySpool
+	// replayMu is held by the replay of each spooled batch, and shared by the live sends of the workers, so
+	// that they can't overtake spooled telemetry
+	replayMu sync.RWMutex
+
 	healthMonitorCancel context.CancelFunc
 }
 
 // NewTelemetryIngressBatchClient returns a client backed by wsrpc that
-// can send telemetry to the telemetry ingress server
-func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool) TelemetryService {
+// can send telemetry to the telemetry ingress server. If spoolDir is set, telemetry
+// which fails to send is persisted there and replayed in order once the server is reachable.
+func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, lggr logger.Logger, telemBufferSize uint, telemMaxBatchSize uint, telemSendInterval time.Duration, telemSendTimeout time.Duration, useUniconn bool, spoolDir string, spoolMaxSize utils.FileSize) TelemetryService {
 	c := &telemetryIngressBatchClient{
 		telemBufferSize:   telemBufferSize,
 		telemMaxBatchSize: telemMaxBatchSize,
@@ -79,6 +89,8 @@ func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks key
 		logging:           logging,
 		workers:           make(map[string]*telemetryIngressBatchWorker),
 		useUniConn:        useUniconn,
+		spoolDir:          spoolDir,
+		spoolMaxSize:      spoolMaxSize,
 	}
 	c.Service, c.eng = services.Config{
 		Name:  "TelemetryIngressBatchClient",
@@ -103,6 +115,16 @@ func (tc *telemetryIngressBatchClient) start(ctx context.Context) error {
 
 	serverPubKey := keys.FromHex(tc.serverPubKeyHex)
 
+	if tc.spoolDir != "" {
+		tc.spool, err = newTelemetrySpool(tc.spoolDir, int64(tc.spoolMaxSize)) //nolint:gosec // limited by the config
+		if err != nil {
+			return fmt.Errorf("could not open telemetry spool: %w", err)
+		}
+		if n := tc.spool.Len(); n > 0 {
+			tc.eng.Infow("Replaying spooled telemetry", "count", n, "dir", tc.spoolDir)
+		}
+	}
+
 	// Initialize a new wsrpc client caller
 	// This is used to call RPC methods on the server
 	if tc.telemClient == nil { // only preset for tests
@@ -134,9 +156,60 @@ func (tc *telemetryIngressBatchClient) start(ctx context.Context) error {
 		}
 	}
 
+	if tc.spool != nil {
+		tc.eng.GoTick(timeutil.NewTicker(func() time.Duration {
+			return tc.telemSendInterval
+		}), tc.replaySpool)
+	}
+
 	return nil
 }
 
+// replaySpool sends spooled telemetry in order, until the spool is empty or sending fails.
+// Workers spool their batches instead of sending them while the spool is not empty, so the
+// spooled telemetry is never overtaken by live telemetry.
+func (tc *telemetryIngressBatchClient) replaySpool(ctx context.Context) {
+	if tc.useUniConn && !tc.connected.Load() {
+		return
+	}
+	for ctx.Err() == nil {
+		if !tc.replayNext(ctx) {
+			return
+		}
+	}
+}
+
+// replayNext sends the oldest spooled batch and removes it from the spool. Returns false if the
+// spool is empty or the batch could not be replayed.
+func (tc *telemetryIngressBatchClient) replayNext(ctx context.Context) bool {
+	tc.replayMu.Lock()
+	defer tc.replayMu.Unlock()
+	req, err := tc.spool.Peek()
+	if err != nil {
+		tc.eng.Warnw("Could not read spooled telemetry", "err", err)
+		return false
+	}
+	if req == nil {
+		return false
+	}
+	sendCtx, cancel := context.WithTimeout(ctx, tc.telemSendTimeout)
+	_, err = tc.telemClient.TelemBatch(sendCtx, req)
+	cancel()
+	if err != nil {
+		tc.eng.Debugw("Could not replay spooled telemetry", "err", err)
+		return false
+	}
+	if err = tc.spool.Pop(); err != nil {
+		tc.eng.Warnw("Could not remove replayed telemetry from spool", "err", err)
+		return false
+	}
+	TelemetryClientMessagesReplayed.WithLabelValues(tc.url.String(), req.TelemetryType).Add(float64(len(req.Telemetry)))
+	if tc.logging {
+		tc.eng.Debugw("Successfully replayed spooled telemetry", "contractID", req.ContractId, "telemType", req.TelemetryType, "telemetry", req.Telemetry)
+	}
+	return true
+}
+
 // startHealthMonitoring starts a goroutine to monitor the connection state and update other relevant metrics every 5 seconds
 func (tc *telemetryIngressBatchClient) startHealthMonitoring(ctx context.Context, conn *wsrpc.ClientConn) {
 	_, cancel := context.WithCancel(ctx)
@@ -162,15 +235,26 @@ func (tc *telemetryIngressBatchClient) startHealthMonitoring(ctx context.Context
 	})
 }
 
-// Close disconnects the wsrpc client from the ingress server and waits for all workers to exit
-func (tc *telemetryIngressBatchClient) close() error {
+// Close disconnects the wsrpc client from the ingress server and waits for all workers to exit.
+// Buffered telemetry is spooled, if enabled, to be sent after a restart.
+func (tc *telemetryIngressBatchClient) close() (err error) {
 	if tc.healthMonitorCancel != nil {
 		tc.healthMonitorCancel()
 	}
 	if (tc.useUniConn && tc.connected.Load()) || !tc.useUniConn {
-		return tc.closeFn()
+		err = tc.closeFn()
 	}
-	return nil
+	if tc.spool != nil {
+		tc.workersMutex.RLock()
+		for _, worker := range tc.workers {
+			for len(worker.chTelemetry) > 0 {
+				spoolTelemetry(tc.spool, worker.BuildTelemBatchReq(), tc.url.String(), tc.eng)
+			}
+		}
+		tc.workersMutex.RUnlock()
+		err = errors.Join(err, tc.spool.Close())
+	}
+	return err
 }
 
 // getCSAPrivateKey gets the client's CSA private key
@@ -191,6 +275,15 @@ func (tc *telemetryIngressBatchClient) getCSAPrivateKey() (privkey []byte, err e
 // and a warning is logged.
 func (tc *telemetryIngressBatchClient) Send(ctx context.Context, telemData []byte, contractID string, telemType TelemetryType) {
 	if tc.useUniConn && !tc.connected.Load() {
+		if tc.spool != nil {
+			spoolTelemetry(tc.spool, &telemPb.TelemBatchRequest{
+				ContractId:    contractID,
+				TelemetryType: string(telemType),
+				Telemetry:     [][]byte{telemData},
+				SentAt:        time.Now().UnixNano(),
+			}, tc.url.String(), tc.eng)
+			return
+		}
 		tc.eng.Warnw("not connected to telemetry endpoint", "endpoint", tc.url.String())
 		return
 	}
@@ -231,6 +324,8 @@ func (tc *telemetryIngressBatchClient) findOrCreateWorker(payload TelemPayload)
 			tc.logging,
 			tc.url.String(),
 		)
+		worker.spool = tc.spool
+		worker.replayMu = &tc.replayMu
 		tc.eng.GoTick(timeutil.NewTicker(func() time.Duration {
 			return tc.telemSendInterval
 		}), worker.Send)
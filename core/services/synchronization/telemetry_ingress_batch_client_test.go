@@ -1,7 +1,12 @@
 package synchronization_test
 
 import (
+	"context"
+	"errors"
 	"net/url"
+	"slices"
+	"strconv"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -9,6 +14,7 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -86,3 +92,126 @@ func TestTelemetryIngressBatchClient_HappyPath(t *testing.T) {
 		return []uint32{contractCounter1.Load(), contractCounter3.Load()}
 	}).Should(gomega.Equal([]uint32{3, 1}))
 }
+
+// spoolTestServer records the telemetry of successful TelemBatch calls, and fails them while down.
+type spoolTestServer struct {
+	down     atomic.Bool
+	mu       sync.Mutex
+	received []string
+}
+
+func (s *spoolTestServer) TelemBatch(_ context.Context, req *telemPb.TelemBatchRequest) (*telemPb.TelemResponse, error) {
+	if s.down.Load() {
+		return nil, errors.New("ingress server unreachable")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, telem := range req.Telemetry {
+		s.received = append(s.received, string(telem))
+	}
+	return &telemPb.TelemResponse{}, nil
+}
+
+func (s *spoolTestServer) Received() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.received)
+}
+
+func TestTelemetryIngressBatchClient_SpoolReplaysInOrder(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	telemClient := mocks.NewTelemClient(t)
+	csaKeystore := ksmocks.NewCSA(t)
+	csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+
+	server := &spoolTestServer{}
+	server.down.Store(true)
+	telemClient.On("TelemBatch", mock.Anything, mock.Anything).Return(server.TelemBatch)
+
+	sendInterval := time.Millisecond * 5
+	telemIngressClient := synchronization.NewTestTelemetryIngressBatchClientWithSpool(t, &url.URL{}, "33333333333", csaKeystore,
+		telemClient, sendInterval, t.TempDir())
+	servicetest.Run(t, telemIngressClient)
+
+	testCtx := testutils.Context(t)
+	for i := 0; i < 5; i++ {
+		telemIngressClient.Send(testCtx, []byte(strconv.Itoa(i)), "0x1", synchronization.OCR)
+		time.Sleep(sendInterval * 2)
+	}
+	assert.Empty(t, server.Received())
+
+	server.down.Store(false)
+	telemIngressClient.Send(testCtx, []byte("5"), "0x1", synchronization.OCR)
+
+	g.Eventually(server.Received).Should(gomega.Equal([]string{"0", "1", "2", "3", "4", "5"}))
+}
+
+func TestTelemetryIngressBatchClient_SpoolSurvivesRestart(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	telemClient := mocks.NewTelemClient(t)
+	csaKeystore := ksmocks.NewCSA(t)
+	csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+
+	server := &spoolTestServer{}
+	server.down.Store(true)
+	telemClient.On("TelemBatch", mock.Anything, mock.Anything).Return(server.TelemBatch)
+
+	spoolDir := t.TempDir()
+	// a long send interval keeps the telemetry buffered until the client is closed
+	telemIngressClient := synchronization.NewTestTelemetryIngressBatchClientWithSpool(t, &url.URL{}, "33333333333", csaKeystore,
+		telemClient, time.Hour, spoolDir)
+	testCtx := testutils.Context(t)
+	require.NoError(t, telemIngressClient.Start(testCtx))
+	for i := 0; i < 3; i++ {
+		telemIngressClient.Send(testCtx, []byte(strconv.Itoa(i)), "0x1", synchronization.OCR)
+	}
+	require.NoError(t, telemIngressClient.Close())
+
+	server.down.Store(false)
+	telemIngressClient = synchronization.NewTestTelemetryIngressBatchClientWithSpool(t, &url.URL{}, "33333333333", csaKeystore,
+		telemClient, time.Millisecond*5, spoolDir)
+	servicetest.Run(t, telemIngressClient)
+
+	g.Eventually(server.Received).Should(gomega.Equal([]string{"0", "1", "2"}))
+}
+
+func TestTelemetryIngressBatchClient_SpoolIsNotOvertakenByLiveTelemetry(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	telemClient := mocks.NewTelemClient(t)
+	csaKeystore := ksmocks.NewCSA(t)
+	csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+
+	server := &spoolTestServer{}
+	server.down.Store(true)
+	sendInterval := time.Millisecond * 5
+	// slow sends leave live telemetry time to arrive while the spool is replayed
+	telemClient.On("TelemBatch", mock.Anything, mock.Anything).Return(func(ctx context.Context, req *telemPb.TelemBatchRequest) (*telemPb.TelemResponse, error) {
+		time.Sleep(sendInterval * 2)
+		return server.TelemBatch(ctx, req)
+	})
+
+	telemIngressClient := synchronization.NewTestTelemetryIngressBatchClientWithSpool(t, &url.URL{}, "33333333333", csaKeystore,
+		telemClient, sendInterval, t.TempDir())
+	servicetest.Run(t, telemIngressClient)
+
+	testCtx := testutils.Context(t)
+	var expected []string
+	for i := 0; i < 5; i++ {
+		expected = append(expected, strconv.Itoa(i))
+		telemIngressClient.Send(testCtx, []byte(strconv.Itoa(i)), "0x1", synchronization.OCR)
+		time.Sleep(sendInterval * 3)
+	}
+	assert.Empty(t, server.Received())
+
+	server.down.Store(false)
+	for i := 5; i < 15; i++ {
+		expected = append(expected, strconv.Itoa(i))
+		telemIngressClient.Send(testCtx, []byte(strconv.Itoa(i)), "0x1", synchronization.OCR)
+		time.Sleep(sendInterval)
+	}
+
+	g.Eventually(server.Received).Should(gomega.Equal(expected))
+}
@@ -2,6 +2,7 @@ package synchronization
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -25,6 +26,10 @@ type telemetryIngressBatchWorker struct {
 	logging           bool
 	lggr              logger.Logger
 	dropMessageCount  atomic.Uint32
+	// spool persists batches which could not be sent, nil if disabled
+	spool *telemetrySpool
+	// replayMu is held for reading while sending, to not overtake a batch being replayed from the spool
+	replayMu *sync.RWMutex
 
 	// endpointURL is used for reporting metrics
 	endpointURL string
@@ -64,6 +69,15 @@ func (tw *telemetryIngressBatchWorker) Send(ctx context.Context) {
 
 	// Send batched telemetry to the ingress server, log any errors
 	telemBatchReq := tw.BuildTelemBatchReq()
+	if tw.spool != nil {
+		tw.replayMu.RLock()
+		defer tw.replayMu.RUnlock()
+		if tw.spool.Len() > 0 {
+			// queue behind the spooled telemetry to keep it in order
+			spoolTelemetry(tw.spool, telemBatchReq, tw.endpointURL, tw.lggr)
+			return
+		}
+	}
 	ctx, cancel := context.WithTimeout(ctx, tw.telemSendTimeout)
 	_, err := tw.telemClient.TelemBatch(ctx, telemBatchReq)
 	cancel()
@@ -71,6 +85,9 @@ func (tw *telemetryIngressBatchWorker) Send(ctx context.Context) {
 	if err != nil {
 		tw.lggr.Warnf("Could not send telemetry: %v", err)
 		TelemetryClientMessagesSendErrors.WithLabelValues(tw.endpointURL, string(tw.telemType)).Inc()
+		if tw.spool != nil {
+			spoolTelemetry(tw.spool, telemBatchReq, tw.endpointURL, tw.lggr)
+		}
 		return
 	}
 	TelemetryClientMessagesSent.WithLabelValues(tw.endpointURL, string(tw.telemType)).Inc()
@@ -117,3 +134,22 @@ func (tw *telemetryIngressBatchWorker) BuildTelemBatchReq() *telemPb.TelemBatchR
 		SentAt:        time.Now().UnixNano(),
 	}
 }
+
+// spoolTelemetry persists a batch which could not be sent, to be replayed by the client once the ingress
+// server is reachable.
+func spoolTelemetry(spool *telemetrySpool, req *telemPb.TelemBatchRequest, endpointURL string, lggr logger.Logger) {
+	if len(req.Telemetry) == 0 {
+		return
+	}
+	dropped, err := spool.Append(req)
+	if dropped > 0 {
+		lggr.Warnw("telemetry spool full, dropped oldest telemetry", "droppedCount", dropped)
+		TelemetryClientMessagesSpoolDropped.WithLabelValues(endpointURL).Add(float64(dropped))
+	}
+	if err != nil {
+		lggr.Warnw("Could not spool telemetry, dropping it", "err", err, "contractID", req.ContractId, "telemType", req.TelemetryType)
+		TelemetryClientMessagesSpoolDropped.WithLabelValues(endpointURL).Add(float64(len(req.Telemetry)))
+		return
+	}
+	TelemetryClientMessagesSpooled.WithLabelValues(endpointURL, req.TelemetryType).Add(float64(len(req.Telemetry)))
+}
@@ -0,0 +1,382 @@
+package synchronization
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+)
+
+const (
+	spoolSegmentExt = ".spool"
+	spoolCursorFile = "cursor"
+	// spoolSegments is the number of segments a full spool is split into, the oldest one is dropped
+	// when the spool is full.
+	spoolSegments       = 8
+	spoolMinSegmentSize = 64 * 1024
+	// spoolRecordHeaderLen is the length and the CRC-32 of each record
+	spoolRecordHeaderLen = 8
+)
+
+var (
+	errSpoolClosed         = errors.New("telemetry spool is closed")
+	errSpoolRecordTooLarge = errors.New("telemetry batch is larger than the spool")
+)
+
+// spoolSegment is a file of length-prefixed, checksummed telemetry batch requests, in order.
+type spoolSegment struct {
+	seq  uint64
+	size int64
+	// messages counts the telemetry messages of the segment which were not replayed yet
+	messages int
+}
+
+// telemetrySpool persists telemetry batches in segment files of a directory, so that they can be
+// replayed in order after the ingress server is reachable again, including after a restart.
+// The oldest segment is dropped when the spool exceeds its maximum size.
+//
+// Segments are appended to until they reach the segment size. Replayed records are tracked by a
+// cursor file, written after each replayed record, so records are replayed at most once unless
+// the node stops between sending a record and writing the cursor.
+type telemetrySpool struct {
+	dir         string
+	maxSize     int64
+	segmentSize int64
+
+	mu       sync.Mutex
+	segments []*spoolSegment
+	size     int64
+	// writer appends to the last segment, nil when a new segment must be started
+	writer *os.File
+	// head holds the unreplayed records of the first segment, read once it is replayed
+	head       [][]byte
+	headOffset int64
+	headLoaded bool
+	closed     bool
+}
+
+func newTelemetrySpool(dir string, maxSize int64) (*telemetrySpool, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create telemetry spool directory: %w", err)
+	}
+	s := &telemetrySpool{
+		dir:         dir,
+		maxSize:     maxSize,
+		segmentSize: max(maxSize/spoolSegments, spoolMinSegmentSize),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// load reads the segments left by a previous run, skipping records which were already replayed.
+func (s *telemetrySpool) load() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), spoolSegmentExt)
+		if !ok {
+			continue
+		}
+		seq, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		s.segments = append(s.segments, &spoolSegment{seq: seq})
+	}
+	slices.SortFunc(s.segments, func(a, b *spoolSegment) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	cursorSeq, cursorOffset := s.readCursor()
+	var kept []*spoolSegment
+	for _, segment := range s.segments {
+		if segment.seq < cursorSeq {
+			// replayed, but not deleted before the node stopped
+			_ = os.Remove(s.segmentPath(segment.seq))
+			continue
+		}
+		offset := int64(0)
+		if segment.seq == cursorSeq {
+			offset = cursorOffset
+		}
+		records, size, err := readSpoolRecords(s.segmentPath(segment.seq), offset)
+		if err != nil {
+			return err
+		}
+		segment.size = size
+		for _, record := range records {
+			segment.messages += countSpoolMessages(record)
+		}
+		s.size += size
+		kept = append(kept, segment)
+	}
+	s.segments = kept
+	if len(s.segments) > 0 && s.segments[0].seq == cursorSeq {
+		s.headOffset = cursorOffset
+	}
+	return nil
+}
+
+// Append adds a batch to the end of the spool. Returns the number of older telemetry messages dropped to
+// stay within the maximum size.
+func (s *telemetrySpool) Append(req *telemPb.TelemBatchRequest) (dropped int, err error) {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return 0, err
+	}
+	record := encodeSpoolRecord(data)
+	recordSize := int64(len(record))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return 0, errSpoolClosed
+	}
+	if recordSize > s.maxSize {
+		return 0, errSpoolRecordTooLarge
+	}
+	for s.size+recordSize > s.maxSize && len(s.segments) > 0 {
+		dropped += s.dropOldest()
+	}
+	if s.writer == nil || s.segments[len(s.segments)-1].size+recordSize > s.segmentSize {
+		if err = s.startSegment(); err != nil {
+			return dropped, err
+		}
+	}
+	if _, err = s.writer.Write(record); err != nil {
+		// records after a partial write can't be read, continue in a new segment
+		_ = s.writer.Close()
+		s.writer = nil
+		return dropped, err
+	}
+	last := s.segments[len(s.segments)-1]
+	last.size += recordSize
+	last.messages += len(req.Telemetry)
+	s.size += recordSize
+	return dropped, nil
+}
+
+// Len returns the number of telemetry messages waiting to be replayed.
+func (s *telemetrySpool) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var messages int
+	for _, segment := range s.segments {
+		messages += segment.messages
+	}
+	return messages
+}
+
+// Peek returns the oldest batch, or nil if the spool is empty.
+func (s *telemetrySpool) Peek() (*telemPb.TelemBatchRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		if s.closed {
+			return nil, errSpoolClosed
+		}
+		if len(s.segments) == 0 {
+			return nil, nil
+		}
+		if err := s.loadHead(); err != nil {
+			return nil, err
+		}
+		if len(s.head) == 0 {
+			// all records were replayed, or the rest of the segment is corrupted
+			s.removeHead()
+			continue
+		}
+		req := new(telemPb.TelemBatchRequest)
+		if err := proto.Unmarshal(s.head[0], req); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+}
+
+// Pop removes the batch returned by Peek.
+func (s *telemetrySpool) Pop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return errSpoolClosed
+	}
+	if !s.headLoaded || len(s.head) == 0 {
+		return nil
+	}
+	record := s.head[0]
+	s.head = s.head[1:]
+	s.headOffset += int64(spoolRecordHeaderLen + len(record))
+	head := s.segments[0]
+	head.messages -= countSpoolMessages(record)
+	if len(s.head) == 0 {
+		s.removeHead()
+		return nil
+	}
+	return s.writeCursor(head.seq, s.headOffset)
+}
+
+func (s *telemetrySpool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	if s.writer != nil {
+		return s.writer.Close()
+	}
+	return nil
+}
+
+// loadHead reads the records of the first segment. New records are appended to another segment from now on.
+func (s *telemetrySpool) loadHead() error {
+	if s.headLoaded {
+		return nil
+	}
+	head := s.segments[0]
+	if len(s.segments) == 1 && s.writer != nil {
+		if err := s.writer.Close(); err != nil {
+			return err
+		}
+		s.writer = nil
+	}
+	records, _, err := readSpoolRecords(s.segmentPath(head.seq), s.headOffset)
+	if err != nil {
+		return err
+	}
+	s.head = records
+	s.headLoaded = true
+	return nil
+}
+
+// removeHead deletes the first segment and moves the cursor to the next one.
+func (s *telemetrySpool) removeHead() {
+	head := s.segments[0]
+	if len(s.segments) == 1 && s.writer != nil {
+		_ = s.writer.Close()
+		s.writer = nil
+	}
+	_ = os.Remove(s.segmentPath(head.seq))
+	s.size -= head.size
+	s.segments = s.segments[1:]
+	s.head = nil
+	s.headOffset = 0
+	s.headLoaded = false
+	_ = s.writeCursor(head.seq+1, 0)
+}
+
+// dropOldest removes the first segment and returns the number of telemetry messages dropped.
+func (s *telemetrySpool) dropOldest() int {
+	dropped := s.segments[0].messages
+	s.removeHead()
+	return dropped
+}
+
+func (s *telemetrySpool) startSegment() error {
+	if s.writer != nil {
+		if err := s.writer.Close(); err != nil {
+			return err
+		}
+		s.writer = nil
+	}
+	seq := s.readCursorSeq()
+	if len(s.segments) > 0 {
+		seq = s.segments[len(s.segments)-1].seq + 1
+	}
+	f, err := os.OpenFile(s.segmentPath(seq), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	s.writer = f
+	s.segments = append(s.segments, &spoolSegment{seq: seq})
+	return nil
+}
+
+func (s *telemetrySpool) segmentPath(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, spoolSegmentExt))
+}
+
+// readCursorSeq returns the sequence number of the next segment to replay.
+func (s *telemetrySpool) readCursorSeq() uint64 {
+	seq, _ := s.readCursor()
+	return seq
+}
+
+func (s *telemetrySpool) readCursor() (seq uint64, offset int64) {
+	data, err := os.ReadFile(filepath.Join(s.dir, spoolCursorFile))
+	if err != nil || len(data) != 16 {
+		return 0, 0
+	}
+	offset = int64(binary.BigEndian.Uint64(data[8:])) //nolint:gosec // written by writeCursor
+	return binary.BigEndian.Uint64(data[:8]), offset
+}
+
+func (s *telemetrySpool) writeCursor(seq uint64, offset int64) error {
+	data := make([]byte, 16)
+	binary.BigEndian.PutUint64(data[:8], seq)
+	binary.BigEndian.PutUint64(data[8:], uint64(offset)) //nolint:gosec // offsets are not negative
+	return os.WriteFile(filepath.Join(s.dir, spoolCursorFile), data, 0o600)
+}
+
+func encodeSpoolRecord(data []byte) []byte {
+	record := make([]byte, spoolRecordHeaderLen+len(data))
+	binary.BigEndian.PutUint32(record[:4], uint32(len(data))) //nolint:gosec // records are smaller than the spool
+	binary.BigEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(data))
+	copy(record[spoolRecordHeaderLen:], data)
+	return record
+}
+
+// readSpoolRecords reads the records of a segment after offset, and returns them with the size of the file.
+// Reading stops at the first incomplete or corrupted record, e.g. written while the node stopped.
+func readSpoolRecords(path string, offset int64) (records [][]byte, size int64, err error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	size = int64(len(data))
+	if offset > size {
+		return nil, size, nil
+	}
+	rest := data[offset:]
+	for len(rest) >= spoolRecordHeaderLen {
+		length := int(binary.BigEndian.Uint32(rest[:4]))
+		checksum := binary.BigEndian.Uint32(rest[4:8])
+		if len(rest) < spoolRecordHeaderLen+length {
+			break
+		}
+		record := rest[spoolRecordHeaderLen : spoolRecordHeaderLen+length]
+		if crc32.ChecksumIEEE(record) != checksum {
+			break
+		}
+		records = append(records, record)
+		rest = rest[spoolRecordHeaderLen+length:]
+	}
+	return records, size, nil
+}
+
+func countSpoolMessages(record []byte) int {
+	req := new(telemPb.TelemBatchRequest)
+	if err := proto.Unmarshal(record, req); err != nil {
+		return 0
+	}
+	return len(req.Telemetry)
+}
@@ -0,0 +1,117 @@
+package synchronization
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	telemPb "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+)
+
+func newSpoolBatch(telem ...string) *telemPb.TelemBatchRequest {
+	req := &telemPb.TelemBatchRequest{ContractId: "0x1", TelemetryType: string(OCR)}
+	for _, t := range telem {
+		req.Telemetry = append(req.Telemetry, []byte(t))
+	}
+	return req
+}
+
+func popSpoolBatch(t *testing.T, spool *telemetrySpool) []string {
+	req, err := spool.Peek()
+	require.NoError(t, err)
+	require.NotNil(t, req)
+	require.NoError(t, spool.Pop())
+	var telem []string
+	for _, b := range req.Telemetry {
+		telem = append(telem, string(b))
+	}
+	return telem
+}
+
+func TestTelemetrySpool_ReplaysInOrder(t *testing.T) {
+	spool, err := newTelemetrySpool(t.TempDir(), 1<<20)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, spool.Close()) })
+
+	req, err := spool.Peek()
+	require.NoError(t, err)
+	require.Nil(t, req)
+
+	for _, batch := range [][]string{{"a", "b"}, {"c"}} {
+		dropped, err2 := spool.Append(newSpoolBatch(batch...))
+		require.NoError(t, err2)
+		require.Zero(t, dropped)
+	}
+	require.Equal(t, 3, spool.Len())
+
+	require.Equal(t, []string{"a", "b"}, popSpoolBatch(t, spool))
+	// batches appended while replaying are replayed after the others
+	_, err = spool.Append(newSpoolBatch("d"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"c"}, popSpoolBatch(t, spool))
+	require.Equal(t, []string{"d"}, popSpoolBatch(t, spool))
+	require.Zero(t, spool.Len())
+
+	req, err = spool.Peek()
+	require.NoError(t, err)
+	require.Nil(t, req)
+}
+
+func TestTelemetrySpool_Reopen(t *testing.T) {
+	dir := t.TempDir()
+	spool, err := newTelemetrySpool(dir, 1<<20)
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		_, err = spool.Append(newSpoolBatch(strconv.Itoa(i)))
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"0"}, popSpoolBatch(t, spool))
+	require.NoError(t, spool.Close())
+
+	// a record interrupted by a crash is ignored
+	segments, err := filepath.Glob(filepath.Join(dir, "*"+spoolSegmentExt))
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	f, err := os.OpenFile(segments[0], os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 0, 10, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	spool, err = newTelemetrySpool(dir, 1<<20)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, spool.Close()) })
+	require.Equal(t, 3, spool.Len())
+	_, err = spool.Append(newSpoolBatch("4"))
+	require.NoError(t, err)
+	for i := 1; i <= 4; i++ {
+		require.Equal(t, []string{strconv.Itoa(i)}, popSpoolBatch(t, spool))
+	}
+	require.Zero(t, spool.Len())
+}
+
+func TestTelemetrySpool_MaxSize(t *testing.T) {
+	// segments of the minimum size, a quarter of the spool
+	spool, err := newTelemetrySpool(t.TempDir(), 4*spoolMinSegmentSize)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, spool.Close()) })
+
+	// batches of a bit more than a quarter of a segment, 3 fit in a segment and 15 in the spool
+	payload := string(make([]byte, spoolMinSegmentSize/4))
+	var dropped int
+	for i := 0; i < 18; i++ {
+		n, err2 := spool.Append(newSpoolBatch(payload, strconv.Itoa(i)))
+		require.NoError(t, err2)
+		dropped += n
+	}
+	// the oldest segment was dropped
+	require.Equal(t, 6, dropped)
+	require.Equal(t, 30, spool.Len())
+	require.Equal(t, []string{payload, "3"}, popSpoolBatch(t, spool))
+
+	_, err = spool.Append(newSpoolBatch(string(make([]byte, 4*spoolMinSegmentSize))))
+	require.ErrorIs(t, err, errSpoolRecordTooLarge)
+}
@@ -2,6 +2,7 @@ package telemetry
 
 import (
 	"net/url"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -110,7 +111,12 @@ func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Log
 	lggr = logger.Sugared(lggr).Named(e.Network()).Named(e.ChainID())
 	var tClient synchronization.TelemetryService
 	if m.useBatchSend {
-		tClient = synchronization.NewTelemetryIngressBatchClient(e.URL(), e.ServerPubKey(), m.ks, cfg.Logging(), lggr, cfg.BufferSize(), cfg.MaxBatchSize(), cfg.SendInterval(), cfg.SendTimeout(), cfg.UniConn())
+		var spoolDir string
+		if cfg.SpoolDir() != "" {
+			// endpoints have their own spool
+			spoolDir = filepath.Join(cfg.SpoolDir(), strings.ToLower(e.Network())+"_"+strings.ToLower(e.ChainID()))
+		}
+		tClient = synchronization.NewTelemetryIngressBatchClient(e.URL(), e.ServerPubKey(), m.ks, cfg.Logging(), lggr, cfg.BufferSize(), cfg.MaxBatchSize(), cfg.SendInterval(), cfg.SendTimeout(), cfg.UniConn(), spoolDir, cfg.SpoolMaxSize())
 	} else {
 		tClient = synchronization.NewTelemetryIngressClient(e.URL(), e.ServerPubKey(), m.ks, cfg.Logging(), lggr, cfg.BufferSize())
 	}
@@ -22,6 +22,7 @@ type Delegate struct {
 	secretsFetcher secretsFetcher
 	logger         logger.Logger
 	store          store.Store
+	engines        *EngineRegistry
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -70,6 +71,8 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		Config:         config,
 		Binary:         binary,
 		SecretsFetcher: d.secretsFetcher,
+		Paused:         spec.WorkflowSpec.Paused,
+		EngineRegistry: d.engines,
 	}
 	engine, err := NewEngine(ctx, cfg)
 	if err != nil {
@@ -84,8 +87,9 @@ func NewDelegate(
 	registry core.CapabilitiesRegistry,
 	secretsFetcher secretsFetcher,
 	store store.Store,
+	engines *EngineRegistry,
 ) *Delegate {
-	return &Delegate{logger: logger, registry: registry, secretsFetcher: secretsFetcher, store: store}
+	return &Delegate{logger: logger, registry: registry, secretsFetcher: secretsFetcher, store: store, engines: engines}
 }
 
 func ValidatedWorkflowJobSpec(ctx context.Context, tomlString string) (job.Job, error) {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jonboulle/clockwork"
@@ -116,6 +117,12 @@ type Engine struct {
 	maxExecutionDuration time.Duration
 	heartbeatCadence     time.Duration
 	stepTimeoutDuration  time.Duration
+	engines              *EngineRegistry
+	initialized          atomic.Bool
+
+	// pauseMu guards resumedCh, which is set while the workflow is paused and closed when it is resumed.
+	pauseMu   sync.Mutex
+	resumedCh chan struct{}
 
 	// testing lifecycle hook to signal when an execution is finished.
 	onExecutionFinished func(string)
@@ -155,6 +162,7 @@ func (e *Engine) Start(_ context.Context) error {
 		e.wg.Add(1)
 		go e.heartbeat(ctx)
 
+		e.engines.add(e)
 		return nil
 	})
 }
@@ -352,6 +360,7 @@ func (e *Engine) init(ctx context.Context) {
 		}
 	}
 
+	e.initialized.Store(true)
 	e.logger.Info("engine initialized")
 	logCustMsg(ctx, e.cma, "workflow registered", e.logger)
 	e.afterInit(true)
@@ -475,6 +484,11 @@ func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, trig
 					return
 				}
 
+				// While the workflow is paused, the event is held back and no further events are consumed.
+				if !e.waitUntilResumed() {
+					return
+				}
+
 				select {
 				case <-e.stopCh:
 					return
@@ -766,6 +780,16 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 		Ref:         msg.stepRef,
 	}
 
+	// Persist the step as started, so that its created_at records when it began executing.
+	_, err := e.executionStates.UpsertStep(ctx, &store.WorkflowExecutionStep{
+		ExecutionID: msg.state.ExecutionID,
+		Ref:         msg.stepRef,
+		Status:      store.StatusStarted,
+	})
+	if err != nil {
+		l.Errorf("failed to persist the start of the step; error %v", err)
+	}
+
 	// TODO ks-462 inputs
 	logCustMsg(ctx, cma, "executing step", l)
 
@@ -972,8 +996,8 @@ func (e *Engine) isWorkflowFullyProcessed(ctx context.Context, state store.Workf
 	// we need to first propagate the status of the errored status if it exists...
 	err := e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
 		stateStep, ok := state.Steps[s.Ref]
-		if !ok {
-			// The step not existing on the state means that it has not been processed yet.
+		if !ok || stateStep.Status == store.StatusStarted {
+			// The step not existing on the state, or still executing, means that it has not been processed yet.
 			// So ignore it.
 			return nil
 		}
@@ -1074,9 +1098,173 @@ func (e *Engine) heartbeat(ctx context.Context) {
 	}
 }
 
+// Pause stops the engine from starting executions on trigger events. The triggers remain registered, their events
+// are consumed again once the workflow is resumed. Executions in progress run to completion and failed executions
+// can still be re-executed. The workflow remains paused across restarts.
+func (e *Engine) Pause(ctx context.Context) error {
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+	if e.resumedCh != nil {
+		return nil
+	}
+	if err := e.executionStates.SetPaused(ctx, e.workflow.id, true); err != nil {
+		return fmt.Errorf("failed to pause workflow: %w", err)
+	}
+	e.resumedCh = make(chan struct{})
+	e.logger.Info("workflow paused")
+	logCustMsg(ctx, e.cma, "workflow paused", e.logger)
+	return nil
+}
+
+// Resume resumes a paused workflow.
+func (e *Engine) Resume(ctx context.Context) error {
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+	if e.resumedCh == nil {
+		return nil
+	}
+	if err := e.executionStates.SetPaused(ctx, e.workflow.id, false); err != nil {
+		return fmt.Errorf("failed to resume workflow: %w", err)
+	}
+	close(e.resumedCh)
+	e.resumedCh = nil
+	e.logger.Info("workflow resumed")
+	logCustMsg(ctx, e.cma, "workflow resumed", e.logger)
+	return nil
+}
+
+// Paused returns true if the workflow is paused.
+func (e *Engine) Paused() bool {
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+	return e.resumedCh != nil
+}
+
+// waitUntilResumed blocks while the workflow is paused. It returns false if the engine is stopped meanwhile.
+func (e *Engine) waitUntilResumed() bool {
+	for {
+		e.pauseMu.Lock()
+		resumedCh := e.resumedCh
+		e.pauseMu.Unlock()
+		if resumedCh == nil {
+			return true
+		}
+		select {
+		case <-e.stopCh:
+			return false
+		case <-resumedCh:
+		}
+	}
+}
+
+// ReExecute starts a new execution of an errored or timed out execution, from the given step. The outputs of the
+// steps which do not depend on fromStep are reused from the failed execution, fromStep and its dependents are
+// executed again, along with any other step which had not completed. The failed execution is left as is.
+func (e *Engine) ReExecute(ctx context.Context, executionID string, fromStep string) (string, error) {
+	if !e.initialized.Load() {
+		return "", fmt.Errorf("%w: workflow engine is not initialized", ErrCannotReExecute)
+	}
+
+	prev, err := e.executionStates.Get(ctx, executionID)
+	if err != nil {
+		return "", err
+	}
+	if prev.WorkflowID != e.workflow.id {
+		return "", fmt.Errorf("%w: execution %s does not belong to workflow %s", ErrCannotReExecute, executionID, e.workflow.id)
+	}
+	if prev.Status != store.StatusErrored && prev.Status != store.StatusTimeout {
+		return "", fmt.Errorf("%w: only errored or timed out executions can be re-executed, execution %s is %s", ErrCannotReExecute, executionID, prev.Status)
+	}
+	if fromStep == workflows.KeywordTrigger {
+		return "", fmt.Errorf("%w: the trigger event is reused, choose a step after the trigger", ErrCannotReExecute)
+	}
+	if _, err = e.workflow.Vertex(fromStep); err != nil {
+		return "", fmt.Errorf("%w: unknown step %q", ErrCannotReExecute, fromStep)
+	}
+
+	rerun := map[string]bool{}
+	err = e.workflow.walkDo(fromStep, func(s *step) error {
+		rerun[s.Ref] = true
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	newExecutionID, err := generateExecutionID(e.workflow.id, fmt.Sprintf("%s/%s/%d", executionID, fromStep, e.clock.Now().UnixNano()))
+	if err != nil {
+		return "", err
+	}
+	lggr := e.logger.With(platform.KeyWorkflowExecutionID, newExecutionID, "sourceExecutionID", executionID, platform.KeyStepRef, fromStep)
+
+	ec := &store.WorkflowExecution{
+		Steps:       map[string]*store.WorkflowExecutionStep{},
+		WorkflowID:  e.workflow.id,
+		ExecutionID: newExecutionID,
+		Status:      store.StatusStarted,
+	}
+	for ref, s := range prev.Steps {
+		if rerun[ref] || s.Status != store.StatusCompleted {
+			continue
+		}
+		ec.Steps[ref] = &store.WorkflowExecutionStep{
+			ExecutionID: newExecutionID,
+			Ref:         ref,
+			Status:      store.StatusCompleted,
+			Inputs:      s.Inputs,
+			Outputs:     s.Outputs,
+		}
+	}
+	if _, ok := ec.Steps[workflows.KeywordTrigger]; !ok {
+		return "", fmt.Errorf("%w: execution %s has no trigger event", ErrCannotReExecute, executionID)
+	}
+
+	dbWex, err := e.executionStates.Add(ctx, ec)
+	if err != nil {
+		return "", err
+	}
+
+	ch := make(chan store.WorkflowExecutionStep)
+	e.stepUpdatesChMap.add(newExecutionID, stepUpdateChannel{
+		ch:          ch,
+		executionID: newExecutionID,
+	})
+	// The step update loop must outlive the request which triggered the re-execution. It is only started while the
+	// engine is running, so that it's either waited for by Close or not started at all.
+	started := e.IfStarted(func() {
+		e.wg.Add(1)
+		go func() {
+			runCtx, cancel := e.stopCh.NewCtx()
+			defer cancel()
+			e.stepUpdateLoop(runCtx, newExecutionID, ch, dbWex.CreatedAt)
+		}()
+	})
+	if !started {
+		e.stepUpdatesChMap.remove(newExecutionID)
+		return "", fmt.Errorf("%w: workflow engine is not running", ErrCannotReExecute)
+	}
+
+	// Enqueue the steps which were not reused and which dependencies have all completed,
+	// the remaining steps are enqueued as their dependencies complete.
+	err = e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		if _, reused := ec.Steps[s.Ref]; !reused {
+			e.queueIfReady(*ec, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	lggr.Info("re-executing failed execution")
+	logCustMsg(ctx, e.cma.With(platform.KeyWorkflowExecutionID, newExecutionID), fmt.Sprintf("re-executing execution %s from step %s", executionID, fromStep), lggr)
+	return newExecutionID, nil
+}
+
 func (e *Engine) Close() error {
 	return e.StopOnce("Engine", func() error {
 		e.logger.Info("shutting down engine")
+		e.engines.remove(e)
 		ctx := context.Background()
 		// To shut down the engine, we'll start by deregistering
 		// any triggers to ensure no new executions are triggered,
@@ -1156,6 +1344,10 @@ type Config struct {
 	SecretsFetcher       secretsFetcher
 	HeartbeatCadence     time.Duration
 	StepTimeout          time.Duration
+	// Paused starts the engine paused, see Engine.Pause.
+	Paused bool
+	// EngineRegistry, if set, holds the engine while it is running.
+	EngineRegistry *EngineRegistry
 
 	// For testing purposes only
 	maxRetries          int
@@ -1268,6 +1460,10 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 		retryMs:              cfg.retryMs,
 		maxWorkerLimit:       cfg.MaxWorkerLimit,
 		clock:                cfg.clock,
+		engines:              cfg.EngineRegistry,
+	}
+	if cfg.Paused {
+		engine.resumedCh = make(chan struct{})
 	}
 
 	return engine, nil
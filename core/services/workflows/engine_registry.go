@@ -0,0 +1,55 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrEngineNotFound  = errors.New("workflow engine not found")
+	ErrCannotReExecute = errors.New("cannot re-execute workflow execution")
+)
+
+// EngineRegistry holds the workflow engines running on this node, by workflow ID, so that operators can control them.
+type EngineRegistry struct {
+	mu      sync.RWMutex
+	engines map[string]*Engine
+}
+
+func NewEngineRegistry() *EngineRegistry {
+	return &EngineRegistry{engines: map[string]*Engine{}}
+}
+
+// add registers a running engine. It is a no-op on a nil registry.
+func (r *EngineRegistry) add(e *Engine) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.engines[e.workflow.id] = e
+}
+
+// remove unregisters the engine, unless it was replaced by another engine of the same workflow.
+func (r *EngineRegistry) remove(e *Engine) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.engines[e.workflow.id] == e {
+		delete(r.engines, e.workflow.id)
+	}
+}
+
+// Get returns the engine running the workflow.
+func (r *EngineRegistry) Get(workflowID string) (*Engine, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.engines[workflowID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEngineNotFound, workflowID)
+	}
+	return e, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -494,6 +495,38 @@ func TestEngine_ErrorsTheWorkflowIfAStepErrors(t *testing.T) {
 	assert.Equal(t, state.Steps["evm_median"].Status, store.StatusErrored)
 }
 
+func TestEngine_PersistsStepStartTime(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+	clock := clockwork.NewFakeClock()
+
+	trigger, _ := mockTrigger(t)
+	consensus := mockConsensus("")
+	transform := consensus.transform
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		clock.Advance(time.Minute)
+		return transform(req)
+	}
+
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) { c.clock = clock })
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	step := state.Steps["evm_median"]
+	require.NotNil(t, step.CreatedAt)
+	require.NotNil(t, step.UpdatedAt)
+	assert.Equal(t, time.Minute, step.UpdatedAt.Sub(*step.CreatedAt))
+}
+
 func TestEngine_GracefulEarlyTermination(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -1670,3 +1703,95 @@ func TestEngine_FetchesSecrets(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, gotConfig, expm)
 }
+
+func TestEngine_PauseAndResume(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	engines := NewEngineRegistry()
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.Paused = true
+		c.EngineRegistry = engines
+	})
+	servicetest.Run(t, eng)
+
+	registered, err := engines.Get(testWorkflowId)
+	require.NoError(t, err)
+	assert.Equal(t, eng, registered)
+
+	<-hooks.initSuccessful
+	assert.True(t, eng.Paused())
+	// the trigger event is held back while the workflow is paused
+	select {
+	case eid := <-hooks.executionFinished:
+		t.Fatalf("unexpected execution %s while paused", eid)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	require.NoError(t, eng.Resume(ctx))
+	assert.False(t, eng.Paused())
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+
+	require.NoError(t, eng.Pause(ctx))
+	assert.True(t, eng.Paused())
+}
+
+func TestEngine_ReExecute(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	target := mockTarget("")
+	var targetFailed atomic.Bool
+	transform := target.transform
+	target.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		if targetFailed.CompareAndSwap(false, true) {
+			return capabilities.CapabilityResponse{}, errors.New("fatal target error")
+		}
+		return transform(req)
+	}
+
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	require.NoError(t, reg.Add(ctx, target))
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	failed, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	require.Equal(t, store.StatusErrored, failed.Status)
+
+	_, err = eng.ReExecute(ctx, eid, workflows.KeywordTrigger)
+	require.ErrorIs(t, err, ErrCannotReExecute)
+	_, err = eng.ReExecute(ctx, eid, "unknown")
+	require.ErrorIs(t, err, ErrCannotReExecute)
+
+	targetRef := "write_polygon-testnet-mumbai@1.0.0"
+	newEid, err := eng.ReExecute(ctx, eid, targetRef)
+	require.NoError(t, err)
+	assert.NotEqual(t, eid, newEid)
+	assert.Equal(t, newEid, getExecutionId(t, eng, hooks))
+
+	state, err := eng.executionStates.Get(ctx, newEid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	// the consensus step is not executed again, its persisted outputs are reused
+	assert.Equal(t, failed.Steps["evm_median"].Outputs.Value, state.Steps["evm_median"].Outputs.Value)
+	assert.Len(t, target.response, 1)
+
+	_, err = eng.ReExecute(ctx, newEid, targetRef)
+	require.ErrorIs(t, err, ErrCannotReExecute)
+}
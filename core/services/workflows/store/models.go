@@ -38,6 +38,7 @@ type WorkflowExecutionStep struct {
 	Inputs  *values.Map
 	Outputs StepOutput
 
+	CreatedAt *time.Time
 	UpdatedAt *time.Time
 }
 
@@ -66,3 +67,11 @@ func (w WorkflowExecution) ResultForStep(s string) (*exec.Result, bool) {
 }
 
 var _ exec.Results = WorkflowExecution{}
+
+// ExecutionFilter restricts the workflow executions returned by Store.List, zero fields match any execution.
+type ExecutionFilter struct {
+	WorkflowID    string
+	Status        string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
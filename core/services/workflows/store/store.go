@@ -10,6 +10,10 @@ type Store interface {
 	UpdateStatus(ctx context.Context, executionID string, status string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
 	GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error)
+	// List returns the executions matching the filter, most recent first, along with the total count of matching executions.
+	List(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error)
+	// SetPaused records whether the workflow is paused, so that it remains paused across restarts.
+	SetPaused(ctx context.Context, workflowID string, paused bool) error
 }
 
 var _ Store = (*DBStore)(nil)
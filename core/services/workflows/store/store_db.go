@@ -2,8 +2,10 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
@@ -62,6 +65,7 @@ type workflowStepRow struct {
 	Inputs              []byte
 	OutputErr           *string    `db:"output_err"`
 	OutputValue         []byte     `db:"output_value"`
+	CreatedAt           *time.Time `db:"created_at"`
 	UpdatedAt           *time.Time `db:"updated_at"`
 }
 
@@ -74,6 +78,7 @@ type workflowExecutionWithStep struct {
 	WSInputs              []byte     `db:"ws_inputs"`
 	WSOutputErr           *string    `db:"ws_output_err"`
 	WSOutputValue         []byte     `db:"ws_output_value"`
+	WSCreatedAt           *time.Time `db:"ws_created_at"`
 	WSUpdatedAt           *time.Time `db:"ws_updated_at"`
 
 	// WorkflowExecution fields
@@ -156,7 +161,7 @@ func (d *DBStore) UpsertStep(ctx context.Context, stepState *WorkflowExecutionSt
 
 // Get fetches the ExecutionState from the database.
 func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecution, error) {
-	sql := `
+	query := `
     SELECT
 			workflow_executions.id AS we_id,
 			workflow_executions.workflow_id AS we_workflow_id,
@@ -170,13 +175,14 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 			workflow_steps.inputs AS ws_inputs,
 			workflow_steps.output_err AS ws_output_err,
 			workflow_steps.output_value AS ws_output_value,
+			workflow_steps.created_at AS ws_created_at,
 			workflow_steps.updated_at AS ws_updated_at
 	FROM workflow_executions JOIN workflow_steps
 	ON workflow_executions.id = workflow_steps.workflow_execution_id
 	WHERE workflow_executions.id = $1`
 
 	var records []workflowExecutionWithStep
-	err := d.db.SelectContext(ctx, &records, sql, executionID)
+	err := d.db.SelectContext(ctx, &records, query, executionID)
 	if err != nil {
 		return WorkflowExecution{}, err
 	}
@@ -186,7 +192,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 	}
 	state, ok := idToExecutionState[executionID]
 	if !ok {
-		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s", executionID)
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, sql.ErrNoRows)
 	}
 	return *state, nil
 }
@@ -217,6 +223,7 @@ func workflowExecutionsWithStepToWorkflowExecutions(wews []workflowExecutionWith
 			OutputValue:         jr.WSOutputValue,
 			Inputs:              jr.WSInputs,
 			Status:              jr.WSStatus,
+			CreatedAt:           jr.WSCreatedAt,
 			UpdatedAt:           jr.WSUpdatedAt,
 		})
 		if err != nil {
@@ -276,6 +283,8 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 			Err:   outputErr,
 			Value: outputs,
 		},
+		CreatedAt: step.CreatedAt,
+		UpdatedAt: step.UpdatedAt,
 	}, nil
 }
 
@@ -367,15 +376,17 @@ func (d *DBStore) Add(ctx context.Context, state *WorkflowExecution) (WorkflowEx
 }
 
 func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) error {
-	for _, s := range steps {
-		now := d.clock.Now()
-		s.UpdatedAt = &now
+	now := d.clock.Now()
+	for i := range steps {
+		steps[i].CreatedAt = &now
+		steps[i].UpdatedAt = &now
 	}
 
+	// created_at is left untouched on conflict, it records when the step was first persisted.
 	sql := `
 	INSERT INTO
-	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
-	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at)
+	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, created_at, updated_at)
+	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :created_at, :updated_at)
 	ON CONFLICT ON CONSTRAINT uniq_workflow_execution_id_ref
 	DO UPDATE SET
 		workflow_execution_id = EXCLUDED.workflow_execution_id,
@@ -427,6 +438,7 @@ func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset,
 		workflow_steps.inputs AS ws_inputs,
 		workflow_steps.output_err AS ws_output_err,
 		workflow_steps.output_value AS ws_output_value,
+		workflow_steps.created_at AS ws_created_at,
 		workflow_steps.updated_at AS ws_updated_at,
 		workflow_executions.id AS we_id,
 		workflow_executions.workflow_id AS we_workflow_id,
@@ -462,6 +474,98 @@ func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset,
 	return states, nil
 }
 
+// List returns the workflow executions matching the filter along with their steps, most recent first.
+// Unlike Get, executions which have no step persisted yet are returned too.
+func (d *DBStore) List(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	var (
+		where []string
+		args  []any
+	)
+	addCond := func(cond string, arg any) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.WorkflowID != "" {
+		addCond("workflow_id = $%d", filter.WorkflowID)
+	}
+	if filter.Status != "" {
+		addCond("status = $%d", filter.Status)
+	}
+	if filter.CreatedAfter != nil {
+		addCond("created_at >= $%d", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		addCond("created_at < $%d", *filter.CreatedBefore)
+	}
+	cond := ""
+	if len(where) > 0 {
+		cond = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	var count int
+	if err := d.db.GetContext(ctx, &count, "SELECT count(*) FROM workflow_executions "+cond, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count workflow executions: %w", err)
+	}
+
+	var rows []workflowExecutionRow
+	query := fmt.Sprintf(`SELECT * FROM workflow_executions %s ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`, cond, len(args)+1, len(args)+2)
+	if err := d.db.SelectContext(ctx, &rows, query, append(args, limit, offset)...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list workflow executions: %w", err)
+	}
+	if len(rows) == 0 {
+		return []WorkflowExecution{}, count, nil
+	}
+
+	ids := make([]string, len(rows))
+	executions := make([]WorkflowExecution, len(rows))
+	idToExecution := make(map[string]*WorkflowExecution, len(rows))
+	for i, r := range rows {
+		ids[i] = r.ID
+		executions[i] = WorkflowExecution{
+			ExecutionID: r.ID,
+			Status:      r.Status,
+			Steps:       map[string]*WorkflowExecutionStep{},
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   r.UpdatedAt,
+			FinishedAt:  r.FinishedAt,
+		}
+		if r.WorkflowID != nil {
+			executions[i].WorkflowID = *r.WorkflowID
+		}
+		idToExecution[r.ID] = &executions[i]
+	}
+
+	var steps []workflowStepRow
+	err := d.db.SelectContext(ctx, &steps, `SELECT * FROM workflow_steps WHERE workflow_execution_id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load workflow execution steps: %w", err)
+	}
+	for _, step := range steps {
+		state, err := stepToState(step)
+		if err != nil {
+			return nil, 0, err
+		}
+		idToExecution[step.WorkflowExecutionID].Steps[state.Ref] = state
+	}
+	return executions, count, nil
+}
+
+// SetPaused records whether the workflow is paused on its spec.
+func (d *DBStore) SetPaused(ctx context.Context, workflowID string, paused bool) error {
+	res, err := d.db.ExecContext(ctx, `UPDATE workflow_specs SET paused = $1, updated_at = $2 WHERE workflow_id = $3`, paused, d.clock.Now(), workflowID)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("could not find workflow with id %s: %w", workflowID, sql.ErrNoRows)
+	}
+	return nil
+}
+
 func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
 	return &DBStore{db: ds, lggr: lggr.Named("WorkflowDBStore"), clock: clock, chStop: make(chan struct{})}
 }
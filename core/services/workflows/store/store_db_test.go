@@ -2,9 +2,11 @@ package store
 
 import (
 	"crypto/rand"
+	"database/sql"
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -31,6 +33,14 @@ func random(length int) string {
 	return hex.EncodeToString(b)
 }
 
+// zeroStepTimestamps zeroes out the step timestamps, which are set by the db store.
+func zeroStepTimestamps(es WorkflowExecution) {
+	for _, step := range es.Steps {
+		step.CreatedAt = nil
+		step.UpdatedAt = nil
+	}
+}
+
 func newTestDBStore(t *testing.T) *DBStore {
 	db := pgtest.NewSqlxDB(t)
 	return &DBStore{db: db, lggr: logger.TestLogger(t), clock: clockwork.NewFakeClock()}
@@ -65,6 +75,11 @@ func Test_StoreDB(t *testing.T) {
 	// but is added by the db store.
 	gotEs.CreatedAt = nil
 	require.NoError(t, err)
+	for _, step := range gotEs.Steps {
+		assert.NotNil(t, step.CreatedAt)
+		assert.NotNil(t, step.UpdatedAt)
+	}
+	zeroStepTimestamps(gotEs)
 	assert.Equal(t, es, gotEs)
 }
 
@@ -166,6 +181,7 @@ func Test_StoreDB_UpdateStep(t *testing.T) {
 	es, err = store.UpsertStep(tests.Context(t), stepOne)
 	require.NoError(t, err)
 
+	zeroStepTimestamps(es)
 	gotStep := es.Steps[stepOne.Ref]
 	assert.Equal(t, stepOne, gotStep)
 
@@ -173,6 +189,7 @@ func Test_StoreDB_UpdateStep(t *testing.T) {
 	es, err = store.UpsertStep(tests.Context(t), stepTwo)
 	require.NoError(t, err)
 
+	zeroStepTimestamps(es)
 	gotStep = es.Steps[stepTwo.Ref]
 	assert.Equal(t, stepTwo, gotStep)
 }
@@ -311,5 +328,92 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	assert.Len(t, states, 1)
 	// Zero out the completedAt timestamp
 	states[0].CreatedAt = nil
+	zeroStepTimestamps(states[0])
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_List(t *testing.T) {
+	ctx := tests.Context(t)
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: pgtest.NewSqlxDB(t), lggr: logger.TestLogger(t), clock: clock}
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	start := clock.Now()
+	var ids []string
+	for i, status := range []string{StatusCompleted, StatusErrored, StatusStarted} {
+		id := randomID()
+		ids = append(ids, id)
+		es := WorkflowExecution{
+			ExecutionID: id,
+			WorkflowID:  wid,
+			Status:      status,
+			Steps:       map[string]*WorkflowExecutionStep{},
+		}
+		if i > 0 {
+			nm, err := values.NewMap(map[string]any{"hello": "world"})
+			require.NoError(t, err)
+			es.Steps["step1"] = &WorkflowExecutionStep{ExecutionID: id, Ref: "step1", Status: status, Inputs: nm}
+		}
+		_, err := store.Add(ctx, &es)
+		require.NoError(t, err)
+		clock.Advance(time.Minute)
+	}
+	// executions of other workflows are not returned when filtering by workflow ID
+	_, err := store.Add(ctx, &WorkflowExecution{ExecutionID: randomID(), Status: StatusCompleted})
+	require.NoError(t, err)
+
+	executions, count, err := store.List(ctx, ExecutionFilter{WorkflowID: wid}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 2)
+	assert.Equal(t, ids[2], executions[0].ExecutionID)
+	assert.Equal(t, ids[1], executions[1].ExecutionID)
+	step := executions[1].Steps["step1"]
+	require.NotNil(t, step)
+	assert.Equal(t, StatusErrored, step.Status)
+	assert.Equal(t, "world", step.Inputs.Underlying["hello"].(*values.String).Underlying)
+	assert.NotNil(t, step.CreatedAt)
+
+	executions, count, err = store.List(ctx, ExecutionFilter{WorkflowID: wid}, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 1)
+	assert.Equal(t, ids[0], executions[0].ExecutionID)
+	assert.Empty(t, executions[0].Steps)
+
+	executions, count, err = store.List(ctx, ExecutionFilter{WorkflowID: wid, Status: StatusErrored}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, executions, 1)
+	assert.Equal(t, ids[1], executions[0].ExecutionID)
+
+	after, before := start.Add(30*time.Second), start.Add(90*time.Second)
+	executions, count, err = store.List(ctx, ExecutionFilter{WorkflowID: wid, CreatedAfter: &after, CreatedBefore: &before}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, executions, 1)
+	assert.Equal(t, ids[1], executions[0].ExecutionID)
+}
+
+func Test_StoreDB_SetPaused(t *testing.T) {
+	ctx := tests.Context(t)
+	store := newTestDBStore(t)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+
+	isPaused := func() (paused bool) {
+		require.NoError(t, store.db.GetContext(ctx, &paused, `SELECT paused FROM workflow_specs WHERE workflow_id = $1`, wid))
+		return paused
+	}
+	assert.False(t, isPaused())
+
+	require.NoError(t, store.SetPaused(ctx, wid, true))
+	assert.True(t, isPaused())
+
+	require.NoError(t, store.SetPaused(ctx, wid, false))
+	assert.False(t, isPaused())
+
+	require.ErrorIs(t, store.SetPaused(ctx, randomID(), true), sql.ErrNoRows)
+}
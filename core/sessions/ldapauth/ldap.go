@@ -56,6 +56,7 @@ type ldapAuthenticator struct {
 	ds          sqlutil.DataSource
 	ldapClient  LDAPClient
 	config      config.LDAP
+	roles       *sessions.Roles
 	lggr        logger.Logger
 	auditLogger audit.AuditLogger
 }
@@ -66,6 +67,7 @@ var _ sessions.AuthenticationProvider = (*ldapAuthenticator)(nil)
 func NewLDAPAuthenticator(
 	ds sqlutil.DataSource,
 	ldapCfg config.LDAP,
+	roles *sessions.Roles,
 	dev bool,
 	lggr logger.Logger,
 	auditLogger audit.AuditLogger,
@@ -91,6 +93,7 @@ func NewLDAPAuthenticator(
 		ds:          ds,
 		ldapClient:  newLDAPClient(ldapCfg),
 		config:      ldapCfg,
+		roles:       roles,
 		lggr:        lggr.Named("LDAPAuthenticationProvider"),
 		auditLogger: auditLogger,
 	}
@@ -247,37 +250,16 @@ func (l *ldapAuthenticator) ListUsers(ctx context.Context) ([]sessions.User, err
 	}
 	defer conn.Close()
 
-	// Query for list of uniqueMember IDs present in Admin group
-	adminUsers, err := l.ldapGroupMembersListToUser(conn, l.config.AdminUserGroupCN(), sessions.UserRoleAdmin)
-	if err != nil {
-		l.lggr.Errorf("error in ldapGroupMembersListToUser: %v", err)
-		return users, errors.New("unable to list group users")
-	}
-	// Query for list of uniqueMember IDs present in Edit group
-	editUsers, err := l.ldapGroupMembersListToUser(conn, l.config.EditUserGroupCN(), sessions.UserRoleEdit)
-	if err != nil {
-		l.lggr.Error("error in ldapGroupMembersListToUser: ", err)
-		return users, errors.New("unable to list group users")
-	}
-	// Query for list of uniqueMember IDs present in Run group
-	runUsers, err := l.ldapGroupMembersListToUser(conn, l.config.RunUserGroupCN(), sessions.UserRoleRun)
-	if err != nil {
-		l.lggr.Error("error in ldapGroupMembersListToUser: ", err)
-		return users, errors.New("unable to list group users")
-	}
-	// Query for list of uniqueMember IDs present in Read group
-	readUsers, err := l.ldapGroupMembersListToUser(conn, l.config.ReadUserGroupCN(), sessions.UserRoleView)
-	if err != nil {
-		l.lggr.Error("error in ldapGroupMembersListToUser: ", err)
-		return users, errors.New("unable to list group users")
+	// Query for list of uniqueMember IDs present in each group, aggregating the full list by order of precedence
+	for _, group := range groupRoles(l.config, l.roles) {
+		groupUsers, err := l.ldapGroupMembersListToUser(conn, group.cn, group.role)
+		if err != nil {
+			l.lggr.Errorf("error in ldapGroupMembersListToUser: %v", err)
+			return users, errors.New("unable to list group users")
+		}
+		users = append(users, groupUsers...)
 	}
 
-	// Aggregate full list
-	users = append(users, adminUsers...)
-	users = append(users, editUsers...)
-	users = append(users, runUsers...)
-	users = append(users, readUsers...)
-
 	// Dedupe preserving order of highest role
 	uniqueRef := make(map[string]struct{})
 	dedupedUsers := []sessions.User{}
@@ -765,6 +747,27 @@ func ldapGroupMembersListToUser(
 	return users, nil
 }
 
+type groupRole struct {
+	cn   string
+	role sessions.UserRole
+}
+
+// groupRoles returns the LDAP groups mapped to a role, by order of precedence for users member of several groups:
+// admin, then the custom roles in the order they are defined, then edit, run and view.
+func groupRoles(cfg config.LDAP, roles *sessions.Roles) []groupRole {
+	groups := []groupRole{{cfg.AdminUserGroupCN(), sessions.UserRoleAdmin}}
+	for _, role := range roles.Custom() {
+		if role.LDAPGroupCN != "" {
+			groups = append(groups, groupRole{role.LDAPGroupCN, role.Name})
+		}
+	}
+	return append(groups,
+		groupRole{cfg.EditUserGroupCN(), sessions.UserRoleEdit},
+		groupRole{cfg.RunUserGroupCN(), sessions.UserRoleRun},
+		groupRole{cfg.ReadUserGroupCN(), sessions.UserRoleView},
+	)
+}
+
 // groupSearchResultsToUserRole takes a list of LDAP group search result entries and returns the associated
 // internal user role based on the group name mappings defined in the configuration
 func (l *ldapAuthenticator) groupSearchResultsToUserRole(ldapGroups []*ldap.Entry) (sessions.UserRole, error) {
@@ -774,16 +777,30 @@ func (l *ldapAuthenticator) groupSearchResultsToUserRole(ldapGroups []*ldap.Entr
 		l.config.EditUserGroupCN(),
 		l.config.RunUserGroupCN(),
 		l.config.ReadUserGroupCN(),
+		l.roles.Custom()...,
 	)
 }
 
-func GroupSearchResultsToUserRole(ldapGroups []*ldap.Entry, adminCN string, editCN string, runCN string, readCN string) (sessions.UserRole, error) {
+// GroupSearchResultsToUserRole returns the role of the first group found in order of precedence: admin, then the
+// custom roles mapped to an LDAP group in the order they are given, then edit, run and view.
+func GroupSearchResultsToUserRole(ldapGroups []*ldap.Entry, adminCN string, editCN string, runCN string, readCN string, customRoles ...sessions.Role) (sessions.UserRole, error) {
 	// If defined Admin group name is present in groups search result, return UserRoleAdmin
 	for _, group := range ldapGroups {
 		if group.GetAttributeValue("cn") == adminCN {
 			return sessions.UserRoleAdmin, nil
 		}
 	}
+	// Check custom roles
+	for _, role := range customRoles {
+		if role.LDAPGroupCN == "" {
+			continue
+		}
+		for _, group := range ldapGroups {
+			if group.GetAttributeValue("cn") == role.LDAPGroupCN {
+				return role.Name, nil
+			}
+		}
+	}
 	// Check edit role
 	for _, group := range ldapGroups {
 		if group.GetAttributeValue("cn") == editCN {
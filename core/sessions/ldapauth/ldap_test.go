@@ -649,3 +649,45 @@ func TestORM_MapSearchGroups(t *testing.T) {
 		})
 	}
 }
+
+func TestORM_MapSearchGroups_CustomRoles(t *testing.T) {
+	t.Parallel()
+
+	cfg := ldapauth.TestConfig{}
+	customRoles := []sessions.Role{
+		{Name: "unmapped", Permissions: []sessions.Permission{{Resource: sessions.ResourceAll, Action: sessions.ActionView}}},
+		{Name: "bridge-manager", LDAPGroupCN: "NodeBridgeManagers", Permissions: []sessions.Permission{{Resource: sessions.ResourceBridges, Action: sessions.ActionEdit}}},
+	}
+	group := func(cn string) *ldap.Entry {
+		return &ldap.Entry{
+			DN:         fmt.Sprintf("cn=%s,ou=Groups,dc=example,dc=com", cn),
+			Attributes: []*ldap.EntryAttribute{{Name: "cn", Values: []string{cn}}},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		groups         []*ldap.Entry
+		wantMappedRole sessions.UserRole
+	}{
+		{"custom group only", []*ldap.Entry{group("NodeBridgeManagers")}, "bridge-manager"},
+		{"custom and view groups", []*ldap.Entry{group(ldapauth.NodeReadOnlyGroupCN), group("NodeBridgeManagers")}, "bridge-manager"},
+		{"custom and edit groups", []*ldap.Entry{group(ldapauth.NodeEditorsGroupCN), group("NodeBridgeManagers")}, "bridge-manager"},
+		{"custom and admin groups", []*ldap.Entry{group("NodeBridgeManagers"), group(ldapauth.NodeAdminsGroupCN)}, sessions.UserRoleAdmin},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			role, err := ldapauth.GroupSearchResultsToUserRole(
+				test.groups,
+				cfg.AdminUserGroupCN(),
+				cfg.EditUserGroupCN(),
+				cfg.RunUserGroupCN(),
+				cfg.ReadUserGroupCN(),
+				customRoles...,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantMappedRole, role)
+		})
+	}
+}
@@ -20,6 +20,7 @@ type LDAPServerStateSyncer struct {
 	ds           sqlutil.DataSource
 	ldapClient   LDAPClient
 	config       config.LDAP
+	roles        *sessions.Roles
 	lggr         logger.Logger
 	nextSyncTime time.Time
 	done         chan struct{}
@@ -30,12 +31,14 @@ type LDAPServerStateSyncer struct {
 func NewLDAPServerStateSyncer(
 	ds sqlutil.DataSource,
 	config config.LDAP,
+	roles *sessions.Roles,
 	lggr logger.Logger,
 ) *LDAPServerStateSyncer {
 	return &LDAPServerStateSyncer{
 		ds:         ds,
 		ldapClient: newLDAPClient(config),
 		config:     config,
+		roles:      roles,
 		lggr:       lggr.Named("LDAPServerStateSync"),
 		done:       make(chan struct{}),
 		stopCh:     make(services.StopChan),
@@ -129,36 +132,16 @@ func (l *LDAPServerStateSyncer) Work(ctx context.Context) {
 	}
 	defer conn.Close()
 
-	// Query for list of uniqueMember IDs present in Admin group
-	adminUsers, err := l.ldapGroupMembersListToUser(conn, l.config.AdminUserGroupCN(), sessions.UserRoleAdmin)
-	if err != nil {
-		l.lggr.Error("Error in ldapGroupMembersListToUser: ", err)
-		return
-	}
-	// Query for list of uniqueMember IDs present in Edit group
-	editUsers, err := l.ldapGroupMembersListToUser(conn, l.config.EditUserGroupCN(), sessions.UserRoleEdit)
-	if err != nil {
-		l.lggr.Error("Error in ldapGroupMembersListToUser: ", err)
-		return
-	}
-	// Query for list of uniqueMember IDs present in Edit group
-	runUsers, err := l.ldapGroupMembersListToUser(conn, l.config.RunUserGroupCN(), sessions.UserRoleRun)
-	if err != nil {
-		l.lggr.Error("Error in ldapGroupMembersListToUser: ", err)
-		return
-	}
-	// Query for list of uniqueMember IDs present in Edit group
-	readUsers, err := l.ldapGroupMembersListToUser(conn, l.config.ReadUserGroupCN(), sessions.UserRoleView)
-	if err != nil {
-		l.lggr.Error("Error in ldapGroupMembersListToUser: ", err)
-		return
+	// Query for list of uniqueMember IDs present in each group, by order of precedence
+	for _, group := range groupRoles(l.config, l.roles) {
+		groupUsers, err := l.ldapGroupMembersListToUser(conn, group.cn, group.role)
+		if err != nil {
+			l.lggr.Error("Error in ldapGroupMembersListToUser: ", err)
+			return
+		}
+		users = append(users, groupUsers...)
 	}
 
-	users = append(users, adminUsers...)
-	users = append(users, editUsers...)
-	users = append(users, runUsers...)
-	users = append(users, readUsers...)
-
 	// Dedupe preserving order of highest role (sorted)
 	// Preserve members as a map for future lookup
 	upstreamUserStateMap := make(map[string]sessions.User)
@@ -24,18 +24,20 @@ type orm struct {
 	sessionDuration time.Duration
 	lggr            logger.Logger
 	auditLogger     audit.AuditLogger
+	roles           *sessions.Roles
 }
 
 // orm implements sessions.AuthenticationProvider and sessions.BasicAdminUsersORM interfaces
 var _ sessions.AuthenticationProvider = (*orm)(nil)
 var _ sessions.BasicAdminUsersORM = (*orm)(nil)
 
-func NewORM(ds sqlutil.DataSource, sd time.Duration, lggr logger.Logger, auditLogger audit.AuditLogger) sessions.AuthenticationProvider {
+func NewORM(ds sqlutil.DataSource, sd time.Duration, lggr logger.Logger, auditLogger audit.AuditLogger, roles *sessions.Roles) sessions.AuthenticationProvider {
 	return &orm{
 		ds:              ds,
 		sessionDuration: sd,
 		lggr:            lggr.Named("LocalAuthAuthenticationProviderORM"),
 		auditLogger:     auditLogger,
+		roles:           roles,
 	}
 }
 
@@ -266,7 +268,7 @@ func (o *orm) UpdateRole(ctx context.Context, email, newRole string) (sessions.U
 		}
 
 		// Patch validated role
-		userRole, err := sessions.GetUserRole(newRole)
+		userRole, err := o.roles.Get(newRole)
 		if err != nil {
 			return err
 		}
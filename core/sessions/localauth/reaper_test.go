@@ -35,7 +35,7 @@ func TestSessionReaper_ReapSessions(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	config := sessionReaperConfig{}
 	lggr := logger.TestLogger(t)
-	orm := localauth.NewORM(db, config.SessionTimeout().Duration(), lggr, audit.NoopLogger)
+	orm := localauth.NewORM(db, config.SessionTimeout().Duration(), lggr, audit.NoopLogger, nil)
 
 	r := localauth.NewSessionReaper(db, config, lggr)
 	t.Cleanup(func() {
@@ -0,0 +1,213 @@
+package sessions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// Resource is a kind of object managed through the API, which permissions are granted on.
+type Resource string
+
+const (
+	// ResourceAll grants a permission on every resource.
+	ResourceAll                Resource = "*"
+	ResourceBridges            Resource = "bridges"
+	ResourceChains             Resource = "chains"
+	ResourceConfig             Resource = "config"
+	ResourceExternalInitiators Resource = "external_initiators"
+	ResourceFeedsManagers      Resource = "feeds_managers"
+	ResourceJobProposals       Resource = "job_proposals"
+	ResourceJobs               Resource = "jobs"
+	ResourceKeys               Resource = "keys"
+	ResourceTransactions       Resource = "transactions"
+	ResourceUsers              Resource = "users"
+)
+
+var resources = []Resource{
+	ResourceAll,
+	ResourceBridges,
+	ResourceChains,
+	ResourceConfig,
+	ResourceExternalInitiators,
+	ResourceFeedsManagers,
+	ResourceJobProposals,
+	ResourceJobs,
+	ResourceKeys,
+	ResourceTransactions,
+	ResourceUsers,
+}
+
+// Action is what a permission allows to do with a resource. Actions are ordered, each one implying the previous ones:
+// view, run, edit and admin.
+type Action string
+
+const (
+	ActionView  Action = "view"
+	ActionRun   Action = "run"
+	ActionEdit  Action = "edit"
+	ActionAdmin Action = "admin"
+)
+
+var actions = []Action{ActionView, ActionRun, ActionEdit, ActionAdmin}
+
+func (a Action) level() int {
+	return slices.Index(actions, a)
+}
+
+// Permission grants an action on a resource, optionally restricted to a scope such as the chain a job runs on.
+//
+// Its string representation is resource:action[@scope], e.g. bridges:edit or job_proposals:edit@evm/1.
+type Permission struct {
+	Resource Resource
+	Action   Action
+	// Scope restricts the permission to the resources of the scope, if not empty. Chain scopes are <network>/<chain ID>.
+	Scope string
+}
+
+// ParsePermission parses the string representation of a permission.
+func ParsePermission(s string) (p Permission, err error) {
+	s, p.Scope, _ = strings.Cut(s, "@")
+	resource, action, ok := strings.Cut(s, ":")
+	if !ok {
+		return p, pkgerrors.Errorf("invalid permission %q: expected resource:action[@scope]", s)
+	}
+	p.Resource, p.Action = Resource(resource), Action(action)
+	if !slices.Contains(resources, p.Resource) {
+		return p, pkgerrors.Errorf("invalid permission %q: unknown resource %q, allowed resources: %v", s, resource, resources)
+	}
+	if p.Action.level() < 0 {
+		return p, pkgerrors.Errorf("invalid permission %q: unknown action %q, allowed actions: %v", s, action, actions)
+	}
+	return p, nil
+}
+
+func (p Permission) String() string {
+	s := fmt.Sprintf("%s:%s", p.Resource, p.Action)
+	if p.Scope != "" {
+		s += "@" + p.Scope
+	}
+	return s
+}
+
+// grants returns true if the permission allows the action on the resource within the scope.
+func (p Permission) grants(resource Resource, action Action, scope string) bool {
+	return (p.Resource == ResourceAll || p.Resource == resource) &&
+		p.Action.level() >= action.level() &&
+		(p.Scope == "" || p.Scope == scope)
+}
+
+// Role is a named set of permissions.
+type Role struct {
+	Name        UserRole
+	Permissions []Permission
+	// LDAPGroupCN is the LDAP group which members are assigned the role, if any.
+	LDAPGroupCN string
+}
+
+var builtinRoles = map[UserRole]Role{
+	UserRoleAdmin: {Name: UserRoleAdmin, Permissions: []Permission{{Resource: ResourceAll, Action: ActionAdmin}}},
+	UserRoleEdit:  {Name: UserRoleEdit, Permissions: []Permission{{Resource: ResourceAll, Action: ActionEdit}}},
+	UserRoleRun:   {Name: UserRoleRun, Permissions: []Permission{{Resource: ResourceAll, Action: ActionRun}}},
+	UserRoleView:  {Name: UserRoleView, Permissions: []Permission{{Resource: ResourceAll, Action: ActionView}}},
+}
+
+// Roles holds the built-in roles along with the custom roles defined by the node operator.
+// A nil Roles only knows the built-in roles.
+type Roles struct {
+	custom []Role
+}
+
+// NewRoles validates the custom roles, which must not shadow the built-in roles.
+func NewRoles(custom ...Role) (*Roles, error) {
+	names := make(map[UserRole]struct{}, len(custom))
+	for _, r := range custom {
+		if r.Name == "" {
+			return nil, pkgerrors.New("custom role name must not be empty")
+		}
+		if _, ok := builtinRoles[r.Name]; ok {
+			return nil, pkgerrors.Errorf("custom role %q must not redefine a built-in role", r.Name)
+		}
+		if _, ok := names[r.Name]; ok {
+			return nil, pkgerrors.Errorf("custom role %q is defined more than once", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		if len(r.Permissions) == 0 {
+			return nil, pkgerrors.Errorf("custom role %q must grant at least one permission", r.Name)
+		}
+	}
+	return &Roles{custom: custom}, nil
+}
+
+// Custom returns the custom roles, in the order they were defined.
+func (r *Roles) Custom() []Role {
+	if r == nil {
+		return nil
+	}
+	return r.custom
+}
+
+func (r *Roles) find(name UserRole) (Role, bool) {
+	if role, ok := builtinRoles[name]; ok {
+		return role, true
+	}
+	for _, role := range r.Custom() {
+		if role.Name == name {
+			return role, true
+		}
+	}
+	return Role{}, false
+}
+
+// Get is the single point of logic for mapping a role string to a built-in or custom UserRole.
+func (r *Roles) Get(name string) (UserRole, error) {
+	if _, ok := r.find(UserRole(name)); !ok {
+		return "", pkgerrors.Errorf("Invalid role: %s. Allowed roles: %s.", name, r.names())
+	}
+	return UserRole(name), nil
+}
+
+func (r *Roles) names() string {
+	names := []string{
+		fmt.Sprintf("'%s'", UserRoleAdmin),
+		fmt.Sprintf("'%s'", UserRoleEdit),
+		fmt.Sprintf("'%s'", UserRoleRun),
+		fmt.Sprintf("'%s'", UserRoleView),
+	}
+	for _, role := range r.Custom() {
+		names = append(names, fmt.Sprintf("'%s'", role.Name))
+	}
+	return strings.Join(names, ", ")
+}
+
+// Permitted returns true if the role allows the action on the resource. The scope identifies the resource more
+// precisely, e.g. the chain of a job, for permissions restricted to a scope. Unknown roles have no permissions.
+func (r *Roles) Permitted(role UserRole, resource Resource, action Action, scope string) bool {
+	def, ok := r.find(role)
+	if !ok {
+		return false
+	}
+	for _, p := range def.Permissions {
+		if p.grants(resource, action, scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// PermittedWithinSomeScope returns true if the role allows the action on the resource within at least one scope,
+// e.g. on the jobs of a single chain.
+func (r *Roles) PermittedWithinSomeScope(role UserRole, resource Resource, action Action) bool {
+	def, ok := r.find(role)
+	if !ok {
+		return false
+	}
+	for _, p := range def.Permissions {
+		if p.grants(resource, action, p.Scope) {
+			return true
+		}
+	}
+	return false
+}
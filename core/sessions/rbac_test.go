@@ -0,0 +1,147 @@
+package sessions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestParsePermission(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		s   string
+		exp sessions.Permission
+		err string
+	}{
+		{s: "*:view", exp: sessions.Permission{Resource: sessions.ResourceAll, Action: sessions.ActionView}},
+		{s: "bridges:edit", exp: sessions.Permission{Resource: sessions.ResourceBridges, Action: sessions.ActionEdit}},
+		{s: "job_proposals:edit@evm/1", exp: sessions.Permission{Resource: sessions.ResourceJobProposals, Action: sessions.ActionEdit, Scope: "evm/1"}},
+		{s: "bridges", err: "expected resource:action[@scope]"},
+		{s: "widgets:view", err: `unknown resource "widgets"`},
+		{s: "bridges:delete", err: `unknown action "delete"`},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			p, err := sessions.ParsePermission(tt.s)
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, p)
+			assert.Equal(t, tt.s, p.String())
+		})
+	}
+}
+
+func TestNewRoles(t *testing.T) {
+	t.Parallel()
+
+	view := []sessions.Permission{{Resource: sessions.ResourceAll, Action: sessions.ActionView}}
+	for _, tt := range []struct {
+		name   string
+		custom []sessions.Role
+		err    string
+	}{
+		{"valid", []sessions.Role{{Name: "bridge-manager", Permissions: view}}, ""},
+		{"empty name", []sessions.Role{{Permissions: view}}, "name must not be empty"},
+		{"built-in", []sessions.Role{{Name: sessions.UserRoleEdit, Permissions: view}}, "must not redefine a built-in role"},
+		{"duplicate", []sessions.Role{{Name: "a", Permissions: view}, {Name: "a", Permissions: view}}, "defined more than once"},
+		{"no permissions", []sessions.Role{{Name: "a"}}, "at least one permission"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sessions.NewRoles(tt.custom...)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestRoles_Permitted(t *testing.T) {
+	t.Parallel()
+
+	roles, err := sessions.NewRoles(
+		sessions.Role{Name: "bridge-manager", Permissions: []sessions.Permission{
+			{Resource: sessions.ResourceAll, Action: sessions.ActionView},
+			{Resource: sessions.ResourceBridges, Action: sessions.ActionEdit},
+		}},
+		sessions.Role{Name: "mainnet-approver", Permissions: []sessions.Permission{
+			{Resource: sessions.ResourceJobProposals, Action: sessions.ActionEdit, Scope: "evm/1"},
+		}},
+	)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		role     sessions.UserRole
+		resource sessions.Resource
+		action   sessions.Action
+		scope    string
+		exp      bool
+	}{
+		{sessions.UserRoleAdmin, sessions.ResourceKeys, sessions.ActionAdmin, "", true},
+		{sessions.UserRoleEdit, sessions.ResourceKeys, sessions.ActionEdit, "", true},
+		{sessions.UserRoleEdit, sessions.ResourceKeys, sessions.ActionAdmin, "", false},
+		{sessions.UserRoleRun, sessions.ResourceJobs, sessions.ActionRun, "", true},
+		{sessions.UserRoleRun, sessions.ResourceJobs, sessions.ActionEdit, "", false},
+		{sessions.UserRoleView, sessions.ResourceAll, sessions.ActionView, "", true},
+		{sessions.UserRoleView, sessions.ResourceAll, sessions.ActionRun, "", false},
+
+		{"bridge-manager", sessions.ResourceBridges, sessions.ActionEdit, "", true},
+		{"bridge-manager", sessions.ResourceBridges, sessions.ActionAdmin, "", false},
+		{"bridge-manager", sessions.ResourceKeys, sessions.ActionView, "", true},
+		{"bridge-manager", sessions.ResourceKeys, sessions.ActionEdit, "", false},
+		{"bridge-manager", sessions.ResourceAll, sessions.ActionEdit, "", false},
+
+		{"mainnet-approver", sessions.ResourceJobProposals, sessions.ActionEdit, "evm/1", true},
+		{"mainnet-approver", sessions.ResourceJobProposals, sessions.ActionView, "evm/1", true},
+		{"mainnet-approver", sessions.ResourceJobProposals, sessions.ActionEdit, "evm/10", false},
+		{"mainnet-approver", sessions.ResourceJobProposals, sessions.ActionEdit, "", false},
+
+		{"unknown", sessions.ResourceBridges, sessions.ActionView, "", false},
+	} {
+		assert.Equal(t, tt.exp, roles.Permitted(tt.role, tt.resource, tt.action, tt.scope),
+			"%s %s:%s@%s", tt.role, tt.resource, tt.action, tt.scope)
+	}
+
+	t.Run("nil roles only know built-in roles", func(t *testing.T) {
+		var roles *sessions.Roles
+		assert.True(t, roles.Permitted(sessions.UserRoleEdit, sessions.ResourceBridges, sessions.ActionEdit, ""))
+		assert.False(t, roles.Permitted("bridge-manager", sessions.ResourceBridges, sessions.ActionView, ""))
+	})
+
+	t.Run("within some scope", func(t *testing.T) {
+		assert.True(t, roles.PermittedWithinSomeScope("mainnet-approver", sessions.ResourceJobProposals, sessions.ActionEdit))
+		assert.False(t, roles.PermittedWithinSomeScope("mainnet-approver", sessions.ResourceJobProposals, sessions.ActionAdmin))
+		assert.False(t, roles.PermittedWithinSomeScope("mainnet-approver", sessions.ResourceJobs, sessions.ActionView))
+		assert.True(t, roles.PermittedWithinSomeScope("bridge-manager", sessions.ResourceBridges, sessions.ActionEdit))
+		assert.False(t, roles.PermittedWithinSomeScope("unknown", sessions.ResourceBridges, sessions.ActionView))
+	})
+}
+
+func TestRoles_Get(t *testing.T) {
+	t.Parallel()
+
+	roles, err := sessions.NewRoles(sessions.Role{Name: "bridge-manager", Permissions: []sessions.Permission{
+		{Resource: sessions.ResourceBridges, Action: sessions.ActionEdit},
+	}})
+	require.NoError(t, err)
+
+	role, err := roles.Get("bridge-manager")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRole("bridge-manager"), role)
+
+	role, err = roles.Get("run")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleRun, role)
+
+	_, err = roles.Get("superuser")
+	require.EqualError(t, err, "Invalid role: superuser. Allowed roles: 'admin', 'edit', 'run', 'view', 'bridge-manager'.")
+}
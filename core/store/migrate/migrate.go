@@ -167,6 +167,19 @@ func Current(ctx context.Context, db *sql.DB) (int64, error) {
 	return provider.GetDBVersion(ctx)
 }
 
+// Latest returns the version of the latest migration known to this node.
+func Latest(ctx context.Context, db *sql.DB) (int64, error) {
+	provider, err := NewProvider(ctx, db)
+	if err != nil {
+		return -1, err
+	}
+	sources := provider.ListSources()
+	if len(sources) == 0 {
+		return -1, pkgerrors.New("no migrations found")
+	}
+	return sources[len(sources)-1].Version, nil
+}
+
 func Status(ctx context.Context, db *sql.DB) error {
 	provider, err := NewProvider(ctx, db)
 	if err != nil {
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Journal of reorgs handled by the LogPoller, one row per rewind of evm.logs and evm.log_poller_blocks
+CREATE TABLE evm.log_poller_reorgs (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    evm_chain_id NUMERIC(78, 0) NOT NULL,
+    -- first block removed from the db, i.e. the block after the LCA
+    block_number BIGINT NOT NULL,
+    depth BIGINT NOT NULL,
+    old_block_hash BYTEA NOT NULL,
+    new_block_hash BYTEA NOT NULL,
+    removed_log_ids BIGINT[] NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_log_poller_reorgs_chain_created_at ON evm.log_poller_reorgs (evm_chain_id, created_at);
+CREATE INDEX idx_log_poller_reorgs_chain_block ON evm.log_poller_reorgs (evm_chain_id, block_number);
+
+-- Copies of the logs removed from evm.logs by a reorg, so that consumers can replay what was orphaned
+CREATE TABLE evm.log_poller_reorged_logs (
+    reorg_id BIGINT NOT NULL REFERENCES evm.log_poller_reorgs (id) ON DELETE CASCADE,
+    log_id BIGINT NOT NULL,
+    evm_chain_id NUMERIC(78, 0) NOT NULL,
+    log_index BIGINT NOT NULL,
+    block_hash BYTEA NOT NULL,
+    block_number BIGINT NOT NULL,
+    block_timestamp TIMESTAMPTZ NOT NULL,
+    address BYTEA NOT NULL,
+    event_sig BYTEA NOT NULL,
+    topics BYTEA[] NOT NULL,
+    tx_hash BYTEA NOT NULL,
+    data BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (reorg_id, log_id)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE evm.log_poller_reorged_logs;
+DROP TABLE evm.log_poller_reorgs;
+
+-- +goose StatementEnd
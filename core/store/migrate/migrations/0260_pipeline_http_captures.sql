@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Raw traffic of http and bridge tasks, recorded when JobPipeline.HTTPRequest.CaptureTraffic is enabled
+CREATE TABLE pipeline_http_captures (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    pipeline_run_id BIGINT NOT NULL REFERENCES pipeline_runs (id) ON DELETE CASCADE,
+    -- not a foreign key, task runs of successful runs are not necessarily saved
+    pipeline_task_run_id UUID NOT NULL,
+    dot_id TEXT NOT NULL,
+    method TEXT NOT NULL,
+    url TEXT NOT NULL,
+    request_headers JSONB NOT NULL,
+    request_body BYTEA,
+    -- 0 if no response was received
+    status_code INT NOT NULL,
+    response_headers JSONB NOT NULL,
+    response_body BYTEA,
+    latency BIGINT NOT NULL,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE UNIQUE INDEX idx_pipeline_http_captures_task_run ON pipeline_http_captures (pipeline_run_id, pipeline_task_run_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE pipeline_http_captures;
+
+-- +goose StatementEnd
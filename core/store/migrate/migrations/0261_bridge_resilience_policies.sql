@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE bridge_types
+    -- 0 disables the circuit breaker
+    ADD COLUMN circuit_failure_threshold INT NOT NULL DEFAULT 0,
+    ADD COLUMN circuit_open_duration BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN hedge_after_p95 BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN fallback_bridge_name TEXT REFERENCES bridge_types (name) ON DELETE SET NULL,
+    ADD CONSTRAINT chk_fallback_bridge_name CHECK (fallback_bridge_name <> name);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE bridge_types
+    DROP COLUMN circuit_failure_threshold,
+    DROP COLUMN circuit_open_duration,
+    DROP COLUMN hedge_after_p95,
+    DROP COLUMN fallback_bridge_name;
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Roles are no longer limited to the built-in ones, custom roles are defined in the configuration
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN role TYPE TEXT USING role::TEXT;
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'view';
+ALTER TABLE ldap_sessions ALTER COLUMN user_role TYPE TEXT USING user_role::TEXT;
+ALTER TABLE ldap_user_api_tokens ALTER COLUMN user_role TYPE TEXT USING user_role::TEXT;
+DROP TYPE user_roles;
+
+-- +goose Down
+CREATE TYPE user_roles AS ENUM ('admin', 'edit', 'run', 'view');
+-- Users assigned a custom role fall back to the 'view' role, LDAP sessions and tokens are recreated on the next login
+UPDATE users SET role = 'view' WHERE role NOT IN ('admin', 'edit', 'run', 'view');
+DELETE FROM ldap_sessions WHERE user_role NOT IN ('admin', 'edit', 'run', 'view');
+DELETE FROM ldap_user_api_tokens WHERE user_role NOT IN ('admin', 'edit', 'run', 'view');
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN role TYPE user_roles USING role::user_roles;
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'view';
+ALTER TABLE ldap_sessions ALTER COLUMN user_role TYPE user_roles USING user_role::user_roles;
+ALTER TABLE ldap_user_api_tokens ALTER COLUMN user_role TYPE user_roles USING user_role::user_roles;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Steps record when they were started, so that the execution history can report how long each step took
+ALTER TABLE workflow_steps ADD COLUMN created_at timestamp with time zone;
+UPDATE workflow_steps SET created_at = updated_at;
+CREATE INDEX idx_workflow_executions_workflow_id_created_at ON workflow_executions (workflow_id, created_at DESC);
+CREATE INDEX idx_workflow_executions_created_at ON workflow_executions (created_at DESC);
+
+-- +goose Down
+DROP INDEX idx_workflow_executions_created_at;
+DROP INDEX idx_workflow_executions_workflow_id_created_at;
+ALTER TABLE workflow_steps DROP COLUMN created_at;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Paused workflows keep their trigger registrations but do not start executions on trigger events
+ALTER TABLE workflow_specs ADD COLUMN paused boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE workflow_specs DROP COLUMN paused;
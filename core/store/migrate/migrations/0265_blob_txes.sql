@@ -0,0 +1,25 @@
+-- +goose Up
+-- Blob transactions (EIP-4844) carry a sidecar of blobs, which is kept to sign their bumped attempts
+ALTER TABLE evm.txes ADD COLUMN blob_sidecar jsonb;
+ALTER TABLE evm.tx_attempts
+	ADD COLUMN max_fee_per_blob_gas numeric(78,0),
+	DROP CONSTRAINT chk_legacy_or_dynamic,
+	ADD CONSTRAINT chk_legacy_or_dynamic CHECK (
+		(tx_type = 0 AND gas_price IS NOT NULL AND gas_tip_cap IS NULL AND gas_fee_cap IS NULL AND max_fee_per_blob_gas IS NULL)
+		OR
+		(tx_type = 2 AND gas_price IS NULL AND gas_tip_cap IS NOT NULL AND gas_fee_cap IS NOT NULL AND max_fee_per_blob_gas IS NULL)
+		OR
+		(tx_type = 3 AND gas_price IS NULL AND gas_tip_cap IS NOT NULL AND gas_fee_cap IS NOT NULL AND max_fee_per_blob_gas IS NOT NULL)
+	);
+
+-- +goose Down
+DELETE FROM evm.tx_attempts WHERE tx_type = 3;
+ALTER TABLE evm.tx_attempts
+	DROP CONSTRAINT chk_legacy_or_dynamic,
+	DROP COLUMN max_fee_per_blob_gas,
+	ADD CONSTRAINT chk_legacy_or_dynamic CHECK (
+		(tx_type = 0 AND gas_price IS NOT NULL AND gas_tip_cap IS NULL AND gas_fee_cap IS NULL)
+		OR
+		(tx_type = 2 AND gas_price IS NULL AND gas_tip_cap IS NOT NULL AND gas_fee_cap IS NOT NULL)
+	);
+ALTER TABLE evm.txes DROP COLUMN blob_sidecar;
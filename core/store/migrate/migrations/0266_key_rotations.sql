@@ -0,0 +1,26 @@
+-- +goose Up
+-- Rotations of OCR2 key bundles and P2P keys. The old key is archived once the contract config of every job lists the successor key.
+CREATE TABLE key_rotations (
+	id BIGSERIAL PRIMARY KEY,
+	key_type TEXT NOT NULL CHECK (key_type IN ('ocr2', 'p2p')),
+	old_key_id TEXT NOT NULL,
+	new_key_id TEXT NOT NULL,
+	state TEXT NOT NULL CHECK (state IN ('pending', 'archived')),
+	archived_key bytea,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	archived_at timestamp with time zone
+);
+CREATE UNIQUE INDEX idx_key_rotations_pending_old_key_id ON key_rotations (key_type, old_key_id) WHERE state = 'pending';
+
+CREATE TABLE key_rotation_jobs (
+	key_rotation_id BIGINT NOT NULL REFERENCES key_rotations (id) ON DELETE CASCADE,
+	job_id INT NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+	config_digest bytea,
+	switched_at timestamp with time zone,
+	PRIMARY KEY (key_rotation_id, job_id)
+);
+
+-- +goose Down
+DROP TABLE key_rotation_jobs;
+DROP TABLE key_rotations;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- State shared between gateway replicas: pending user requests, node connections and messages between replicas.
+CREATE TABLE gateway_pending_requests (
+	don_id TEXT NOT NULL,
+	sender TEXT NOT NULL,
+	message_id TEXT NOT NULL,
+	owner TEXT NOT NULL,
+	state bytea,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (don_id, sender, message_id)
+);
+CREATE INDEX idx_gateway_pending_requests_expires_at ON gateway_pending_requests (expires_at);
+
+CREATE TABLE gateway_node_claims (
+	don_id TEXT NOT NULL,
+	node_address TEXT NOT NULL,
+	owner TEXT NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (don_id, node_address)
+);
+
+CREATE TABLE gateway_replica_messages (
+	id BIGSERIAL PRIMARY KEY,
+	recipient TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	payload bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL
+);
+CREATE INDEX idx_gateway_replica_messages_recipient ON gateway_replica_messages (recipient, id);
+
+-- +goose Down
+DROP TABLE gateway_replica_messages;
+DROP TABLE gateway_node_claims;
+DROP TABLE gateway_pending_requests;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Streaming requests receive intermediate updates from the replicas aggregating node responses.
+ALTER TABLE gateway_pending_requests ADD COLUMN streaming BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE gateway_pending_requests DROP COLUMN streaming;
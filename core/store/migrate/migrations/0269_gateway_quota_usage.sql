@@ -0,0 +1,14 @@
+-- +goose Up
+-- Usage of gateway user quotas, counted in buckets of a rolling window.
+CREATE TABLE gateway_quota_usage (
+	quota_key TEXT NOT NULL,
+	bucket_start timestamp with time zone NOT NULL,
+	requests BIGINT NOT NULL,
+	payload_bytes BIGINT NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY (quota_key, bucket_start)
+);
+CREATE INDEX idx_gateway_quota_usage_expires_at ON gateway_quota_usage (expires_at);
+
+-- +goose Down
+DROP TABLE gateway_quota_usage;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Compute units charged to the daily and monthly request budgets of the RPCs, so that they survive restarts.
+CREATE TABLE evm.rpc_request_usage (
+	evm_chain_id NUMERIC(78,0) NOT NULL,
+	node_name TEXT NOT NULL,
+	period TEXT NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	units BIGINT NOT NULL,
+	PRIMARY KEY (evm_chain_id, node_name, period, period_start)
+);
+
+-- +goose Down
+DROP TABLE evm.rpc_request_usage;
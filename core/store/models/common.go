@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 	"github.com/tidwall/gjson"
@@ -258,6 +259,22 @@ type SendEtherRequest struct {
 	WaitAttemptTimeout *time.Duration `json:"waitAttemptTimeout"`
 }
 
+// ReplaceEthTxRequest represents a request to cancel, speed up or replace the
+// unconfirmed transaction sent from FromAddress with the given Nonce.
+// The fees are required to speed up or replace the transaction, the gas limit
+// and the data are only used to replace it.
+type ReplaceEthTxRequest struct {
+	FromAddress          common.Address `json:"from"`
+	Nonce                int64          `json:"nonce"`
+	EVMChainID           *big.Big       `json:"evmChainID"`
+	GasPrice             *assets.Wei    `json:"gasPrice"`
+	MaxFeePerGas         *assets.Wei    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *assets.Wei    `json:"maxPriorityFeePerGas"`
+	MaxFeePerBlobGas     *assets.Wei    `json:"maxFeePerBlobGas"`
+	GasLimit             uint64         `json:"gasLimit"`
+	Data                 hexutil.Bytes  `json:"data"`
+}
+
 // AddressCollection is an array of common.Address
 // serializable to and from a database.
 type AddressCollection []common.Address
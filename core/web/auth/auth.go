@@ -197,46 +197,37 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 	return obj.(*bridges.ExternalInitiator), ok
 }
 
-// RequiresRunRole extracts the user object from the context, and asserts the user's role is at least
-// 'run'
-func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
+type rolesKey struct{}
+
+// WithRoles is middleware which makes the roles of the node, including the custom roles, available to the
+// authorization checks of the request.
+func WithRoles(roles *clsessions.Roles) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role == clsessions.UserRoleView {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
-		}
-		handler(c)
+		c.Request = c.Request.WithContext(ContextWithRoles(c.Request.Context(), roles))
 	}
 }
 
-// RequiresEditRole extracts the user object from the context, and asserts the user's role is at least
-// 'edit'
-func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
-	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
-		}
-		handler(c)
-	}
+// ContextWithRoles sets the roles of the node in the context.
+func ContextWithRoles(ctx context.Context, roles *clsessions.Roles) context.Context {
+	return context.WithValue(ctx, rolesKey{}, roles)
+}
+
+// GetRoles extracts the roles of the node from the context. The returned roles are nil, and only know the
+// built-in roles, if they were not set.
+func GetRoles(ctx context.Context) *clsessions.Roles {
+	roles, _ := ctx.Value(rolesKey{}).(*clsessions.Roles)
+	return roles
+}
+
+// RequiresPermission extracts the user object from the context, and asserts the user's role allows the action
+// on the resource. Permissions restricted to a scope do not grant the request.
+func RequiresPermission(resource clsessions.Resource, action clsessions.Action, handler func(*gin.Context)) func(*gin.Context) {
+	return RequiresScopedPermission(resource, action, unscoped, handler)
 }
 
-// RequiresAdminRole extracts the user object from the context, and asserts the user's role is 'admin'
-func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
+// RequiresScopedPermission works like RequiresPermission, with the scope of the request returned by scope. The
+// scope must be resolved from the resource the request acts on, never from parameters the caller may choose freely.
+func RequiresScopedPermission(resource clsessions.Resource, action clsessions.Action, scope ScopeFunc, handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
 		if !ok {
@@ -244,10 +235,20 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role != clsessions.UserRoleAdmin {
+		roles, requestScope := GetRoles(c.Request.Context()), scope(c)
+		if !roles.Permitted(user.Role, resource, action, requestScope) {
 			c.Abort()
-			addForbiddenErrorHeaders(c, "admin", string(user.Role), user.Email)
-			jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+			if action == clsessions.ActionAdmin {
+				addForbiddenErrorHeaders(c, string(clsessions.UserRoleAdmin), string(user.Role), user.Email)
+				jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+				return
+			}
+			if requestScope != "" && roles.PermittedWithinSomeScope(user.Role, resource, action) {
+				// the user may act on the resources of other chains only
+				jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+				return
+			}
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
 			return
 		}
 		handler(c)
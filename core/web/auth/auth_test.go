@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -206,6 +207,92 @@ func TestRequireAuth_Error(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+func TestRequiresPermission_CustomRole(t *testing.T) {
+	roles, err := sessions.NewRoles(sessions.Role{Name: "bridge-manager", Permissions: []sessions.Permission{
+		{Resource: sessions.ResourceAll, Action: sessions.ActionView},
+		{Resource: sessions.ResourceBridges, Action: sessions.ActionEdit},
+	}})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(webauth.WithRoles(roles), func(c *gin.Context) {
+		c.Set(webauth.SessionUserKey, &sessions.User{Role: "bridge-manager"})
+	})
+	ok := func(c *gin.Context) { c.String(http.StatusOK, "") }
+	router.GET("/bridges", webauth.RequiresPermission(sessions.ResourceBridges, sessions.ActionView, ok))
+	router.POST("/bridges", webauth.RequiresPermission(sessions.ResourceBridges, sessions.ActionEdit, ok))
+	router.POST("/jobs", webauth.RequiresPermission(sessions.ResourceJobs, sessions.ActionEdit, ok))
+	router.POST("/users", webauth.RequiresPermission(sessions.ResourceUsers, sessions.ActionAdmin, ok))
+
+	for _, tt := range []struct {
+		verb, path string
+		code       int
+	}{
+		{"GET", "/bridges", http.StatusOK},
+		{"POST", "/bridges", http.StatusOK},
+		{"POST", "/jobs", http.StatusUnauthorized},
+		{"POST", "/users", http.StatusForbidden},
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, mustRequest(t, tt.verb, tt.path, nil))
+		assert.Equal(t, tt.code, w.Code, "%s %s", tt.verb, tt.path)
+	}
+}
+
+func TestRequiresPermission_Scoped(t *testing.T) {
+	roles, err := sessions.NewRoles(sessions.Role{Name: "evm-1", Permissions: []sessions.Permission{
+		{Resource: sessions.ResourceJobs, Action: sessions.ActionEdit, Scope: "evm/1"},
+		{Resource: sessions.ResourceTransactions, Action: sessions.ActionView, Scope: "evm/1"},
+		{Resource: sessions.ResourceChains, Action: sessions.ActionView, Scope: "evm/1"},
+		{Resource: sessions.ResourceKeys, Action: sessions.ActionAdmin, Scope: "evm/1"},
+		{Resource: sessions.ResourceUsers, Action: sessions.ActionAdmin, Scope: "evm/1"},
+	}})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(webauth.WithRoles(roles), func(c *gin.Context) {
+		c.Set(webauth.SessionUserKey, &sessions.User{Role: "evm-1"})
+	})
+	ok := func(c *gin.Context) { c.String(http.StatusOK, "") }
+	// the handler still gets the body the scope was read from
+	echo := func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		require.NoError(t, err)
+		c.String(http.StatusOK, string(body))
+	}
+	router.POST("/jobs", webauth.RequiresScopedPermission(sessions.ResourceJobs, sessions.ActionEdit, webauth.JobSpecBodyScope, echo))
+	router.GET("/transactions", webauth.RequiresPermission(sessions.ResourceTransactions, sessions.ActionView, ok))
+	router.POST("/keys/eth/export/:address", webauth.RequiresPermission(sessions.ResourceKeys, sessions.ActionAdmin, ok))
+	router.POST("/users", webauth.RequiresPermission(sessions.ResourceUsers, sessions.ActionAdmin, ok))
+	router.GET("/chains/evm/:ID", webauth.RequiresScopedPermission(sessions.ResourceChains, sessions.ActionView, webauth.ChainParamScope("evm"), ok))
+
+	for _, tt := range []struct {
+		verb, path, body string
+		code             int
+	}{
+		{"POST", "/jobs", `{"toml":"type = \"offchainreporting\"\nevmChainID = 1"}`, http.StatusOK},
+		{"POST", "/jobs", `{"toml":"type = \"offchainreporting\"\nevmChainID = 2"}`, http.StatusForbidden},
+		{"POST", "/jobs", `{"toml":"type = \"offchainreporting2\"\nrelay = \"evm\"\n[relayConfig]\nchainID = 1"}`, http.StatusOK},
+		{"POST", "/jobs", `{"toml":"type = \"offchainreporting2\"\nrelay = \"evm\"\nchainID = \"1\""}`, http.StatusOK},
+		{"POST", "/jobs", `{"toml":"type = \"webhook\""}`, http.StatusUnauthorized},
+		// the caller can't choose the scope of resources without a chain
+		{"GET", "/transactions", "", http.StatusUnauthorized},
+		{"GET", "/transactions?evmChainID=1", "", http.StatusUnauthorized},
+		{"POST", "/keys/eth/export/0x0?evmChainID=1", "", http.StatusForbidden},
+		{"POST", "/users?evmChainID=1", "", http.StatusForbidden},
+		{"GET", "/chains/evm/1", "", http.StatusOK},
+		{"GET", "/chains/evm/2", "", http.StatusForbidden},
+		{"GET", "/chains/evm/2?evmChainID=1", "", http.StatusForbidden},
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, mustRequest(t, tt.verb, tt.path, strings.NewReader(tt.body)))
+		assert.Equal(t, tt.code, w.Code, "%s %s %s", tt.verb, tt.path, tt.body)
+		if tt.code == http.StatusOK && tt.body != "" {
+			assert.Equal(t, tt.body, w.Body.String())
+		}
+	}
+}
+
 // Test RBAC (Role based access control) of each route and their required user roles
 // Admin is omitted from the fields here since admin should be able to access all routes
 type routeRules struct {
@@ -247,6 +334,9 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/transactions/evm/MOCK", true, true, true},
 	{"GET", "/v2/transactions", true, true, true},
 	{"GET", "/v2/transactions/MOCK", true, true, true},
+	{"POST", "/v2/transactions/evm/cancel", false, false, false},
+	{"POST", "/v2/transactions/evm/speed_up", false, false, false},
+	{"POST", "/v2/transactions/evm/replace", false, false, false},
 	{"POST", "/v2/replay_from_block/MOCK", false, true, true},
 	{"GET", "/v2/keys/csa", true, true, true},
 	{"POST", "/v2/keys/csa", false, false, true},
@@ -272,6 +362,9 @@ var routesRolesMap = [...]routeRules{
 	{"DELETE", "/v2/keys/p2p/MOCK", false, false, false},
 	{"POST", "/v2/keys/p2p/import", false, false, false},
 	{"POST", "/v2/keys/p2p/export/MOCK", false, false, false},
+	{"GET", "/v2/keys/rotations", true, true, true},
+	{"POST", "/v2/keys/ocr2/rotate/MOCK", false, false, false},
+	{"POST", "/v2/keys/p2p/rotate/MOCK", false, false, false},
 	{"GET", "/v2/keys/solana", true, true, true},
 	{"GET", "/v2/keys/cosmos", true, true, true},
 	{"GET", "/v2/keys/starknet", true, true, true},
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// maxScopedBodySize bounds the size of request bodies read to find their scope.
+const maxScopedBodySize = 1 << 20
+
+// ScopeFunc returns the scope of the resource a request acts on, e.g. the chain of a job, or "" if the request is
+// not within a scope. Permissions restricted to a scope only grant requests within the same scope.
+type ScopeFunc func(c *gin.Context) string
+
+func unscoped(*gin.Context) string { return "" }
+
+// ChainParamScope scopes requests to the chain identified by their ID path parameter, i.e. the chain they act on.
+func ChainParamScope(network string) ScopeFunc {
+	return func(c *gin.Context) string {
+		if chainID := c.Param("ID"); chainID != "" {
+			return network + "/" + chainID
+		}
+		return ""
+	}
+}
+
+// JobSpecBodyScope scopes requests to the chain of the job spec in the toml field of their JSON body. The body is
+// left in place for the handler.
+func JobSpecBodyScope(c *gin.Context) string {
+	if c.Request.Body == nil {
+		return ""
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxScopedBodySize))
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+	if err != nil {
+		return ""
+	}
+	var request struct {
+		TOML string `json:"toml"`
+	}
+	if err = json.Unmarshal(body, &request); err != nil {
+		return ""
+	}
+	scope, _ := JobSpecScope(request.TOML)
+	return scope
+}
+
+// JobSpecScope returns the chain scope of a job spec definition, i.e. evm/<chain ID> or <relay>/<chain ID>.
+func JobSpecScope(definition string) (string, bool) {
+	var spec struct {
+		EVMChainID  interface{} `toml:"evmChainID"`
+		Relay       string      `toml:"relay"`
+		ChainID     interface{} `toml:"chainID"`
+		RelayConfig struct {
+			ChainID interface{} `toml:"chainID"`
+		} `toml:"relayConfig"`
+	}
+	if err := toml.Unmarshal([]byte(definition), &spec); err != nil {
+		return "", false
+	}
+	switch {
+	case spec.Relay != "" && spec.ChainID != nil:
+		return fmt.Sprintf("%s/%v", spec.Relay, spec.ChainID), true
+	case spec.Relay != "" && spec.RelayConfig.ChainID != nil:
+		return fmt.Sprintf("%s/%v", spec.Relay, spec.RelayConfig.ChainID), true
+	case spec.EVMChainID != nil:
+		return fmt.Sprintf("evm/%v", spec.EVMChainID), true
+	default:
+		return "", false
+	}
+}
@@ -50,6 +50,27 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.CircuitFailureThreshold > 0 && bt.CircuitOpenDuration.Duration() <= 0 {
+		fe.Add("CircuitOpenDuration must be positive when CircuitFailureThreshold is set")
+	}
+	if bt.FallbackBridgeName != nil && *bt.FallbackBridgeName == bt.Name {
+		fe.Add("FallbackBridgeName must not be the bridge itself")
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+// ValidateBridgeTypeFallback checks that the fallback bridge, if any, exists.
+func ValidateBridgeTypeFallback(ctx context.Context, bt *bridges.BridgeTypeRequest, orm bridges.ORM) error {
+	if bt.FallbackBridgeName == nil {
+		return nil
+	}
+	fe := models.NewJSONAPIErrors()
+	_, err := orm.FindBridge(ctx, *bt.FallbackBridgeName)
+	if errors.Is(err, sql.ErrNoRows) {
+		fe.Add(fmt.Sprintf("Fallback Bridge Type %v does not exist", *bt.FallbackBridgeName))
+	} else if err != nil {
+		fe.Add(fmt.Sprintf("Error determining if fallback bridge type %v exists", *bt.FallbackBridgeName))
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -81,6 +102,10 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, e)
 		return
 	}
+	if e := ValidateBridgeTypeFallback(ctx, btr, orm); e != nil {
+		jsonAPIError(c, http.StatusBadRequest, e)
+		return
+	}
 	if e := orm.CreateBridgeType(ctx, bt); e != nil {
 		jsonAPIError(c, http.StatusInternalServerError, e)
 		return
@@ -116,7 +141,7 @@ func (btc *BridgeTypesController) Index(c *gin.Context, size, page, offset int)
 
 	var resources []presenters.BridgeResource
 	for _, bridge := range bridges {
-		resources = append(resources, *presenters.NewBridgeResource(bridge))
+		resources = append(resources, *btc.newBridgeResourceWithHealth(bridge))
 	}
 
 	paginatedResponse(c, "Bridges", size, page, resources, count, err)
@@ -143,7 +168,7 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, btc.newBridgeResourceWithHealth(bt), "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -177,6 +202,10 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	if err := ValidateBridgeTypeFallback(ctx, btr, orm); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
 	if err := orm.UpdateBridgeType(ctx, &bt, btr); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -189,7 +218,7 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		"bridgeURL":                    bt.URL,
 	})
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, btc.newBridgeResourceWithHealth(bt), "bridge")
 }
 
 // Destroy removes a specific Bridge.
@@ -231,3 +260,9 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
+
+func (btc *BridgeTypesController) newBridgeResourceWithHealth(bt bridges.BridgeType) *presenters.BridgeResource {
+	resource := presenters.NewBridgeResource(bt)
+	resource.Health = presenters.NewBridgeHealthResource(btc.App.BridgeHealth().Health(bt.Name, bt.ResiliencePolicy))
+	return resource
+}
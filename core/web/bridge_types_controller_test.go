@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -85,6 +86,42 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"valid resilience policy",
+			bridges.BridgeTypeRequest{
+				Name: "adapterwithpolicy",
+				URL:  cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				ResiliencePolicy: bridges.ResiliencePolicy{
+					CircuitFailureThreshold: 5,
+					CircuitOpenDuration:     models.Interval(time.Minute),
+					HedgeAfterP95:           true,
+					FallbackBridgeName:      ptr(bridges.BridgeName("fallback")),
+				},
+			},
+			nil,
+		},
+		{
+			"invalid circuit breaker without open duration",
+			bridges.BridgeTypeRequest{
+				Name: "adapterwithpolicy",
+				URL:  cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				ResiliencePolicy: bridges.ResiliencePolicy{
+					CircuitFailureThreshold: 5,
+				},
+			},
+			models.NewJSONAPIErrorsWith("CircuitOpenDuration must be positive when CircuitFailureThreshold is set"),
+		},
+		{
+			"invalid fallback to itself",
+			bridges.BridgeTypeRequest{
+				Name: "adapterwithpolicy",
+				URL:  cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				ResiliencePolicy: bridges.ResiliencePolicy{
+					FallbackBridgeName: ptr(bridges.BridgeName("adapterwithpolicy")),
+				},
+			},
+			models.NewJSONAPIErrorsWith("FallbackBridgeName must not be the bridge itself"),
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
@@ -293,6 +330,8 @@ func TestBridgeController_Show(t *testing.T) {
 	assert.Equal(t, bt.Name.String(), resource.Name, "should have the same name")
 	assert.Equal(t, bt.URL.String(), resource.URL, "should have the same URL")
 	assert.Equal(t, bt.Confirmations, resource.Confirmations, "should have the same Confirmations")
+	require.NotNil(t, resource.Health)
+	assert.Equal(t, bridges.CircuitDisabled, resource.Health.Circuit)
 
 	resp, cleanup = client.Get("/v2/bridge_types/nosuchbridge")
 	t.Cleanup(cleanup)
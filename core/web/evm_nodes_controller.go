@@ -1,6 +1,9 @@
 package web
 
 import (
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -10,5 +13,29 @@ func NewEVMNodesController(app chainlink.Application) NodesController {
 	scopedNodeStatuser := NewNetworkScopedNodeStatuser(app.GetRelayers(), relay.NetworkEVM)
 
 	return newNodesController[presenters.EVMNodeResource](
-		scopedNodeStatuser, ErrEVMNotEnabled, presenters.NewEVMNodeResource, app.GetAuditLogger())
+		scopedNodeStatuser, ErrEVMNotEnabled, newEVMNodeResourceWithStats(app), app.GetAuditLogger())
+}
+
+// newEVMNodeResourceWithStats returns a constructor of EVMNodeResources including the RPC statistics of the nodes,
+// which are not part of the node statuses reported by relayers.
+func newEVMNodeResourceWithStats(app chainlink.Application) func(types.NodeStatus) presenters.EVMNodeResource {
+	return func(status types.NodeStatus) presenters.EVMNodeResource {
+		r := presenters.NewEVMNodeResource(status)
+		chain, err := app.GetRelayers().LegacyEVMChains().Get(status.ChainID)
+		if err != nil {
+			return r
+		}
+		stats, ok := chain.Client().NodeRPCStats()[status.Name]
+		if !ok {
+			return r
+		}
+		nodePool := chain.Config().EVM().NodePool()
+		if nodePool.SelectionMode() == commonclient.NodeSelectionModeLatencyWeighted {
+			score := commonclient.LatencyWeightedScore(stats, nodePool.LatencyWeighted())
+			r.RPCStats = presenters.NewEVMNodeRPCStats(stats, &score)
+		} else {
+			r.RPCStats = presenters.NewEVMNodeRPCStats(stats, nil)
+		}
+		return r
+	}
 }
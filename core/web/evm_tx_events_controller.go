@@ -0,0 +1,131 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+// txEventsKeepAliveInterval is the interval of the comments sent to keep the stream open while no event is published.
+const txEventsKeepAliveInterval = 30 * time.Second
+
+// TxEventsController streams the state transitions and fee bumps of the EVM transactions.
+type TxEventsController struct {
+	App chainlink.Application
+}
+
+// Stream streams the transaction events of all the EVM chains, or of the chain given by the evmChainID query
+// parameter, as Server-Sent Events until the client disconnects.
+// Example:
+//
+//	"<application>/v2/tx_events/evm?evmChainID=1"
+func (tc *TxEventsController) Stream(c *gin.Context) {
+	var ids []string
+	if chainID := c.Query("evmChainID"); chainID != "" {
+		ids = append(ids, chainID)
+	}
+	chains, err := tc.App.GetRelayers().LegacyEVMChains().List(ids...)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if len(chains) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("no EVM chain found"))
+		return
+	}
+
+	// The stream outlives the write timeout of the web server.
+	if err = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		tc.App.GetLogger().Debugw("Failed to disable the write deadline of the transaction events stream", "err", err)
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	events := make(chan txmgr.TxEvent)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+	for _, chain := range chains {
+		ch, unsubscribe := chain.TxManager().SubscribeToTxEvents()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer unsubscribe()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case event, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case events <- event:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(txEventsKeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err = c.Writer.WriteString(": keep-alive\n\n"); err != nil {
+				return
+			}
+		case event := <-events:
+			c.SSEvent(string(event.Type), NewTxEventResponse(event))
+		}
+		c.Writer.Flush()
+	}
+}
+
+type TxEventResponse struct {
+	Type           string       `json:"type"`
+	EVMChainID     *big.Big     `json:"evmChainID"`
+	TxID           int64        `json:"txID"`
+	IdempotencyKey *string      `json:"idempotencyKey,omitempty"`
+	From           string       `json:"from"`
+	To             string       `json:"to"`
+	State          string       `json:"state"`
+	Hash           *common.Hash `json:"hash,omitempty"`
+	Fee            string       `json:"fee,omitempty"`
+	Error          string       `json:"error,omitempty"`
+	Timestamp      time.Time    `json:"timestamp"`
+}
+
+func NewTxEventResponse(event txmgr.TxEvent) TxEventResponse {
+	return TxEventResponse{
+		Type:           string(event.Type),
+		EVMChainID:     big.New(event.ChainID),
+		TxID:           event.TxID,
+		IdempotencyKey: event.IdempotencyKey,
+		From:           event.FromAddress.Hex(),
+		To:             event.ToAddress.Hex(),
+		State:          string(event.State),
+		Hash:           event.TxHash,
+		Fee:            event.Fee,
+		Error:          event.Error,
+		Timestamp:      event.Timestamp,
+	}
+}
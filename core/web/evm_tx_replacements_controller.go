@@ -0,0 +1,148 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	commontxmgr "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// EVMTxReplacementsController cancels, speeds up or replaces the unconfirmed EVM transactions stuck in the mempool.
+// The new attempts are sent and tracked by the Confirmer like the gas bumps.
+type EVMTxReplacementsController struct {
+	App chainlink.Application
+}
+
+// Cancel replaces the unconfirmed transaction with the given nonce with a self-send carrying no payload.
+// Example:
+//
+//	"<application>/transactions/evm/cancel"
+func (tc *EVMTxReplacementsController) Cancel(c *gin.Context) {
+	tr, chain, ok := tc.bind(c)
+	if !ok {
+		return
+	}
+	attempt, err := chain.TxManager().CancelTransaction(c, tr.FromAddress, evmtypes.Nonce(tr.Nonce))
+	tc.respond(c, audit.EthTransactionCancelled, attempt, err)
+}
+
+// SpeedUp sends a new attempt at the given fee for the unconfirmed transaction with the given nonce.
+// Example:
+//
+//	"<application>/transactions/evm/speed_up"
+func (tc *EVMTxReplacementsController) SpeedUp(c *gin.Context) {
+	tr, chain, ok := tc.bind(c)
+	if !ok {
+		return
+	}
+	fee, err := replacementFee(tr)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	attempt, err := chain.TxManager().SpeedUpTransaction(c, tr.FromAddress, evmtypes.Nonce(tr.Nonce), fee)
+	tc.respond(c, audit.EthTransactionSpedUp, attempt, err)
+}
+
+// Replace replaces the data and the gas limit of the unconfirmed transaction with the given nonce, and sends it at the
+// given fee.
+// Example:
+//
+//	"<application>/transactions/evm/replace"
+func (tc *EVMTxReplacementsController) Replace(c *gin.Context) {
+	tr, chain, ok := tc.bind(c)
+	if !ok {
+		return
+	}
+	fee, err := replacementFee(tr)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	attempt, err := chain.TxManager().ReplaceTransaction(c, tr.FromAddress, evmtypes.Nonce(tr.Nonce), tr.Data, fee, tr.GasLimit)
+	tc.respond(c, audit.EthTransactionReplaced, attempt, err)
+}
+
+func (tc *EVMTxReplacementsController) bind(c *gin.Context) (tr models.ReplaceEthTxRequest, chain legacyevm.Chain, ok bool) {
+	if err := c.ShouldBindJSON(&tr); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return tr, nil, false
+	}
+
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), tr.EVMChainID.String())
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return tr, nil, false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return tr, nil, false
+	}
+
+	if tr.FromAddress == utils.ZeroAddress {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("transaction source address is missing"))
+		return tr, nil, false
+	}
+	if tr.Nonce < 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid nonce: %d", tr.Nonce))
+		return tr, nil, false
+	}
+	return tr, chain, true
+}
+
+func (tc *EVMTxReplacementsController) respond(c *gin.Context, event audit.EventID, attempt txmgr.TxAttempt, err error) {
+	if errors.Is(err, commontxmgr.ErrTxNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if errors.Is(err, commontxmgr.ErrTxNotReplaceable) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+	if errors.Is(err, commonfee.ErrFeeBelowBump) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("transaction replacement failed: %w", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(event, map[string]interface{}{
+		"ethTX":  attempt.Tx,
+		"txHash": attempt.Hash,
+	})
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "eth_tx")
+}
+
+// replacementFee returns the legacy fee, or the dynamic fee of the request. The blob fee is only required to replace
+// blob transactions.
+func replacementFee(tr models.ReplaceEthTxRequest) (fee gas.EvmFee, err error) {
+	switch {
+	case tr.GasPrice != nil && (tr.MaxFeePerGas != nil || tr.MaxPriorityFeePerGas != nil):
+		return fee, errors.New("either gasPrice, or maxFeePerGas and maxPriorityFeePerGas must be set, not both")
+	case tr.GasPrice != nil:
+		fee.GasPrice = tr.GasPrice
+	case tr.MaxFeePerGas != nil && tr.MaxPriorityFeePerGas != nil:
+		fee.GasFeeCap = tr.MaxFeePerGas
+		fee.GasTipCap = tr.MaxPriorityFeePerGas
+		fee.BlobFeeCap = tr.MaxFeePerBlobGas
+	default:
+		return fee, errors.New("gasPrice, or maxFeePerGas and maxPriorityFeePerGas must be set")
+	}
+	return fee, nil
+}
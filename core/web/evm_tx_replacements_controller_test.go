@@ -0,0 +1,74 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestEVMTxReplacementsController(t *testing.T) {
+	t.Parallel()
+
+	key := cltest.MustGenerateRandomKey(t)
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	ethClient.On("PendingNonceAt", mock.Anything, key.Address).Return(uint64(0), nil).Maybe()
+
+	app := cltest.NewApplicationWithKey(t, ethClient, key)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, key.Address)
+
+	chainID := ubig.New(evmtest.MustGetDefaultChainID(t, app.Config.EVMConfigs()))
+	post := func(t *testing.T, path string, request models.ReplaceEthTxRequest) *http.Response {
+		body, err := json.Marshal(&request)
+		require.NoError(t, err)
+		resp, cleanup := client.Post(path, bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	t.Run("cancel fails if no transaction has the nonce", func(t *testing.T) {
+		resp := post(t, "/v2/transactions/evm/cancel", models.ReplaceEthTxRequest{FromAddress: key.Address, Nonce: 5, EVMChainID: chainID})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("cancel fails if the transaction is confirmed", func(t *testing.T) {
+		resp := post(t, "/v2/transactions/evm/cancel", models.ReplaceEthTxRequest{FromAddress: key.Address, Nonce: 0, EVMChainID: chainID})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("speed up requires a fee", func(t *testing.T) {
+		resp := post(t, "/v2/transactions/evm/speed_up", models.ReplaceEthTxRequest{FromAddress: key.Address, Nonce: 0, EVMChainID: chainID})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("replace rejects a legacy and a dynamic fee at once", func(t *testing.T) {
+		resp := post(t, "/v2/transactions/evm/replace", models.ReplaceEthTxRequest{
+			FromAddress:  key.Address,
+			Nonce:        0,
+			EVMChainID:   chainID,
+			GasPrice:     assets.GWei(10),
+			MaxFeePerGas: assets.GWei(10),
+		})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("requires the source address", func(t *testing.T) {
+		resp := post(t, "/v2/transactions/evm/cancel", models.ReplaceEthTxRequest{Nonce: 0, EVMChainID: chainID})
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+}
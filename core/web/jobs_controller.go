@@ -12,7 +12,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
 	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
@@ -27,6 +30,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -135,6 +139,43 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to dry-run the pipeline of a job spec with stubbed tasks.
+type SimulateJobRequest struct {
+	TOML       string `json:"toml"`
+	Simulation string `json:"simulation"`
+}
+
+// Simulate validates a job spec and executes its pipeline in-memory, with tasks replaced by the stubs
+// of the simulation. Nothing is persisted and the job is not created.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	sim, err := pipeline.ParseSimulation(request.Simulation)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	run, _, err := jc.App.SimulateJobV2(c.Request.Context(), jb, sim)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(*run, jc.App.GetLogger()), "pipelineRun")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -270,3 +311,66 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 	}
 	return jb, 0, nil
 }
+
+// JobScope scopes requests to the chain of the stored job of their ID path parameter, which may be a job ID or an
+// external job ID. Requests on jobs which can't be found are not within a scope.
+func (jc *JobsController) JobScope(c *gin.Context) string {
+	ctx := c.Request.Context()
+	var jb job.Job
+	var err error
+	if externalJobID, pErr := uuid.Parse(c.Param("ID")); pErr == nil {
+		jb, err = jc.App.JobORM().FindJobByExternalJobID(ctx, externalJobID)
+	} else if err = jb.SetID(c.Param("ID")); err == nil {
+		jb, err = jc.App.JobORM().FindJob(ctx, jb.ID)
+	}
+	if err != nil {
+		return ""
+	}
+	return jobScope(jb)
+}
+
+// jobScope returns the chain scope of a stored job, i.e. evm/<chain ID> or <relay>/<chain ID>, or "" if the job
+// does not run on a chain.
+func jobScope(jb job.Job) string {
+	var chainID *ubig.Big
+	switch {
+	case jb.OCR2OracleSpec != nil:
+		return relayScope(jb.OCR2OracleSpec.RelayID())
+	case jb.BootstrapSpec != nil:
+		spec := jb.BootstrapSpec.AsOCR2Spec()
+		return relayScope(spec.RelayID())
+	case jb.OCROracleSpec != nil:
+		chainID = jb.OCROracleSpec.EVMChainID
+	case jb.DirectRequestSpec != nil:
+		chainID = jb.DirectRequestSpec.EVMChainID
+	case jb.CronSpec != nil:
+		chainID = jb.CronSpec.EVMChainID
+	case jb.FluxMonitorSpec != nil:
+		chainID = jb.FluxMonitorSpec.EVMChainID
+	case jb.KeeperSpec != nil:
+		chainID = jb.KeeperSpec.EVMChainID
+	case jb.VRFSpec != nil:
+		chainID = jb.VRFSpec.EVMChainID
+	case jb.BlockhashStoreSpec != nil:
+		chainID = jb.BlockhashStoreSpec.EVMChainID
+	case jb.BlockHeaderFeederSpec != nil:
+		chainID = jb.BlockHeaderFeederSpec.EVMChainID
+	case jb.LegacyGasStationServerSpec != nil:
+		chainID = jb.LegacyGasStationServerSpec.EVMChainID
+	case jb.LegacyGasStationSidecarSpec != nil:
+		chainID = jb.LegacyGasStationSidecarSpec.EVMChainID
+	case jb.EALSpec != nil:
+		chainID = jb.EALSpec.EVMChainID
+	}
+	if chainID == nil {
+		return ""
+	}
+	return "evm/" + chainID.String()
+}
+
+func relayScope(id types.RelayID, err error) string {
+	if err != nil {
+		return ""
+	}
+	return id.Network + "/" + id.ChainID
+}
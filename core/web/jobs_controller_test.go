@@ -9,13 +9,16 @@ import (
 	"io"
 	"math/big"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/hashicorp/consul/sdk/freeport"
 	"github.com/pelletier/go-toml"
@@ -30,16 +33,21 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	coremocks "github.com/smartcontractkit/chainlink/v2/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	jobORMMocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -522,6 +530,58 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	// bridges are stubbed, so they don't need to exist
+	tomlStr := testspecs.GetWebhookSpecNoBody(uuid.New(), "fetch_bridge", "submit_bridge")
+
+	t.Run("returns the simulated run", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulateJobRequest{
+			TOML: tomlStr,
+			Simulation: `
+[tasks.fetch]
+fixture = '{"data": {"result": 1.5}}'
+
+[tasks.submit]
+output = "ok"
+`,
+		})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		resource := presenters.PipelineRunResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		require.Len(t, resource.TaskRuns, 4)
+		for _, tr := range resource.TaskRuns {
+			if tr.DotID == "multiply" {
+				require.NotNil(t, tr.Output)
+				assert.Equal(t, "150", *tr.Output)
+			}
+		}
+		assert.Equal(t, []*string{nil}, resource.FatalErrors)
+
+		jobs, _, err := app.JobORM().FindJobs(testutils.Context(t), 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+
+	t.Run("fails on missing stubs", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulateJobRequest{
+			TOML:       tomlStr,
+			Simulation: "[tasks.fetch]\noutput = 1\n",
+		})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+	})
+}
+
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
@@ -742,6 +802,57 @@ func TestJobsController_Update_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_OutOfScope(t *testing.T) {
+	t.Parallel()
+
+	roles, err := sessions.NewRoles(sessions.Role{Name: "evm-1", Permissions: []sessions.Permission{
+		{Resource: sessions.ResourceJobs, Action: sessions.ActionEdit, Scope: "evm/1"},
+	}})
+	require.NoError(t, err)
+
+	app := coremocks.NewApplication(t)
+	orm := jobORMMocks.NewORM(t)
+	app.On("JobORM").Return(orm)
+	orm.On("FindJob", mock.Anything, int32(1)).Return(job.Job{ID: 1, OCROracleSpec: &job.OCROracleSpec{EVMChainID: ubig.NewI(1)}}, nil)
+	orm.On("FindJob", mock.Anything, int32(137)).Return(job.Job{ID: 137, OCROracleSpec: &job.OCROracleSpec{EVMChainID: ubig.NewI(137)}}, nil)
+	// only the job on chain 1 may be deleted
+	app.On("DeleteJob", mock.Anything, int32(1)).Return(nil).Once()
+	app.On("GetAuditLogger").Return(audit.NoopLogger)
+
+	jc := web.JobsController{App: app}
+	router := gin.New()
+	router.Use(webauth.WithRoles(roles), func(c *gin.Context) {
+		c.Set(webauth.SessionUserKey, &sessions.User{Role: "evm-1"})
+	})
+	// same as the routes of the router
+	router.PUT("/jobs/:ID", webauth.RequiresScopedPermission(sessions.ResourceJobs, sessions.ActionEdit, jc.JobScope,
+		webauth.RequiresScopedPermission(sessions.ResourceJobs, sessions.ActionEdit, webauth.JobSpecBodyScope, jc.Update)))
+	router.DELETE("/jobs/:ID", webauth.RequiresScopedPermission(sessions.ResourceJobs, sessions.ActionEdit, jc.JobScope, jc.Delete))
+
+	spec := func(chainID int) string {
+		body, err := json.Marshal(web.UpdateJobRequest{TOML: fmt.Sprintf("type = \"offchainreporting\"\nevmChainID = %d", chainID)})
+		require.NoError(t, err)
+		return string(body)
+	}
+	for _, tt := range []struct {
+		name, verb, path, body string
+		code                   int
+	}{
+		{"replace job of another chain", "PUT", "/jobs/137", spec(1), http.StatusForbidden},
+		{"move job to another chain", "PUT", "/jobs/1", spec(137), http.StatusForbidden},
+		{"delete job of another chain", "DELETE", "/jobs/137", "", http.StatusForbidden},
+		{"delete job", "DELETE", "/jobs/1", "", http.StatusNoContent},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.verb, tt.path, strings.NewReader(tt.body))
+			require.NoError(t, err)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OCROracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
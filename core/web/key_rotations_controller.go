@@ -0,0 +1,93 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeyRotationsController rotates OCR2 key bundles and P2P keys
+type KeyRotationsController struct {
+	App chainlink.Application
+}
+
+// Index lists the key rotations
+// Example:
+// "GET <application>/keys/rotations"
+func (krc *KeyRotationsController) Index(c *gin.Context) {
+	rotations, err := krc.App.KeyRotator().Rotations(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	rs := []presenters.KeyRotationResource{}
+	for _, rot := range rotations {
+		rs = append(rs, *krc.newResource(rot))
+	}
+	jsonAPIResponse(c, rs, "keyRotations")
+}
+
+// RotateOCR2 starts the rotation of an OCR2 key bundle
+// Example:
+// "POST <application>/keys/ocr2/rotate/:ID"
+func (krc *KeyRotationsController) RotateOCR2(c *gin.Context) {
+	id := c.Param("ID")
+	if _, err := krc.App.GetKeyStore().OCR2().Get(id); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	rot, err := krc.App.KeyRotator().RotateOCR2(c.Request.Context(), id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	krc.App.GetAuditLogger().Audit(audit.KeyRotated, map[string]interface{}{
+		"type":     "ocr2",
+		"id":       rot.OldKeyID,
+		"newKeyID": rot.NewKeyID,
+	})
+	jsonAPIResponse(c, krc.newResource(rot), "keyRotations")
+}
+
+// RotateP2P starts the rotation of a P2P key
+// Example:
+// "POST <application>/keys/p2p/rotate/:ID"
+func (krc *KeyRotationsController) RotateP2P(c *gin.Context) {
+	peerID, err := p2pkey.MakePeerID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if _, err = krc.App.GetKeyStore().P2P().Get(peerID); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	rot, err := krc.App.KeyRotator().RotateP2P(c.Request.Context(), peerID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	krc.App.GetAuditLogger().Audit(audit.KeyRotated, map[string]interface{}{
+		"type":     "p2p",
+		"id":       rot.OldKeyID,
+		"newKeyID": rot.NewKeyID,
+	})
+	jsonAPIResponse(c, krc.newResource(rot), "keyRotations")
+}
+
+func (krc *KeyRotationsController) newResource(rot keyrotation.Rotation) *presenters.KeyRotationResource {
+	var successor ocr2key.KeyBundle
+	if rot.KeyType == keyrotation.KeyTypeOCR2 {
+		successor, _ = krc.App.GetKeyStore().OCR2().Get(rot.NewKeyID)
+	}
+	return presenters.NewKeyRotationResource(rot, successor)
+}
@@ -0,0 +1,89 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeyRotationsController_RotateOCR2(t *testing.T) {
+	client, ks := setupKeyRotationsControllerTests(t)
+
+	response, cleanup := client.Post("/v2/keys/ocr2/rotate/"+cltest.DefaultOCR2Key.ID(), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.KeyRotationResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.Equal(t, "ocr2", resource.KeyType)
+	assert.Equal(t, cltest.DefaultOCR2Key.ID(), resource.OldKeyID)
+	assert.Equal(t, "pending", resource.State)
+	assert.Empty(t, resource.Jobs)
+
+	successor, err := ks.OCR2().Get(resource.NewKeyID)
+	require.NoError(t, err)
+	assert.Equal(t, presenters.NewOCR2KeysBundleResource(successor).OffChainPublicKey, resource.OffChainPublicKey)
+
+	response, cleanup = client.Get("/v2/keys/rotations")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resources := []presenters.KeyRotationResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+	require.Len(t, resources, 1)
+	assert.Equal(t, resource.ID, resources[0].ID)
+}
+
+func TestKeyRotationsController_RotateP2P(t *testing.T) {
+	client, ks := setupKeyRotationsControllerTests(t)
+
+	response, cleanup := client.Post("/v2/keys/p2p/rotate/"+cltest.DefaultP2PKey.PeerID().String(), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.KeyRotationResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.Equal(t, "p2p", resource.KeyType)
+	assert.Equal(t, cltest.DefaultP2PKey.ID(), resource.OldKeyID)
+
+	peerID, err := p2pkey.MakePeerID(resource.NewKeyID)
+	require.NoError(t, err)
+	_, err = ks.P2P().Get(peerID)
+	require.NoError(t, err)
+	assert.Equal(t, peerID.String(), resource.PeerID)
+}
+
+func TestKeyRotationsController_Rotate_NonExistentKey(t *testing.T) {
+	client, _ := setupKeyRotationsControllerTests(t)
+
+	response, cleanup := client.Post("/v2/keys/ocr2/rotate/eb81f4a35033ac8dd68b9d33a039a713d6fd639af6852b81f47ffeda1c95de54", nil)
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	response, cleanup = client.Post("/v2/keys/p2p/rotate/12D3KooWL1yndUw9T2oWXjhfjdwSscWA78YCpUdduA3Cnn4dCtph", nil)
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func setupKeyRotationsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, keystore.Master) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	require.NoError(t, app.KeyStore.OCR2().Add(ctx, cltest.DefaultOCR2Key))
+	require.NoError(t, app.KeyStore.P2P().Add(ctx, cltest.DefaultP2PKey))
+
+	return client, app.GetKeyStore()
+}
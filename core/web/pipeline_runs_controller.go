@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -85,6 +86,51 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// Captures returns the http and bridge traffic captured for a pipeline run.
+// Example:
+// "GET <application>/pipeline/runs/:runID/captures"
+func (prc *PipelineRunsController) Captures(c *gin.Context) {
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	captures, err := prc.App.PipelineORM().FindHTTPCaptures(c.Request.Context(), pipelineRun.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineHTTPCaptureResources(captures), "pipelineHTTPCapture")
+}
+
+// Replay re-executes a saved pipeline run against its captured http and bridge traffic.
+// Nothing is persisted, the replayed run is returned.
+// Example:
+// "POST <application>/pipeline/runs/:runID/replay"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	replay, _, err := prc.App.ReplayPipelineRun(c.Request.Context(), pipelineRun.ID)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunResource(*replay, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
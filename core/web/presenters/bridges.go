@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -18,6 +19,36 @@ type BridgeResource struct {
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
 	CreatedAt              time.Time    `json:"createdAt"`
+
+	CircuitFailureThreshold uint32              `json:"circuitFailureThreshold"`
+	CircuitOpenDuration     models.Interval     `json:"circuitOpenDuration"`
+	HedgeAfterP95           bool                `json:"hedgeAfterP95"`
+	FallbackBridgeName      *bridges.BridgeName `json:"fallbackBridgeName"`
+	// Health is only provided when reading Bridges
+	Health *BridgeHealthResource `json:"health,omitempty"`
+}
+
+// BridgeHealthResource represents the state of a Bridge, shared by all the jobs using it.
+type BridgeHealthResource struct {
+	Circuit             bridges.CircuitState `json:"circuit"`
+	ConsecutiveFailures uint32               `json:"consecutiveFailures"`
+	OpenedAt            *time.Time           `json:"openedAt"`
+	LatencyP95          *models.Interval     `json:"latencyP95"`
+}
+
+// NewBridgeHealthResource constructs a new BridgeHealthResource
+func NewBridgeHealthResource(h bridges.Health) *BridgeHealthResource {
+	r := &BridgeHealthResource{
+		Circuit:             h.Circuit,
+		ConsecutiveFailures: h.ConsecutiveFailures,
+	}
+	if !h.OpenedAt.IsZero() {
+		r.OpenedAt = &h.OpenedAt
+	}
+	if h.LatencyP95 > 0 {
+		r.LatencyP95 = models.NewInterval(h.LatencyP95)
+	}
+	return r
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -36,5 +67,10 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
 		CreatedAt:              b.CreatedAt,
+
+		CircuitFailureThreshold: b.CircuitFailureThreshold,
+		CircuitOpenDuration:     b.CircuitOpenDuration,
+		HedgeAfterP95:           b.HedgeAfterP95,
+		FallbackBridgeName:      b.FallbackBridgeName,
 	}
 }
@@ -46,7 +46,11 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
-			"createdAt":"2000-01-01T00:00:00Z"
+			"createdAt":"2000-01-01T00:00:00Z",
+			"circuitFailureThreshold":0,
+			"circuitOpenDuration":"0s",
+			"hedgeAfterP95":false,
+			"fallbackBridgeName":null
 		}
 	}
 }
@@ -71,7 +75,58 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
-			"createdAt":"2000-01-01T00:00:00Z"
+			"createdAt":"2000-01-01T00:00:00Z",
+			"circuitFailureThreshold":0,
+			"circuitOpenDuration":"0s",
+			"hedgeAfterP95":false,
+			"fallbackBridgeName":null
+		}
+	}
+}
+`
+
+	assert.JSONEq(t, expected, string(b))
+
+	// Test insertion of the resilience policy and health
+	fallback := bridges.BridgeName("fallback")
+	bridge.ResiliencePolicy = bridges.ResiliencePolicy{
+		CircuitFailureThreshold: 3,
+		CircuitOpenDuration:     models.Interval(time.Minute),
+		HedgeAfterP95:           true,
+		FallbackBridgeName:      &fallback,
+	}
+	r = NewBridgeResource(bridge)
+	r.Health = NewBridgeHealthResource(bridges.Health{
+		Circuit:             bridges.CircuitOpen,
+		ConsecutiveFailures: 3,
+		OpenedAt:            timestamp,
+		LatencyP95:          250 * time.Millisecond,
+	})
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected = `
+{
+	"data": {
+		"type":"bridges",
+		"id":"test",
+		"attributes":{
+			"name":"test",
+			"url":"https://bridge.example.com/api",
+			"confirmations":1,
+			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+			"minimumContractPayment":"1",
+			"createdAt":"2000-01-01T00:00:00Z",
+			"circuitFailureThreshold":3,
+			"circuitOpenDuration":"1m0s",
+			"hedgeAfterP95":true,
+			"fallbackBridgeName":"fallback",
+			"health":{
+				"circuit":"open",
+				"consecutiveFailures":3,
+				"openedAt":"2000-01-01T00:00:00Z",
+				"latencyP95":"250ms"
+			}
 		}
 	}
 }
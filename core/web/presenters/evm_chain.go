@@ -1,6 +1,12 @@
 package presenters
 
-import "github.com/smartcontractkit/chainlink-common/pkg/types"
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
 
 // EVMChainResource is an EVM chain JSONAPI resource.
 type EVMChainResource struct {
@@ -24,6 +30,31 @@ func NewEVMChainResource(chain types.ChainStatus) EVMChainResource {
 // EVMNodeResource is an EVM node JSONAPI resource.
 type EVMNodeResource struct {
 	NodeResource
+	// RPCStats are only set for the primary nodes of running chains
+	RPCStats *EVMNodeRPCStats `json:"rpcStats,omitempty"`
+}
+
+// EVMNodeRPCStats are the rolling latency and error statistics of the calls made to the RPC of an EVM node.
+type EVMNodeRPCStats struct {
+	LatencyP50 string  `json:"latencyP50"`
+	LatencyP99 string  `json:"latencyP99"`
+	ErrorRatio float64 `json:"errorRatio"`
+	// Score is only set when the LatencyWeighted selection mode is used
+	Score *string `json:"score,omitempty"`
+}
+
+// NewEVMNodeRPCStats returns the EVMNodeRPCStats of a node, with its score if not nil.
+func NewEVMNodeRPCStats(stats commonclient.RPCStats, score *time.Duration) *EVMNodeRPCStats {
+	s := &EVMNodeRPCStats{
+		LatencyP50: stats.LatencyP50.String(),
+		LatencyP99: stats.LatencyP99.String(),
+		ErrorRatio: stats.ErrorRatio,
+	}
+	if score != nil {
+		scoreStr := score.String()
+		s.Score = &scoreStr
+	}
+	return s
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -33,7 +64,7 @@ func (r EVMNodeResource) GetName() string {
 
 // NewEVMNodeResource returns a new EVMNodeResource for node.
 func NewEVMNodeResource(node types.NodeStatus) EVMNodeResource {
-	return EVMNodeResource{NodeResource{
+	return EVMNodeResource{NodeResource: NodeResource{
 		JAID:    NewPrefixedJAID(node.Name, node.ChainID),
 		ChainID: node.ChainID,
 		Name:    node.Name,
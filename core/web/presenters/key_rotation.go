@@ -0,0 +1,79 @@
+package presenters
+
+import (
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
+)
+
+// KeyRotationResource represents a rotation of an OCR2 key bundle or of a P2P key as JSONAPI resource. The public keys
+// of the successor are to be set in the onchain config of the jobs.
+type KeyRotationResource struct {
+	JAID
+	KeyType           string                   `json:"keyType"`
+	OldKeyID          string                   `json:"oldKeyID"`
+	NewKeyID          string                   `json:"newKeyID"`
+	State             string                   `json:"state"`
+	OnchainPublicKey  string                   `json:"onchainPublicKey,omitempty"`
+	OffChainPublicKey string                   `json:"offchainPublicKey,omitempty"`
+	ConfigPublicKey   string                   `json:"configPublicKey,omitempty"`
+	PeerID            string                   `json:"peerID,omitempty"`
+	Jobs              []KeyRotationJobResource `json:"jobs"`
+	CreatedAt         time.Time                `json:"createdAt"`
+	ArchivedAt        *time.Time               `json:"archivedAt"`
+}
+
+// KeyRotationJobResource is a job using the rotated key.
+type KeyRotationJobResource struct {
+	JobID        int32      `json:"jobID"`
+	ConfigDigest string     `json:"configDigest,omitempty"`
+	SwitchedAt   *time.Time `json:"switchedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeyRotationResource) GetName() string {
+	return "keyRotations"
+}
+
+// NewKeyRotationResource constructs a new KeyRotationResource. The successor key bundle of OCR2 rotations is nil when
+// it was deleted.
+func NewKeyRotationResource(rot keyrotation.Rotation, successor ocr2key.KeyBundle) *KeyRotationResource {
+	r := &KeyRotationResource{
+		JAID:      NewJAID(strconv.FormatInt(rot.ID, 10)),
+		KeyType:   string(rot.KeyType),
+		OldKeyID:  rot.OldKeyID,
+		NewKeyID:  rot.NewKeyID,
+		State:     string(rot.State),
+		Jobs:      []KeyRotationJobResource{},
+		CreatedAt: rot.CreatedAt,
+	}
+	if rot.ArchivedAt.Valid {
+		r.ArchivedAt = &rot.ArchivedAt.Time
+	}
+	if successor != nil {
+		bundle := NewOCR2KeysBundleResource(successor)
+		r.OnchainPublicKey = bundle.OnchainPublicKey
+		r.OffChainPublicKey = bundle.OffChainPublicKey
+		r.ConfigPublicKey = bundle.ConfigPublicKey
+	}
+	if rot.KeyType == keyrotation.KeyTypeP2P {
+		if peerID, err := p2pkey.MakePeerID(rot.NewKeyID); err == nil {
+			r.PeerID = peerID.String()
+		}
+	}
+	for _, j := range rot.Jobs {
+		jr := KeyRotationJobResource{JobID: j.JobID}
+		if j.ConfigDigest != nil {
+			jr.ConfigDigest = hex.EncodeToString(j.ConfigDigest)
+		}
+		if j.SwitchedAt.Valid {
+			jr.SwitchedAt = &j.SwitchedAt.Time
+		}
+		r.Jobs = append(r.Jobs, jr)
+	}
+	return r
+}
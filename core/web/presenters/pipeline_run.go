@@ -1,6 +1,7 @@
 package presenters
 
 import (
+	"net/http"
 	"time"
 
 	"gopkg.in/guregu/null.v4"
@@ -104,3 +105,53 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineHTTPCaptureResource represents the traffic captured for an http or bridge task of a pipeline run
+type PipelineHTTPCaptureResource struct {
+	JAID
+	TaskRunID       string      `json:"taskRunId"`
+	DotID           string      `json:"dotId"`
+	Method          string      `json:"method"`
+	URL             string      `json:"url"`
+	RequestHeaders  http.Header `json:"requestHeaders"`
+	RequestBody     string      `json:"requestBody"`
+	StatusCode      int         `json:"statusCode"`
+	ResponseHeaders http.Header `json:"responseHeaders"`
+	ResponseBody    string      `json:"responseBody"`
+	Latency         string      `json:"latency"`
+	Error           *string     `json:"error"`
+	CreatedAt       time.Time   `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineHTTPCaptureResource) GetName() string {
+	return "pipelineHTTPCapture"
+}
+
+// NewPipelineHTTPCaptureResource constructs a new PipelineHTTPCaptureResource
+func NewPipelineHTTPCaptureResource(c pipeline.HTTPCapture) PipelineHTTPCaptureResource {
+	return PipelineHTTPCaptureResource{
+		JAID:            NewJAIDInt64(c.ID),
+		TaskRunID:       c.PipelineTaskRunID.String(),
+		DotID:           c.DotID,
+		Method:          c.Method,
+		URL:             c.URL,
+		RequestHeaders:  http.Header(c.RequestHeaders),
+		RequestBody:     string(c.RequestBody),
+		StatusCode:      c.StatusCode,
+		ResponseHeaders: http.Header(c.ResponseHeaders),
+		ResponseBody:    string(c.ResponseBody),
+		Latency:         c.Latency.Duration().String(),
+		Error:           c.Error.Ptr(),
+		CreatedAt:       c.CreatedAt,
+	}
+}
+
+// NewPipelineHTTPCaptureResources constructs the resources of all the captures of a run
+func NewPipelineHTTPCaptureResources(captures []pipeline.HTTPCapture) []PipelineHTTPCaptureResource {
+	var out []PipelineHTTPCaptureResource
+	for _, c := range captures {
+		out = append(out, NewPipelineHTTPCaptureResource(c))
+	}
+	return out
+}
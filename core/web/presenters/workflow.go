@@ -0,0 +1,20 @@
+package presenters
+
+// WorkflowResource represents the operator controlled state of a running workflow.
+type WorkflowResource struct {
+	JAID
+	Paused bool `json:"paused"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowResource) GetName() string {
+	return "workflow"
+}
+
+// NewWorkflowResource constructs a new WorkflowResource
+func NewWorkflowResource(workflowID string, paused bool) WorkflowResource {
+	return WorkflowResource{
+		JAID:   NewJAID(workflowID),
+		Paused: paused,
+	}
+}
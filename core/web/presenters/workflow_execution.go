@@ -0,0 +1,107 @@
+package presenters
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents a workflow execution along with its steps.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	Steps      []WorkflowExecutionStepResource `json:"steps"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecution"
+}
+
+// WorkflowExecutionStepResource represents a step of a workflow execution. CreatedAt is when the step was started,
+// UpdatedAt when its status last changed.
+type WorkflowExecutionStepResource struct {
+	Ref       string     `json:"ref"`
+	Status    string     `json:"status"`
+	Inputs    any        `json:"inputs"`
+	Outputs   any        `json:"outputs"`
+	Error     *string    `json:"error"`
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource, steps are ordered by start time.
+func NewWorkflowExecutionResource(we store.WorkflowExecution) (WorkflowExecutionResource, error) {
+	steps := make([]WorkflowExecutionStepResource, 0, len(we.Steps))
+	for _, s := range we.Steps {
+		step, err := NewWorkflowExecutionStepResource(*s)
+		if err != nil {
+			return WorkflowExecutionResource{}, err
+		}
+		steps = append(steps, step)
+	}
+	slices.SortFunc(steps, func(a, b WorkflowExecutionStepResource) int {
+		if a.CreatedAt != nil && b.CreatedAt != nil {
+			if c := a.CreatedAt.Compare(*b.CreatedAt); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.Ref, b.Ref)
+	})
+
+	return WorkflowExecutionResource{
+		JAID:       NewJAID(we.ExecutionID),
+		WorkflowID: we.WorkflowID,
+		Status:     we.Status,
+		Steps:      steps,
+		CreatedAt:  we.CreatedAt,
+		UpdatedAt:  we.UpdatedAt,
+		FinishedAt: we.FinishedAt,
+	}, nil
+}
+
+// NewWorkflowExecutionResources constructs a slice of WorkflowExecutionResources.
+func NewWorkflowExecutionResources(wes []store.WorkflowExecution) ([]WorkflowExecutionResource, error) {
+	rs := make([]WorkflowExecutionResource, 0, len(wes))
+	for _, we := range wes {
+		r, err := NewWorkflowExecutionResource(we)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+// NewWorkflowExecutionStepResource constructs a new WorkflowExecutionStepResource, inputs and outputs are unwrapped
+// into plain values.
+func NewWorkflowExecutionStepResource(s store.WorkflowExecutionStep) (WorkflowExecutionStepResource, error) {
+	r := WorkflowExecutionStepResource{
+		Ref:       s.Ref,
+		Status:    s.Status,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+	var err error
+	if s.Inputs != nil {
+		if r.Inputs, err = s.Inputs.Unwrap(); err != nil {
+			return r, err
+		}
+	}
+	if r.Outputs, err = values.Unwrap(s.Outputs.Value); err != nil {
+		return r, err
+	}
+	if s.Outputs.Err != nil {
+		msg := s.Outputs.Err.Error()
+		r.Error = &msg
+	}
+	return r, nil
+}
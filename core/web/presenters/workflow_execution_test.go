@@ -0,0 +1,92 @@
+package presenters
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestWorkflowExecutionResource(t *testing.T) {
+	t.Parallel()
+
+	started := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	finished := started.Add(time.Second)
+	inputs, err := values.NewMap(map[string]any{"feedID": "0x01"})
+	require.NoError(t, err)
+	outputs, err := values.Wrap(map[string]any{"price": int64(42)})
+	require.NoError(t, err)
+
+	r, err := NewWorkflowExecutionResource(store.WorkflowExecution{
+		ExecutionID: "execution",
+		WorkflowID:  "workflow",
+		Status:      store.StatusErrored,
+		CreatedAt:   &started,
+		UpdatedAt:   &finished,
+		FinishedAt:  &finished,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"write": {
+				Ref:       "write",
+				Status:    store.StatusErrored,
+				Outputs:   store.StepOutput{Err: errors.New("write failed")},
+				CreatedAt: &finished,
+				UpdatedAt: &finished,
+			},
+			"trigger": {
+				Ref:       "trigger",
+				Status:    store.StatusCompleted,
+				Inputs:    inputs,
+				Outputs:   store.StepOutput{Value: outputs},
+				CreatedAt: &started,
+				UpdatedAt: &finished,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+{
+	"data": {
+		"type": "workflowExecution",
+		"id": "execution",
+		"attributes": {
+			"workflowID": "workflow",
+			"status": "errored",
+			"steps": [
+				{
+					"ref": "trigger",
+					"status": "completed",
+					"inputs": {"feedID": "0x01"},
+					"outputs": {"price": 42},
+					"error": null,
+					"createdAt": "2000-01-01T00:00:00Z",
+					"updatedAt": "2000-01-01T00:00:01Z"
+				},
+				{
+					"ref": "write",
+					"status": "errored",
+					"inputs": null,
+					"outputs": null,
+					"error": "write failed",
+					"createdAt": "2000-01-01T00:00:01Z",
+					"updatedAt": "2000-01-01T00:00:01Z"
+				}
+			],
+			"createdAt": "2000-01-01T00:00:00Z",
+			"updatedAt": "2000-01-01T00:00:01Z",
+			"finishedAt": "2000-01-01T00:00:01Z"
+		}
+	}
+}
+`
+	assert.JSONEq(t, expected, string(b))
+}
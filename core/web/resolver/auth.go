@@ -8,7 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
-// Authenticates the user from the session cookie, presence of user inherently provides 'view' access.
+// Authenticates the user from the session cookie, presence of user is all that's required, e.g. to manage their own account.
 func authenticateUser(ctx context.Context) error {
 	if _, ok := auth.GetGQLAuthenticatedSession(ctx); !ok {
 		return unauthorizedError{}
@@ -16,39 +16,19 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
-	}
-	if session.User.Role == sessions.UserRoleView {
-		return RoleNotPermittedErr{session.User.Role}
-	}
-	return nil
-}
-
-// Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
-	}
-	switch session.User.Role {
-	case sessions.UserRoleView, sessions.UserRoleRun:
-		return RoleNotPermittedErr{session.User.Role}
-	default:
-	}
-	return nil
+// Authenticates the user from the session cookie and asserts their role allows the action on the resource.
+func authorizeUser(ctx context.Context, resource sessions.Resource, action sessions.Action) error {
+	return authorizeUserWithinScope(ctx, resource, action, "")
 }
 
-// Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
+// Authenticates the user from the session cookie and asserts their role allows the action on the resource
+// within the scope, e.g. the chain of a job.
+func authorizeUserWithinScope(ctx context.Context, resource sessions.Resource, action sessions.Action, scope string) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	if session.User.Role != sessions.UserRoleAdmin {
+	if !auth.GetRoles(ctx).Permitted(session.User.Role, resource, action, scope) {
 		return RoleNotPermittedErr{session.User.Role}
 	}
 	return nil
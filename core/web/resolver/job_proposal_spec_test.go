@@ -6,9 +6,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/graph-gophers/graphql-go"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
 func TestResolver_ApproveJobProposalSpec(t *testing.T) {
@@ -464,3 +469,48 @@ func TestResolver_GetJobProposal_Spec(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_UpdateJobProposalSpecDefinition_Scoped(t *testing.T) {
+	t.Parallel()
+
+	roles, err := clsessions.NewRoles(clsessions.Role{Name: "evm-1-approver", Permissions: []clsessions.Permission{
+		{Resource: clsessions.ResourceJobProposals, Action: clsessions.ActionEdit, Scope: "evm/1"},
+	}})
+	require.NoError(t, err)
+	specID := int64(1)
+
+	for _, tc := range []struct {
+		name       string
+		definition string
+		allowed    bool
+	}{
+		{"within scope", "evmChainID = 1", true},
+		{"moved to another chain", "evmChainID = 2", false},
+		{"without chain", "", false},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := setupFramework(t)
+			ctx := auth.WithGQLAuthenticatedSession(testutils.Context(t), clsessions.User{Email: "scoped@chain.link", Role: "evm-1-approver"}, "scopedSession")
+			ctx = auth.ContextWithRoles(ctx, roles)
+			f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+			f.Mocks.feedsSvc.On("GetSpec", mock.Anything, specID).Return(&feeds.JobProposalSpec{ID: specID, Definition: "evmChainID = 1"}, nil)
+			if tc.allowed {
+				f.Mocks.feedsSvc.On("UpdateSpecDefinition", mock.Anything, specID, tc.definition).Return(nil)
+			}
+
+			r := &Resolver{App: f.App}
+			_, err := r.UpdateJobProposalSpecDefinition(ctx, struct {
+				ID    graphql.ID
+				Input *struct{ Definition string }
+			}{ID: "1", Input: &struct{ Definition string }{Definition: tc.definition}})
+			if tc.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorAs(t, err, &RoleNotPermittedErr{})
+			}
+		})
+	}
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -63,7 +64,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceBridges, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -113,7 +114,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -137,7 +138,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -180,7 +181,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceFeedsManagers, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -265,7 +266,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceFeedsManagers, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -321,7 +322,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceFeedsManagers, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -405,7 +406,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceFeedsManagers, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -458,7 +459,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceBridges, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -496,6 +497,8 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	// The resilience policy is not part of the GraphQL API, keep it as is
+	btr.ResiliencePolicy = bridge.ResiliencePolicy
 
 	// Update the bridge
 	if err := ValidateBridgeType(btr); err != nil {
@@ -526,7 +529,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceFeedsManagers, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -574,7 +577,7 @@ func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*EnableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceFeedsManagers, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -596,7 +599,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*DisableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceFeedsManagers, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -615,7 +618,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -635,7 +638,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -654,7 +657,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceBridges, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -690,7 +693,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -714,7 +717,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -740,7 +743,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -762,7 +765,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -782,17 +785,47 @@ func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	return NewDeleteVRFKeyPayloadResolver(key, nil), nil
 }
 
+// authorizeJobProposalSpec asserts the user may edit the job proposal spec, either through a permission on every job
+// proposal or one restricted to the chain the spec runs on.
+func (r *Resolver) authorizeJobProposalSpec(ctx context.Context, id int64) error {
+	err := authorizeUser(ctx, sessions.ResourceJobProposals, sessions.ActionEdit)
+	var notPermitted RoleNotPermittedErr
+	if !errors.As(err, &notPermitted) {
+		return err
+	}
+	spec, specErr := r.App.GetFeedsService().GetSpec(ctx, id)
+	if specErr != nil {
+		return err
+	}
+	return authorizeJobProposalDefinition(ctx, spec.Definition)
+}
+
+// authorizeJobProposalDefinition asserts the user may edit job proposal specs with the given definition, i.e. the
+// chain the definition runs on.
+func authorizeJobProposalDefinition(ctx context.Context, definition string) error {
+	err := authorizeUser(ctx, sessions.ResourceJobProposals, sessions.ActionEdit)
+	var notPermitted RoleNotPermittedErr
+	if !errors.As(err, &notPermitted) {
+		return err
+	}
+	scope, ok := webauth.JobSpecScope(definition)
+	if !ok {
+		return err
+	}
+	return authorizeUserWithinScope(ctx, sessions.ResourceJobProposals, sessions.ActionEdit, scope)
+}
+
 // ApproveJobProposalSpec approves the job proposal spec.
 func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
 		return nil, err
 	}
 
-	id, err := stringutils.ToInt64(string(args.ID))
-	if err != nil {
+	if err = r.authorizeJobProposalSpec(ctx, id); err != nil {
 		return nil, err
 	}
 
@@ -826,12 +859,12 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
 		return nil, err
 	}
 
-	id, err := stringutils.ToInt64(string(args.ID))
-	if err != nil {
+	if err = r.authorizeJobProposalSpec(ctx, id); err != nil {
 		return nil, err
 	}
 
@@ -861,12 +894,12 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
 		return nil, err
 	}
 
-	id, err := stringutils.ToInt64(string(args.ID))
-	if err != nil {
+	if err = r.authorizeJobProposalSpec(ctx, id); err != nil {
 		return nil, err
 	}
 
@@ -897,12 +930,16 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
 		return nil, err
 	}
 
-	id, err := stringutils.ToInt64(string(args.ID))
-	if err != nil {
+	if err = r.authorizeJobProposalSpec(ctx, id); err != nil {
+		return nil, err
+	}
+	// the new definition must not move the spec out of the user's scope
+	if err = authorizeJobProposalDefinition(ctx, args.Input.Definition); err != nil {
 		return nil, err
 	}
 
@@ -971,7 +1008,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceConfig, sessions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -1062,7 +1099,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -1140,7 +1177,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -1174,7 +1211,7 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -1208,7 +1245,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionRun); err != nil {
 		return nil, err
 	}
 
@@ -1238,7 +1275,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceConfig, sessions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -1264,7 +1301,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -1292,7 +1329,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
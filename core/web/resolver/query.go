@@ -18,13 +18,15 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	workflowstore "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
 // Bridge retrieves a bridges by name.
 func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*BridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceBridges, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -50,7 +52,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*BridgesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceBridges, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -71,7 +73,7 @@ func (r *Resolver) Chain(ctx context.Context,
 		ID      graphql.ID
 		Network *string
 	}) (*ChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceChains, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -104,7 +106,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*ChainsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceChains, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -159,7 +161,7 @@ func sortByNetworkAndID(chains []commonTypes.ChainStatusWithID) {
 
 // FeedsManager retrieves a feeds manager by id.
 func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID }) (*FeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceFeedsManagers, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -181,7 +183,7 @@ func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID
 }
 
 func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceFeedsManagers, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -195,7 +197,7 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 
 // Job retrieves a job by id.
 func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -226,7 +228,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -242,7 +244,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 }
 
 func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -255,7 +257,7 @@ func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadReso
 }
 
 func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -278,7 +280,7 @@ func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, erro
 
 // Node retrieves a node by ID (Name)
 func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*NodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceChains, sessions.ActionView); err != nil {
 		return nil, err
 	}
 	r.App.GetLogger().Debug("resolver Node args %v", args)
@@ -306,7 +308,7 @@ func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*Nod
 }
 
 func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -320,7 +322,7 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -336,7 +338,7 @@ func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error)
 func (r *Resolver) VRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*VRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -355,7 +357,7 @@ func (r *Resolver) VRFKey(ctx context.Context, args struct {
 func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobProposalPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobProposals, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -381,7 +383,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*NodesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceChains, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -406,7 +408,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobRunsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -424,7 +426,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -445,8 +447,67 @@ func (r *Resolver) JobRun(ctx context.Context, args struct {
 	return NewJobRunPayload(&jr, r.App, err), nil
 }
 
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	Offset        *int32
+	Limit         *int32
+	WorkflowID    *string
+	Status        *string
+	CreatedAfter  *graphql.Time
+	CreatedBefore *graphql.Time
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionView); err != nil {
+		return nil, err
+	}
+
+	limit := pageLimit(args.Limit)
+	offset := pageOffset(args.Offset)
+
+	var filter workflowstore.ExecutionFilter
+	if args.WorkflowID != nil {
+		filter.WorkflowID = *args.WorkflowID
+	}
+	if args.Status != nil {
+		if !workflowstore.ValidStatuses[*args.Status] {
+			return nil, fmt.Errorf("invalid status %q", *args.Status)
+		}
+		filter.Status = *args.Status
+	}
+	if args.CreatedAfter != nil {
+		filter.CreatedAfter = &args.CreatedAfter.Time
+	}
+	if args.CreatedBefore != nil {
+		filter.CreatedBefore = &args.CreatedBefore.Time
+	}
+
+	executions, count, err := r.App.WorkflowORM().List(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
+
+func (r *Resolver) WorkflowExecution(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*WorkflowExecutionPayloadResolver, error) {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionView); err != nil {
+		return nil, err
+	}
+
+	execution, err := r.App.WorkflowORM().Get(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewWorkflowExecutionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowExecutionPayload(&execution, nil), nil
+}
+
 func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -499,7 +560,7 @@ func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error)
 
 // ConfigV2 retrieves the Chainlink node's configuration (V2 mode)
 func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceConfig, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -510,7 +571,7 @@ func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, erro
 func (r *Resolver) EthTransaction(ctx context.Context, args struct {
 	Hash graphql.ID
 }) (*EthTransactionPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceTransactions, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -531,7 +592,7 @@ func (r *Resolver) EthTransactions(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceTransactions, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -550,7 +611,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsAttemptsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceTransactions, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -566,7 +627,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 }
 
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceConfig, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -576,7 +637,7 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 }
 
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -589,7 +650,7 @@ func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver,
 }
 
 func (r *Resolver) AptosKeys(ctx context.Context) (*AptosKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -602,7 +663,7 @@ func (r *Resolver) AptosKeys(ctx context.Context) (*AptosKeysPayloadResolver, er
 }
 
 func (r *Resolver) CosmosKeys(ctx context.Context) (*CosmosKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 	keys, err := r.App.GetKeyStore().Cosmos().GetAll()
@@ -614,7 +675,7 @@ func (r *Resolver) CosmosKeys(ctx context.Context) (*CosmosKeysPayloadResolver,
 }
 
 func (r *Resolver) StarkNetKeys(ctx context.Context) (*StarkNetKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 	keys, err := r.App.GetKeyStore().StarkNet().GetAll()
@@ -626,7 +687,7 @@ func (r *Resolver) StarkNetKeys(ctx context.Context) (*StarkNetKeysPayloadResolv
 }
 
 func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceConfig, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -637,7 +698,7 @@ func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolve
 
 // OCR2KeyBundles resolves the list of OCR2 key bundles
 func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceKeys, sessions.ActionView); err != nil {
 		return nil, err
 	}
 
@@ -27,6 +27,7 @@ import (
 	keystoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
+	workflowstoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	authProviderMocks "github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
@@ -61,6 +62,7 @@ type mocks struct {
 	eIMgr                *webhookmocks.ExternalInitiatorManager
 	balM                 *evmORMMocks.BalanceMonitor
 	txmStore             *evmtxmgrmocks.EvmTxStore
+	workflowStore        *workflowstoreMocks.Store
 	auditLogger          *audit.AuditLoggerService
 }
 
@@ -119,6 +121,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		eIMgr:                webhookmocks.NewExternalInitiatorManager(t),
 		balM:                 evmORMMocks.NewBalanceMonitor(t),
 		txmStore:             evmtxmgrmocks.NewEvmTxStore(t),
+		workflowStore:        workflowstoreMocks.NewStore(t),
 		auditLogger:          &audit.AuditLoggerService{},
 	}
 
@@ -0,0 +1,182 @@
+package resolver
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResolver resolves the WorkflowExecution type.
+type WorkflowExecutionResolver struct {
+	execution store.WorkflowExecution
+}
+
+func NewWorkflowExecution(execution store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{execution: execution}
+}
+
+func NewWorkflowExecutions(executions []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	var resolvers []*WorkflowExecutionResolver
+
+	for _, e := range executions {
+		resolvers = append(resolvers, NewWorkflowExecution(e))
+	}
+
+	return resolvers
+}
+
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.execution.ExecutionID)
+}
+
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.execution.WorkflowID
+}
+
+func (r *WorkflowExecutionResolver) Status() string {
+	return r.execution.Status
+}
+
+// Steps resolves the steps of the execution, ordered by start time.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	steps := make([]*WorkflowExecutionStepResolver, 0, len(r.execution.Steps))
+	for _, s := range r.execution.Steps {
+		steps = append(steps, &WorkflowExecutionStepResolver{step: *s})
+	}
+	slices.SortFunc(steps, func(a, b *WorkflowExecutionStepResolver) int {
+		if a.step.CreatedAt != nil && b.step.CreatedAt != nil {
+			if c := a.step.CreatedAt.Compare(*b.step.CreatedAt); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.step.Ref, b.step.Ref)
+	})
+	return steps
+}
+
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	return optionalTime(r.execution.CreatedAt)
+}
+
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	return optionalTime(r.execution.UpdatedAt)
+}
+
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	return optionalTime(r.execution.FinishedAt)
+}
+
+// WorkflowExecutionStepResolver resolves the WorkflowExecutionStep type.
+type WorkflowExecutionStepResolver struct {
+	step store.WorkflowExecutionStep
+}
+
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+func (r *WorkflowExecutionStepResolver) Status() string {
+	return r.step.Status
+}
+
+// Inputs resolves the JSON encoded inputs of the step.
+func (r *WorkflowExecutionStepResolver) Inputs() (*string, error) {
+	if r.step.Inputs == nil {
+		return nil, nil
+	}
+	return valueToJSON(r.step.Inputs)
+}
+
+// Outputs resolves the JSON encoded outputs of the step.
+func (r *WorkflowExecutionStepResolver) Outputs() (*string, error) {
+	if r.step.Outputs.Value == nil {
+		return nil, nil
+	}
+	return valueToJSON(r.step.Outputs.Value)
+}
+
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	if r.step.Outputs.Err == nil {
+		return nil
+	}
+	msg := r.step.Outputs.Err.Error()
+	return &msg
+}
+
+func (r *WorkflowExecutionStepResolver) CreatedAt() *graphql.Time {
+	return optionalTime(r.step.CreatedAt)
+}
+
+func (r *WorkflowExecutionStepResolver) UpdatedAt() *graphql.Time {
+	return optionalTime(r.step.UpdatedAt)
+}
+
+func valueToJSON(v values.Value) (*string, error) {
+	unwrapped, err := v.Unwrap()
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(unwrapped)
+	if err != nil {
+		return nil, err
+	}
+	s := string(b)
+	return &s, nil
+}
+
+func optionalTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+// -- WorkflowExecution Query --
+
+type WorkflowExecutionPayloadResolver struct {
+	execution *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *WorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found", isExpectedErrorFn: nil}
+
+	return &WorkflowExecutionPayloadResolver{execution: execution, NotFoundErrorUnionType: e}
+}
+
+func (r *WorkflowExecutionPayloadResolver) ToWorkflowExecution() (*WorkflowExecutionResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewWorkflowExecution(*r.execution), true
+}
+
+// -- WorkflowExecutions Query --
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{executions: executions, total: total}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
@@ -0,0 +1,226 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestQuery_PaginatedWorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions($status: String, $createdAfter: Time) {
+			workflowExecutions(status: $status, createdAfter: $createdAfter) {
+				results {
+					id
+					status
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	after := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	variables := map[string]interface{}{
+		"status":       store.StatusErrored,
+		"createdAfter": after.Format(time.RFC3339),
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				filter := store.ExecutionFilter{Status: store.StatusErrored, CreatedAfter: &after}
+				f.Mocks.workflowStore.On("List", mock.Anything, mock.MatchedBy(func(got store.ExecutionFilter) bool {
+					return got.Status == filter.Status && got.CreatedAfter.Equal(after) && got.CreatedBefore == nil
+				}), PageDefaultOffset, PageDefaultLimit).Return([]store.WorkflowExecution{
+					{ExecutionID: "execution", Status: store.StatusErrored},
+				}, 1, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowStore)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "execution",
+							"status": "errored"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid status",
+			authenticated: true,
+			query:         query,
+			variables:     map[string]interface{}{"status": "unknown"},
+			result:        `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: fmt.Errorf(`invalid status "unknown"`),
+					Path:          []interface{}{"workflowExecutions"},
+					Message:       `invalid status "unknown"`,
+				},
+			},
+		},
+		{
+			name:          "generic error on List()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowStore.On("List", mock.Anything, mock.Anything, PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowStore)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: gError,
+					Path:          []interface{}{"workflowExecutions"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecution($id: ID!) {
+			workflowExecution(id: $id) {
+				... on WorkflowExecution {
+					id
+					workflowID
+					status
+					createdAt
+					finishedAt
+					steps {
+						ref
+						status
+						inputs
+						outputs
+						error
+						createdAt
+						updatedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}
+	`
+	variables := map[string]interface{}{
+		"id": "execution",
+	}
+	started := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	finished := started.Add(time.Second)
+	inputs, err := values.NewMap(map[string]any{"feedID": "0x01"})
+	require.NoError(t, err)
+	notFound := fmt.Errorf("could not find workflow execution with id execution: %w", sql.ErrNoRows)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowStore.On("Get", mock.Anything, "execution").Return(store.WorkflowExecution{
+					ExecutionID: "execution",
+					WorkflowID:  "workflow",
+					Status:      store.StatusErrored,
+					CreatedAt:   &started,
+					FinishedAt:  &finished,
+					Steps: map[string]*store.WorkflowExecutionStep{
+						"write": {
+							Ref:       "write",
+							Status:    store.StatusErrored,
+							Outputs:   store.StepOutput{Err: errors.New("write failed")},
+							CreatedAt: &finished,
+							UpdatedAt: &finished,
+						},
+						"trigger": {
+							Ref:       "trigger",
+							Status:    store.StatusCompleted,
+							Inputs:    inputs,
+							CreatedAt: &started,
+							UpdatedAt: &finished,
+						},
+					},
+				}, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowStore)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"id": "execution",
+						"workflowID": "workflow",
+						"status": "errored",
+						"createdAt": "2000-01-01T00:00:00Z",
+						"finishedAt": "2000-01-01T00:00:01Z",
+						"steps": [{
+							"ref": "trigger",
+							"status": "completed",
+							"inputs": "{\"feedID\":\"0x01\"}",
+							"outputs": null,
+							"error": null,
+							"createdAt": "2000-01-01T00:00:00Z",
+							"updatedAt": "2000-01-01T00:00:01Z"
+						}, {
+							"ref": "write",
+							"status": "errored",
+							"inputs": null,
+							"outputs": null,
+							"error": "write failed",
+							"createdAt": "2000-01-01T00:00:01Z",
+							"updatedAt": "2000-01-01T00:00:01Z"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowStore.On("Get", mock.Anything, "execution").Return(store.WorkflowExecution{}, notFound)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowStore)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"code": "NOT_FOUND",
+						"message": "workflow execution not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
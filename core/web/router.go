@@ -38,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -83,6 +84,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 			rl.Authenticated(),
 		),
 		sessions.Sessions(auth.SessionName, sessionStore),
+		auth.WithRoles(app.Roles()),
 	)
 
 	debugRoutes(app, api)
@@ -243,10 +245,10 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	{
 		uc := UserController{app}
-		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
-		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
-		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
-		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+		authv2.GET("/users", auth.RequiresPermission(clsessions.ResourceUsers, clsessions.ActionAdmin, uc.Index))
+		authv2.POST("/users", auth.RequiresPermission(clsessions.ResourceUsers, clsessions.ActionAdmin, uc.Create))
+		authv2.PATCH("/users", auth.RequiresPermission(clsessions.ResourceUsers, clsessions.ActionAdmin, uc.UpdateRole))
+		authv2.DELETE("/users/:email", auth.RequiresPermission(clsessions.ResourceUsers, clsessions.ActionAdmin, uc.Delete))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
@@ -256,56 +258,64 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
 
 		eia := ExternalInitiatorsController{app}
-		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
-		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
+		authv2.GET("/external_initiators", auth.RequiresPermission(clsessions.ResourceExternalInitiators, clsessions.ActionView, paginatedRequest(eia.Index)))
+		authv2.POST("/external_initiators", auth.RequiresPermission(clsessions.ResourceExternalInitiators, clsessions.ActionEdit, eia.Create))
+		authv2.DELETE("/external_initiators/:Name", auth.RequiresPermission(clsessions.ResourceExternalInitiators, clsessions.ActionEdit, eia.Destroy))
 
 		bt := BridgeTypesController{app}
-		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
-		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
-		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
+		authv2.GET("/bridge_types", auth.RequiresPermission(clsessions.ResourceBridges, clsessions.ActionView, paginatedRequest(bt.Index)))
+		authv2.POST("/bridge_types", auth.RequiresPermission(clsessions.ResourceBridges, clsessions.ActionEdit, bt.Create))
+		authv2.GET("/bridge_types/:BridgeName", auth.RequiresPermission(clsessions.ResourceBridges, clsessions.ActionView, bt.Show))
+		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresPermission(clsessions.ResourceBridges, clsessions.ActionEdit, bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresPermission(clsessions.ResourceBridges, clsessions.ActionEdit, bt.Destroy))
 
 		ets := EVMTransfersController{app}
-		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
-		authv2.POST("/transfers/evm", auth.RequiresAdminRole(ets.Create))
+		authv2.POST("/transfers", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionAdmin, ets.Create))
+		authv2.POST("/transfers/evm", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionAdmin, ets.Create))
 		tts := CosmosTransfersController{app}
-		authv2.POST("/transfers/cosmos", auth.RequiresAdminRole(tts.Create))
+		authv2.POST("/transfers/cosmos", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionAdmin, tts.Create))
 		sts := SolanaTransfersController{app}
-		authv2.POST("/transfers/solana", auth.RequiresAdminRole(sts.Create))
+		authv2.POST("/transfers/solana", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionAdmin, sts.Create))
 
 		cc := ConfigController{app}
-		authv2.GET("/config", cc.Show)
-		authv2.GET("/config/v2", cc.Show)
+		authv2.GET("/config", auth.RequiresPermission(clsessions.ResourceConfig, clsessions.ActionView, cc.Show))
+		authv2.GET("/config/v2", auth.RequiresPermission(clsessions.ResourceConfig, clsessions.ActionView, cc.Show))
 
 		tas := TxAttemptsController{app}
-		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
-		authv2.GET("/tx_attempts/evm", paginatedRequest(tas.Index))
+		authv2.GET("/tx_attempts", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionView, paginatedRequest(tas.Index)))
+		authv2.GET("/tx_attempts/evm", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionView, paginatedRequest(tas.Index)))
 
 		txs := TransactionsController{app}
-		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
-		authv2.GET("/transactions/evm/:TxHash", txs.Show)
-		authv2.GET("/transactions", paginatedRequest(txs.Index))
-		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.GET("/transactions/evm", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionView, paginatedRequest(txs.Index)))
+		authv2.GET("/transactions/evm/:TxHash", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionView, txs.Show))
+		authv2.GET("/transactions", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionView, paginatedRequest(txs.Index)))
+		authv2.GET("/transactions/:TxHash", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionView, txs.Show))
+
+		txr := EVMTxReplacementsController{app}
+		authv2.POST("/transactions/evm/cancel", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionAdmin, txr.Cancel))
+		authv2.POST("/transactions/evm/speed_up", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionAdmin, txr.SpeedUp))
+		authv2.POST("/transactions/evm/replace", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionAdmin, txr.Replace))
+
+		txe := TxEventsController{app}
+		authv2.GET("/tx_events/evm", auth.RequiresPermission(clsessions.ResourceTransactions, clsessions.ActionView, txe.Stream))
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
+		authv2.POST("/replay_from_block/:number", auth.RequiresPermission(clsessions.ResourceChains, clsessions.ActionRun, rc.ReplayFromBlock))
 		lcaC := LCAController{app}
-		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		authv2.GET("/find_lca", auth.RequiresPermission(clsessions.ResourceChains, clsessions.ActionRun, lcaC.FindLCA))
 
 		csakc := CSAKeysController{app}
-		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
-		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
-		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
+		authv2.GET("/keys/csa", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionView, csakc.Index))
+		authv2.POST("/keys/csa", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionEdit, csakc.Create))
+		authv2.POST("/keys/csa/import", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, csakc.Import))
+		authv2.POST("/keys/csa/export/:ID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, csakc.Export))
 
 		ekc := NewETHKeysController(app)
-		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
-		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
-		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.GET("/keys/eth", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionView, ekc.Index))
+		authv2.POST("/keys/eth", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionEdit, ekc.Create))
+		authv2.DELETE("/keys/eth/:keyID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ekc.Delete))
+		authv2.POST("/keys/eth/import", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ekc.Import))
+		authv2.POST("/keys/eth/export/:address", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ekc.Export))
 		// duplicated from above, with `evm` instead of `eth`
 		// legacy ones remain for backwards compatibility
 
@@ -315,33 +325,38 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		))
 
 		ethKeysGroup.Use(ekc.formatETHKeyResponse())
-		authv2.GET("/keys/evm", ekc.Index)
-		ethKeysGroup.POST("/keys/evm", auth.RequiresEditRole(ekc.Create))
-		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresAdminRole(ekc.Delete))
-		ethKeysGroup.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
-		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
+		authv2.GET("/keys/evm", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionView, ekc.Index))
+		ethKeysGroup.POST("/keys/evm", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionEdit, ekc.Create))
+		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ekc.Delete))
+		ethKeysGroup.POST("/keys/evm/import", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ekc.Import))
+		authv2.POST("/keys/evm/export/:address", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ekc.Export))
+		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ekc.Chain))
 
 		ocrkc := OCRKeysController{app}
-		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", auth.RequiresEditRole(ocrkc.Create))
-		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
-		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
-		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(ocrkc.Export))
+		authv2.GET("/keys/ocr", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionView, ocrkc.Index))
+		authv2.POST("/keys/ocr", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionEdit, ocrkc.Create))
+		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ocrkc.Delete))
+		authv2.POST("/keys/ocr/import", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ocrkc.Import))
+		authv2.POST("/keys/ocr/export/:ID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ocrkc.Export))
 
 		ocr2kc := OCR2KeysController{app}
-		authv2.GET("/keys/ocr2", ocr2kc.Index)
-		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(ocr2kc.Create))
-		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
-		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
-		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
+		authv2.GET("/keys/ocr2", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionView, ocr2kc.Index))
+		authv2.POST("/keys/ocr2/:chainType", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionEdit, ocr2kc.Create))
+		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ocr2kc.Delete))
+		authv2.POST("/keys/ocr2/import", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ocr2kc.Import))
+		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
-		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
-		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
-		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
-		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
+		authv2.GET("/keys/p2p", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionView, p2pkc.Index))
+		authv2.POST("/keys/p2p", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionEdit, p2pkc.Create))
+		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, p2pkc.Delete))
+		authv2.POST("/keys/p2p/import", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, p2pkc.Import))
+		authv2.POST("/keys/p2p/export/:ID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, p2pkc.Export))
+
+		krc := KeyRotationsController{app}
+		authv2.GET("/keys/rotations", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionView, krc.Index))
+		authv2.POST("/keys/ocr2/rotate/:ID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, krc.RotateOCR2))
+		authv2.POST("/keys/p2p/rotate/:ID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, krc.RotateP2P))
 
 		for _, keys := range []struct {
 			path string
@@ -352,42 +367,58 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"starknet", NewStarkNetKeysController(app)},
 			{"aptos", NewAptosKeysController(app)},
 		} {
-			authv2.GET("/keys/"+keys.path, keys.kc.Index)
-			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
-			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresAdminRole(keys.kc.Delete))
-			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresAdminRole(keys.kc.Import))
-			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresAdminRole(keys.kc.Export))
+			authv2.GET("/keys/"+keys.path, auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionView, keys.kc.Index))
+			authv2.POST("/keys/"+keys.path, auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionEdit, keys.kc.Create))
+			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, keys.kc.Delete))
+			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, keys.kc.Import))
+			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, keys.kc.Export))
 		}
 
 		vrfkc := VRFKeysController{app}
-		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", auth.RequiresEditRole(vrfkc.Create))
-		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
-		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
-		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
+		authv2.GET("/keys/vrf", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionView, vrfkc.Index))
+		authv2.POST("/keys/vrf", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionEdit, vrfkc.Create))
+		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, vrfkc.Delete))
+		authv2.POST("/keys/vrf/import", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, vrfkc.Import))
+		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresPermission(clsessions.ResourceKeys, clsessions.ActionAdmin, vrfkc.Export))
 
 		jc := JobsController{app}
-		authv2.GET("/jobs", paginatedRequest(jc.Index))
-		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
-		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
-		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.GET("/jobs", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionView, paginatedRequest(jc.Index)))
+		authv2.GET("/jobs/:ID", auth.RequiresScopedPermission(clsessions.ResourceJobs, clsessions.ActionView, jc.JobScope, jc.Show))
+		authv2.POST("/jobs", auth.RequiresScopedPermission(clsessions.ResourceJobs, clsessions.ActionEdit, auth.JobSpecBodyScope, jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresScopedPermission(clsessions.ResourceJobs, clsessions.ActionRun, auth.JobSpecBodyScope, jc.Simulate))
+		// updates must be permitted both on the chain of the stored job and on the chain of the new spec
+		authv2.PUT("/jobs/:ID", auth.RequiresScopedPermission(clsessions.ResourceJobs, clsessions.ActionEdit, jc.JobScope,
+			auth.RequiresScopedPermission(clsessions.ResourceJobs, clsessions.ActionEdit, auth.JobSpecBodyScope, jc.Update)))
+		authv2.DELETE("/jobs/:ID", auth.RequiresScopedPermission(clsessions.ResourceJobs, clsessions.ActionEdit, jc.JobScope, jc.Delete))
 
 		// PipelineRunsController
-		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.GET("/pipeline/runs", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionView, paginatedRequest(prc.Index)))
+		authv2.GET("/pipeline/runs/:runID/captures", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionView, prc.Captures))
+		authv2.POST("/pipeline/runs/:runID/replay", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionRun, prc.Replay))
+		authv2.GET("/jobs/:ID/runs", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionView, paginatedRequest(prc.Index)))
+		authv2.GET("/jobs/:ID/runs/:runID", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionView, prc.Show))
+
+		// WorkflowExecutionsController
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/executions", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionView, paginatedRequest(wec.Index)))
+		authv2.GET("/workflows/executions/:executionID", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionView, wec.Show))
+		authv2.POST("/workflows/executions/:executionID/reexecute", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionRun, wec.ReExecute))
+
+		// WorkflowsController
+		wfc := WorkflowsController{app}
+		authv2.POST("/workflows/:workflowID/pause", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionEdit, wfc.Pause))
+		authv2.POST("/workflows/:workflowID/resume", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionEdit, wfc.Resume))
 
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
+		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionEdit, psec.Destroy))
 
 		lgc := LogController{app}
-		authv2.GET("/log", lgc.Get)
-		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
+		authv2.GET("/log", auth.RequiresPermission(clsessions.ResourceConfig, clsessions.ActionView, lgc.Get))
+		authv2.PATCH("/log", auth.RequiresPermission(clsessions.ResourceConfig, clsessions.ActionAdmin, lgc.Patch))
 
 		chains := authv2.Group("chains")
 		for _, chain := range []struct {
@@ -399,8 +430,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"starknet", NewStarkNetChainsController(app)},
 			{"cosmos", NewCosmosChainsController(app)},
 		} {
-			chains.GET(chain.path, paginatedRequest(chain.cc.Index))
-			chains.GET(chain.path+"/:ID", chain.cc.Show)
+			chains.GET(chain.path, auth.RequiresPermission(clsessions.ResourceChains, clsessions.ActionView, paginatedRequest(chain.cc.Index)))
+			chains.GET(chain.path+"/:ID", auth.RequiresScopedPermission(clsessions.ResourceChains, clsessions.ActionView, auth.ChainParamScope(chain.path), chain.cc.Show))
 		}
 
 		nodes := authv2.Group("nodes")
@@ -415,16 +446,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		} {
 			if chain.path == "evm" {
 				// TODO still EVM only . Archive ticket: story/26276/multi-chain-type-ui-node-chain-configuration
-				nodes.GET("", paginatedRequest(chain.nc.Index))
+				nodes.GET("", auth.RequiresPermission(clsessions.ResourceChains, clsessions.ActionView, paginatedRequest(chain.nc.Index)))
 			}
-			nodes.GET(chain.path, paginatedRequest(chain.nc.Index))
-			chains.GET(chain.path+"/:ID/nodes", paginatedRequest(chain.nc.Index))
+			nodes.GET(chain.path, auth.RequiresPermission(clsessions.ResourceChains, clsessions.ActionView, paginatedRequest(chain.nc.Index)))
+			chains.GET(chain.path+"/:ID/nodes", auth.RequiresScopedPermission(clsessions.ResourceChains, clsessions.ActionView, auth.ChainParamScope(chain.path), paginatedRequest(chain.nc.Index)))
 		}
 
 		efc := EVMForwardersController{app}
-		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
-		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
-		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
+		authv2.GET("/nodes/evm/forwarders", auth.RequiresPermission(clsessions.ResourceChains, clsessions.ActionView, paginatedRequest(efc.Index)))
+		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresPermission(clsessions.ResourceChains, clsessions.ActionEdit, efc.Track))
+		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresPermission(clsessions.ResourceChains, clsessions.ActionEdit, efc.Delete))
 
 		buildInfo := BuildInfoController{app}
 		authv2.GET("/build_info", buildInfo.Show)
@@ -440,7 +471,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateBySession,
 	))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+	userOrEI.POST("/jobs/:ID/runs", auth.RequiresPermission(clsessions.ResourceJobs, clsessions.ActionRun, prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -63,7 +63,7 @@ func (u *UserController) Create(c *gin.Context) {
 		return
 	}
 
-	userRole, err := clsession.GetUserRole(request.Role)
+	userRole, err := u.App.Roles().Get(request.Role)
 	if err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
@@ -136,12 +136,12 @@ func (u *UserController) UpdateRole(c *gin.Context) {
 		return
 	}
 	if request.NewRole == "" {
-		jsonAPIError(c, http.StatusBadRequest, errors.New("new-role flag is empty, must specify a new role, possible options are 'admin', 'edit', 'run', 'view'"))
+		jsonAPIError(c, http.StatusBadRequest, errors.New("new-role flag is empty, must specify a new role"))
 		return
 	}
-	_, err := clsession.GetUserRole(request.NewRole)
+	_, err := u.App.Roles().Get(request.NewRole)
 	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, errors.New("new role does not exist, possible options are 'admin', 'edit', 'run', 'view'"))
+		jsonAPIError(c, http.StatusBadRequest, errors.Wrap(err, "new role does not exist"))
 		return
 	}
 
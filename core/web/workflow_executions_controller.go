@@ -0,0 +1,151 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController exposes the history of the workflow executions and re-executes failed executions.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index lists the workflow executions, most recent first. They can be filtered by workflow ID, status and creation
+// time, with the createdAfter and createdBefore RFC3339 timestamps.
+// Example:
+// "GET <application>/workflows/executions?workflowID=<id>&status=errored"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseExecutionFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	executions, count, err := wec.App.WorkflowORM().List(c.Request.Context(), filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res, err := presenters.NewWorkflowExecutionResources(executions)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	paginatedResponse(c, "workflowExecution", size, page, res, count, err)
+}
+
+// Show returns a workflow execution along with the inputs, outputs and errors of its steps.
+// Example:
+// "GET <application>/workflows/executions/:executionID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, err := wec.App.WorkflowORM().Get(c.Request.Context(), c.Param("executionID"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res, err := presenters.NewWorkflowExecutionResource(execution)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, res, "workflowExecution")
+}
+
+// ReExecuteRequest is the body of a re-execution request, FromStep is the ref of the first step to execute again.
+type ReExecuteRequest struct {
+	FromStep string `json:"fromStep"`
+}
+
+// ReExecute starts a new execution of an errored or timed out execution from the given step, reusing the outputs of
+// the steps which do not depend on it. It returns the new execution.
+// Example:
+// "POST <application>/workflows/executions/:executionID/reexecute"
+func (wec *WorkflowExecutionsController) ReExecute(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request ReExecuteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.FromStep == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("fromStep is required"))
+		return
+	}
+
+	execution, err := wec.App.WorkflowORM().Get(ctx, c.Param("executionID"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	engine, err := wec.App.WorkflowEngines().Get(execution.WorkflowID)
+	if errors.Is(err, workflows.ErrEngineNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow not found"))
+		return
+	}
+
+	executionID, err := engine.ReExecute(ctx, execution.ExecutionID, request.FromStep)
+	if errors.Is(err, workflows.ErrCannotReExecute) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	execution, err = wec.App.WorkflowORM().Get(ctx, executionID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	res, err := presenters.NewWorkflowExecutionResource(execution)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, res, "workflowExecution")
+}
+
+func parseExecutionFilter(c *gin.Context) (filter store.ExecutionFilter, err error) {
+	filter.WorkflowID = c.Query("workflowID")
+	filter.Status = c.Query("status")
+	if filter.Status != "" && !store.ValidStatuses[filter.Status] {
+		return filter, errors.Errorf("invalid status %q", filter.Status)
+	}
+	parseTime := func(param string) (*time.Time, error) {
+		v := c.Query(param)
+		if v == "" {
+			return nil, nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s, expected an RFC3339 timestamp", param)
+		}
+		return &t, nil
+	}
+	if filter.CreatedAfter, err = parseTime("createdAfter"); err != nil {
+		return filter, err
+	}
+	filter.CreatedBefore, err = parseTime("createdBefore")
+	return filter, err
+}
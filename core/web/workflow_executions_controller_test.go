@@ -0,0 +1,122 @@
+package web_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionsController(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	inputs, err := values.NewMap(map[string]any{"feedID": "0x01"})
+	require.NoError(t, err)
+	_, err = app.WorkflowORM().Add(ctx, &store.WorkflowExecution{
+		ExecutionID: "completed-execution",
+		Status:      store.StatusCompleted,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"trigger": {ExecutionID: "completed-execution", Ref: "trigger", Status: store.StatusCompleted, Inputs: inputs},
+		},
+	})
+	require.NoError(t, err)
+	_, err = app.WorkflowORM().Add(ctx, &store.WorkflowExecution{
+		ExecutionID: "errored-execution",
+		Status:      store.StatusErrored,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"trigger": {ExecutionID: "errored-execution", Ref: "trigger", Status: store.StatusCompleted},
+			"write":   {ExecutionID: "errored-execution", Ref: "write", Status: store.StatusErrored, Outputs: store.StepOutput{Err: errors.New("write failed")}},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("index", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions?status=errored")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var executions []presenters.WorkflowExecutionResource
+		body := cltest.ParseResponseBody(t, resp)
+		require.NoError(t, web.ParseJSONAPIResponse(body, &executions))
+		assert.Contains(t, string(body), `"meta":{"count":1}`)
+		require.Len(t, executions, 1)
+		assert.Equal(t, "errored-execution", executions[0].ID)
+		require.Len(t, executions[0].Steps, 2)
+		assert.Equal(t, "write failed", *executions[0].Steps[1].Error)
+	})
+
+	t.Run("index with invalid filter", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions?status=unknown")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		resp, cleanup = client.Get("/v2/workflows/executions?createdAfter=yesterday")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("show", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions/completed-execution")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var execution presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &execution))
+		assert.Equal(t, store.StatusCompleted, execution.Status)
+		require.Len(t, execution.Steps, 1)
+		assert.Equal(t, map[string]any{"feedID": "0x01"}, execution.Steps[0].Inputs)
+		assert.NotNil(t, execution.Steps[0].CreatedAt)
+	})
+
+	t.Run("show not found", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions/unknown")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("re-execute", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/workflows/executions/errored-execution/reexecute", bytes.NewBufferString(`{}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		resp, cleanup = client.Post("/v2/workflows/executions/unknown/reexecute", bytes.NewBufferString(`{"fromStep":"write"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+		// the workflow of the execution is not running on the node
+		resp, cleanup = client.Post("/v2/workflows/executions/errored-execution/reexecute", bytes.NewBufferString(`{"fromStep":"write"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
+
+func TestWorkflowsController_PauseResume(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/workflows/unknown/pause", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Post("/v2/workflows/unknown/resume", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -0,0 +1,51 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowsController pauses and resumes the workflows running on the node.
+type WorkflowsController struct {
+	App chainlink.Application
+}
+
+// Pause stops the workflow from starting executions on trigger events, the triggers remain registered.
+// Example:
+// "POST <application>/workflows/:workflowID/pause"
+func (wc *WorkflowsController) Pause(c *gin.Context) {
+	wc.setPaused(c, true)
+}
+
+// Resume resumes a paused workflow.
+// Example:
+// "POST <application>/workflows/:workflowID/resume"
+func (wc *WorkflowsController) Resume(c *gin.Context) {
+	wc.setPaused(c, false)
+}
+
+func (wc *WorkflowsController) setPaused(c *gin.Context, paused bool) {
+	engine, err := wc.App.WorkflowEngines().Get(c.Param("workflowID"))
+	if errors.Is(err, workflows.ErrEngineNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow not found"))
+		return
+	}
+
+	if paused {
+		err = engine.Pause(c.Request.Context())
+	} else {
+		err = engine.Resume(c.Request.Context())
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowResource(c.Param("workflowID"), engine.Paused()), "workflow")
+}